@@ -19,7 +19,9 @@ import (
 
 	"github.com/spf13/viper"
 
+	"github.com/vmware-tanzu/octant/internal/auth"
 	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/metrics"
 	"github.com/vmware-tanzu/octant/internal/mime"
 	"github.com/vmware-tanzu/octant/internal/module"
 	"github.com/vmware-tanzu/octant/pkg/log"
@@ -113,6 +115,65 @@ func RespondWithError(w http.ResponseWriter, code int, message string, logger lo
 	}
 }
 
+// APIOption is an option for configuring API and LoadingAPI.
+type APIOption func(options *apiOptions)
+
+type apiOptions struct {
+	authenticator *auth.Authenticator
+	impersonation *Impersonation
+}
+
+// WithAuthenticator requires requests to be authenticated. Authentication is
+// disabled if authenticator is nil.
+func WithAuthenticator(authenticator *auth.Authenticator) APIOption {
+	return func(options *apiOptions) {
+		options.authenticator = authenticator
+	}
+}
+
+// WithImpersonation makes cluster calls as the authenticated user. It requires
+// an authenticator.
+func WithImpersonation(impersonation *Impersonation) APIOption {
+	return func(options *apiOptions) {
+		options.impersonation = impersonation
+	}
+}
+
+func buildAPIOptions(options ...APIOption) apiOptions {
+	var opts apiOptions
+	for _, option := range options {
+		option(&opts)
+	}
+	return opts
+}
+
+// newRouter creates the router for an API. It returns the router and the
+// subrouter for the API prefix, which requires authentication if it is enabled.
+func newRouter(ctx context.Context, prefix string, authenticator *auth.Authenticator, impersonation *Impersonation) (*mux.Router, *mux.Router) {
+	router := mux.NewRouter()
+	router.Use(rebindHandler(ctx, acceptedHosts()))
+
+	if authenticator != nil {
+		authenticator.Routes(router.PathPrefix(authenticator.PathPrefix()).Subrouter())
+	}
+
+	metricsHandler := metrics.Handler()
+	if authenticator != nil {
+		metricsHandler = authenticator.Middleware(metricsHandler)
+	}
+	router.Handle(metrics.Path, metricsHandler)
+
+	s := router.PathPrefix(prefix).Subrouter()
+	if authenticator != nil {
+		s.Use(authenticator.Middleware)
+	}
+	if impersonation != nil {
+		s.Use(impersonation.Middleware)
+	}
+
+	return router, s
+}
+
 // API is the API for the dashboard client
 type API struct {
 	ctx              context.Context
@@ -122,6 +183,8 @@ type API struct {
 	dashConfig       config.Dash
 	logger           log.Logger
 	wsClientManager  *WebsocketClientManager
+	authenticator    *auth.Authenticator
+	impersonation    *Impersonation
 
 	modulePaths   map[string]module.Module
 	modules       []module.Module
@@ -131,8 +194,9 @@ type API struct {
 var _ Service = (*API)(nil)
 
 // New creates an instance of API.
-func New(ctx context.Context, prefix string, actionDispatcher ActionDispatcher, websocketClientManager *WebsocketClientManager, dashConfig config.Dash) *API {
+func New(ctx context.Context, prefix string, actionDispatcher ActionDispatcher, websocketClientManager *WebsocketClientManager, dashConfig config.Dash, options ...APIOption) *API {
 	logger := dashConfig.Logger().With("component", "api")
+	opts := buildAPIOptions(options...)
 	return &API{
 		ctx:              ctx,
 		prefix:           prefix,
@@ -142,6 +206,8 @@ func New(ctx context.Context, prefix string, actionDispatcher ActionDispatcher,
 		logger:           logger,
 		forceUpdateCh:    make(chan bool, 1),
 		wsClientManager:  websocketClientManager,
+		authenticator:    opts.authenticator,
+		impersonation:    opts.impersonation,
 	}
 }
 
@@ -155,10 +221,7 @@ func (a *API) Handler(ctx context.Context) (http.Handler, error) {
 	if a.dashConfig == nil {
 		return nil, fmt.Errorf("missing dashConfig")
 	}
-	router := mux.NewRouter()
-	router.Use(rebindHandler(ctx, acceptedHosts()))
-
-	s := router.PathPrefix(a.prefix).Subrouter()
+	router, s := newRouter(ctx, a.prefix, a.authenticator, a.impersonation)
 
 	s.Handle("/stream", websocketService(a.wsClientManager, a.dashConfig))
 
@@ -178,6 +241,8 @@ type LoadingAPI struct {
 	prefix           string
 	logger           log.Logger
 	wsClientManager  *WebsocketClientManager
+	authenticator    *auth.Authenticator
+	impersonation    *Impersonation
 
 	modulePaths   map[string]module.Module
 	modules       []module.Module
@@ -187,8 +252,9 @@ type LoadingAPI struct {
 var _ Service = (*LoadingAPI)(nil)
 
 // NewLoadingAPI creates an instance of LoadingAPI
-func NewLoadingAPI(ctx context.Context, prefix string, actionDispatcher ActionDispatcher, websocketClientManager *WebsocketClientManager, logger log.Logger) *LoadingAPI {
+func NewLoadingAPI(ctx context.Context, prefix string, actionDispatcher ActionDispatcher, websocketClientManager *WebsocketClientManager, logger log.Logger, options ...APIOption) *LoadingAPI {
 	logger = logger.With("component", "loading api")
+	opts := buildAPIOptions(options...)
 	return &LoadingAPI{
 		ctx:              ctx,
 		prefix:           prefix,
@@ -197,6 +263,8 @@ func NewLoadingAPI(ctx context.Context, prefix string, actionDispatcher ActionDi
 		logger:           logger,
 		forceUpdateCh:    make(chan bool, 1),
 		wsClientManager:  websocketClientManager,
+		authenticator:    opts.authenticator,
+		impersonation:    opts.impersonation,
 	}
 }
 
@@ -207,10 +275,7 @@ func (l *LoadingAPI) ForceUpdate() error {
 
 // Handler contains a list of handlers
 func (l *LoadingAPI) Handler(ctx context.Context) (http.Handler, error) {
-	router := mux.NewRouter()
-	router.Use(rebindHandler(ctx, acceptedHosts()))
-
-	s := router.PathPrefix(l.prefix).Subrouter()
+	router, s := newRouter(ctx, l.prefix, l.authenticator, l.impersonation)
 
 	s.Handle("/stream", loadingWebsocketService(l.wsClientManager))
 
@@ -0,0 +1,174 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/util/json"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/event"
+)
+
+const (
+	// RequestRefreshCredentials is sent by clients to run the current
+	// context's credential plugin again.
+	RequestRefreshCredentials = "action.octant.dev/refreshCredentials"
+
+	// authCheckInterval is how often the credentials are checked.
+	authCheckInterval = 10 * time.Second
+)
+
+// AuthManagerOption is an option for configuring AuthManager.
+type AuthManagerOption func(manager *AuthManager)
+
+// WithAuthPoller sets the poller.
+func WithAuthPoller(poller Poller) AuthManagerOption {
+	return func(manager *AuthManager) {
+		manager.poller = poller
+	}
+}
+
+// AuthManager reports the status of the current context's credentials and
+// refreshes them.
+type AuthManager struct {
+	ctx        context.Context
+	dashConfig config.Dash
+	poller     Poller
+	updateCh   chan struct{}
+}
+
+var _ StateManager = (*AuthManager)(nil)
+
+// NewAuthManager creates an instance of AuthManager.
+func NewAuthManager(dashConfig config.Dash, options ...AuthManagerOption) *AuthManager {
+	am := &AuthManager{
+		ctx:        context.Background(),
+		dashConfig: dashConfig,
+		poller:     NewInterruptiblePoller("auth"),
+		updateCh:   make(chan struct{}, 1),
+	}
+
+	for _, option := range options {
+		option(am)
+	}
+
+	return am
+}
+
+// Handlers returns a slice of handlers.
+func (am *AuthManager) Handlers() []octant.ClientRequestHandler {
+	return []octant.ClientRequestHandler{
+		{
+			RequestType: RequestRefreshCredentials,
+			Handler:     am.RefreshCredentials,
+		},
+	}
+}
+
+// RefreshCredentials runs the current context's credential plugin. If it
+// succeeds, the cluster client is created again so it uses the new
+// credentials. Plugins may wait for a login, so the refresh runs in the
+// background.
+func (am *AuthManager) RefreshCredentials(state octant.State, payload action.Payload) error {
+	if !am.refreshable() {
+		return fmt.Errorf("credentials can't be refreshed while impersonating users")
+	}
+
+	checker, ok := am.dashConfig.ClusterClient().(cluster.AuthChecker)
+	if !ok {
+		return fmt.Errorf("the cluster client can't refresh credentials")
+	}
+
+	go am.refresh(am.ctx, state, checker)
+	return nil
+}
+
+// refreshable returns true if the client may refresh the credentials. Users
+// impersonated by Octant share Octant's credentials, so they can't.
+func (am *AuthManager) refreshable() bool {
+	_, impersonating := cluster.IdentityFrom(am.ctx)
+	return !impersonating
+}
+
+func (am *AuthManager) refresh(ctx context.Context, state octant.State, checker cluster.AuthChecker) {
+	defer am.triggerUpdate()
+
+	status := checker.RefreshCredentials(ctx)
+	if status.Failed {
+		state.SendAlert(action.CreateAlert(action.AlertTypeError, "Unable to refresh credentials: "+status.Message, action.DefaultAlertExpiration))
+		return
+	}
+
+	if err := am.dashConfig.UseContext(ctx, am.dashConfig.CurrentContext()); err != nil {
+		am.dashConfig.Logger().WithErr(err).Errorf("recreate cluster client after refreshing credentials")
+		state.SendAlert(action.CreateAlert(action.AlertTypeError, fmt.Sprintf("Unable to use refreshed credentials: %v", err), action.DefaultAlertExpiration))
+		return
+	}
+
+	state.SendAlert(action.CreateAlert(action.AlertTypeSuccess, "Refreshed credentials", action.DefaultAlertExpiration))
+}
+
+func (am *AuthManager) triggerUpdate() {
+	select {
+	case am.updateCh <- struct{}{}:
+	default:
+	}
+}
+
+// Start starts the manager.
+func (am *AuthManager) Start(ctx context.Context, state octant.State, s OctantClient) {
+	am.ctx = ctx
+	am.poller.Run(ctx, am.updateCh, am.runUpdate(s), authCheckInterval)
+}
+
+func (am *AuthManager) runUpdate(s OctantClient) PollerFunc {
+	var previous []byte
+
+	logger := am.dashConfig.Logger()
+	return func(ctx context.Context) bool {
+		checker, ok := am.dashConfig.ClusterClient().(cluster.AuthChecker)
+		if !ok {
+			return false
+		}
+
+		ev := CreateAuthStatusUpdate(checker.CheckAuth(ctx), am.refreshable())
+
+		if ctx.Err() == nil {
+			cur, err := json.Marshal(ev)
+			if err != nil {
+				logger.WithErr(err).Errorf("unable to marshal auth status")
+				return false
+			}
+
+			if !bytes.Equal(previous, cur) {
+				previous = cur
+				s.Send(ev)
+			}
+		}
+
+		return false
+	}
+}
+
+// CreateAuthStatusUpdate creates an auth status event. refreshable is true if
+// the client may refresh the credentials.
+func CreateAuthStatusUpdate(status cluster.AuthStatus, refreshable bool) event.Event {
+	return event.CreateEvent(event.EventTypeAuthStatus, action.Payload{
+		"failed":      status.Failed,
+		"message":     status.Message,
+		"plugin":      status.Plugin,
+		"command":     status.Command,
+		"stderr":      status.Stderr,
+		"refreshable": refreshable,
+	})
+}
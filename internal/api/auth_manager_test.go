@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/api/fake"
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+	octantFake "github.com/vmware-tanzu/octant/internal/octant/fake"
+	"github.com/vmware-tanzu/octant/pkg/action"
+)
+
+type authClusterClient struct {
+	*clusterFake.MockClientInterface
+
+	status  cluster.AuthStatus
+	refresh cluster.AuthStatus
+}
+
+var _ cluster.AuthChecker = (*authClusterClient)(nil)
+
+func (c *authClusterClient) AuthStatus() cluster.AuthStatus {
+	return c.status
+}
+
+func (c *authClusterClient) CheckAuth(ctx context.Context) cluster.AuthStatus {
+	return c.status
+}
+
+func (c *authClusterClient) RefreshCredentials(ctx context.Context) cluster.AuthStatus {
+	return c.refresh
+}
+
+func TestAuthManager_Handlers(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+
+	manager := api.NewAuthManager(dashConfig)
+	AssertHandlers(t, manager, []string{api.RequestRefreshCredentials})
+}
+
+func TestAuthManager_Start(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	status := cluster.AuthStatus{
+		Failed:  true,
+		Message: "getting credentials: exec: executable aws failed with exit code 255",
+		Plugin:  "exec",
+		Command: "aws eks get-token",
+		Stderr:  "token expired",
+	}
+	client := &authClusterClient{status: status}
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Logger().Return(log.NopLogger()).AnyTimes()
+	dashConfig.EXPECT().ClusterClient().Return(client)
+
+	state := octantFake.NewMockState(controller)
+	octantClient := fake.NewMockOctantClient(controller)
+	octantClient.EXPECT().Send(api.CreateAuthStatusUpdate(status, true))
+
+	manager := api.NewAuthManager(dashConfig, api.WithAuthPoller(api.NewSingleRunPoller()))
+	manager.Start(context.Background(), state, octantClient)
+}
+
+func TestAuthManager_RefreshCredentials(t *testing.T) {
+	tests := []struct {
+		name       string
+		refresh    cluster.AuthStatus
+		useContext bool
+		alertType  action.AlertType
+	}{
+		{
+			name:       "refreshed",
+			refresh:    cluster.AuthStatus{Plugin: "exec"},
+			useContext: true,
+			alertType:  action.AlertTypeSuccess,
+		},
+		{
+			name:      "plugin failed",
+			refresh:   cluster.AuthStatus{Failed: true, Message: "login required"},
+			alertType: action.AlertTypeError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			client := &authClusterClient{refresh: test.refresh}
+
+			dashConfig := configFake.NewMockDash(controller)
+			dashConfig.EXPECT().ClusterClient().Return(client)
+			if test.useContext {
+				dashConfig.EXPECT().CurrentContext().Return("eks")
+				dashConfig.EXPECT().UseContext(gomock.Any(), "eks").Return(nil)
+			}
+
+			alerts := make(chan action.Alert, 1)
+			state := octantFake.NewMockState(controller)
+			state.EXPECT().SendAlert(gomock.Any()).Do(func(alert action.Alert) {
+				alerts <- alert
+			})
+
+			manager := api.NewAuthManager(dashConfig)
+			require.NoError(t, manager.RefreshCredentials(state, action.Payload{}))
+
+			select {
+			case alert := <-alerts:
+				require.Equal(t, test.alertType, alert.Type)
+			case <-time.After(5 * time.Second):
+				t.Fatal("credentials were not refreshed")
+			}
+		})
+	}
+}
+
+func TestAuthManager_RefreshCredentials_impersonating(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	status := cluster.AuthStatus{Failed: true, Message: "login required", Plugin: "exec"}
+	client := &authClusterClient{status: status}
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Logger().Return(log.NopLogger()).AnyTimes()
+	dashConfig.EXPECT().ClusterClient().Return(client)
+
+	state := octantFake.NewMockState(controller)
+	octantClient := fake.NewMockOctantClient(controller)
+	octantClient.EXPECT().Send(api.CreateAuthStatusUpdate(status, false))
+
+	ctx := cluster.WithIdentity(context.Background(), cluster.Identity{User: "alice"})
+	manager := api.NewAuthManager(dashConfig, api.WithAuthPoller(api.NewSingleRunPoller()))
+	manager.Start(ctx, state, octantClient)
+
+	require.Error(t, manager.RefreshCredentials(state, action.Payload{}), "impersonated users share Octant's credentials")
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/vmware-tanzu/octant/pkg/store"
 
 	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/metrics"
 	"github.com/vmware-tanzu/octant/internal/modules/overview/container"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/pkg/action"
@@ -180,6 +181,7 @@ func (s *podLogsStateManager) startStream(key store.Key, logStreamer container.L
 	go s.streamEventsToClient(ctx, eventType, logCh)
 
 	logStreamer.Stream(ctx, logCh)
+	metrics.TrackStream(ctx, metrics.StreamLogs)
 
 	return cancelFn
 }
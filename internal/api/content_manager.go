@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"hash/fnv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/vmware-tanzu/octant/internal/util/json"
@@ -23,15 +24,21 @@ import (
 	oerrors "github.com/vmware-tanzu/octant/internal/errors"
 	"github.com/vmware-tanzu/octant/internal/event"
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/metrics"
 	"github.com/vmware-tanzu/octant/internal/module"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
 const (
 	RequestSetContentPath = "action.octant.dev/setContentPath"
+	// RequestContentResync is sent by clients which can't apply a content patch.
+	RequestContentResync = "action.octant.dev/contentResync"
+	// RequestSetListPage is sent by clients to view another page of a paged list.
+	RequestSetListPage = "action.octant.dev/setListPage"
 )
 
 // ContentManagerOption is an option for configuring ContentManager.
@@ -84,6 +91,10 @@ type ContentManager struct {
 	contentGenerateFunc ContentGenerateFunc
 	poller              Poller
 	updateContentCh     chan struct{}
+	patcher             *contentPatcher
+
+	mu      sync.Mutex
+	stopped bool
 }
 
 // NewContentManager creates an instance of ContentManager.
@@ -94,6 +105,7 @@ func NewContentManager(moduleManager module.ManagerInterface, dashConfig config.
 		logger:          logger,
 		poller:          NewInterruptiblePoller("content"),
 		updateContentCh: make(chan struct{}, 1),
+		patcher:         &contentPatcher{},
 	}
 	cm.contentGenerateFunc = cm.generateContent
 
@@ -114,7 +126,10 @@ func (cm *ContentManager) Start(ctx context.Context, state octant.State, s Octan
 
 	defer func() {
 		logger.Debugf("stopping content manager")
+		cm.mu.Lock()
+		cm.stopped = true
 		close(cm.updateContentCh)
+		cm.mu.Unlock()
 	}()
 
 	ctx, cancel := context.WithCancel(ctx)
@@ -129,10 +144,33 @@ func (cm *ContentManager) Start(ctx context.Context, state octant.State, s Octan
 		cancel()
 	}()
 
-	cm.poller.Run(ctx, cm.updateContentCh, cm.runUpdate(state, s), event.DefaultScheduleDelay)
+	resetDuration := event.DefaultScheduleDelay
+
+	var watcher *contentWatcher
+	if notifier, ok := cm.dashConfig.ObjectStore().(store.Notifier); ok {
+		// Content is regenerated when the objects it was generated from change,
+		// so polling is only a fallback.
+		resetDuration = contentFallbackDelay
+		watcher = newContentWatcher(notifier)
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			watcher.Run(ctx, cm.updateContentCh)
+		}()
+
+		defer func() {
+			cancel()
+			wg.Wait()
+			watcher.Stop()
+		}()
+	}
+
+	cm.poller.Run(ctx, cm.updateContentCh, cm.runUpdate(state, s, watcher), resetDuration)
 }
 
-func (cm *ContentManager) runUpdate(state octant.State, s OctantClient) PollerFunc {
+func (cm *ContentManager) runUpdate(state octant.State, s OctantClient, watcher *contentWatcher) PollerFunc {
 	previousChecksum := ""
 
 	return func(ctx context.Context) bool {
@@ -141,7 +179,11 @@ func (cm *ContentManager) runUpdate(state octant.State, s OctantClient) PollerFu
 			return false
 		}
 
-		content, _, err := cm.contentGenerateFunc(ctx, state)
+		recorder := store.NewKeyRecorder()
+		content, _, err := cm.contentGenerateFunc(store.WithKeyRecorder(ctx, recorder), state)
+		if watcher != nil {
+			watcher.Watch(recorder)
+		}
 		if err != nil {
 			var ae *oerrors.AccessError
 			if errors.As(err, &ae) {
@@ -160,14 +202,22 @@ func (cm *ContentManager) runUpdate(state octant.State, s OctantClient) PollerFu
 		}
 
 		checksum := content.Checksum()
-		if checksum == previousChecksum {
+		if checksum == previousChecksum && !cm.patcher.NeedsResync() {
 			return false
 		}
 		previousChecksum = checksum
 
 		if ctx.Err() == nil {
 			if content.Path == state.GetContentPath() {
-				s.Send(CreateContentEvent(content.Response, state.GetNamespace(), contentPath, state.GetQueryParams()))
+				ev, err := cm.patcher.Event(content, state.GetNamespace(), state.GetQueryParams())
+				if err != nil {
+					cm.logger.
+						WithErr(err).
+						With("content-path", contentPath).
+						Errorf("create content event")
+					return false
+				}
+				s.Send(ev)
 			}
 
 		}
@@ -190,8 +240,12 @@ func (cm *ContentManager) generateContent(ctx context.Context, state octant.Stat
 		return emptyContent, false, fmt.Errorf("unable to find module for content path %q", contentPath)
 	}
 	modulePath := strings.TrimPrefix(contentPath, m.Name())
+	pathTemplate := contentPathTemplate(m, contentPath, state.GetNamespace())
+	defer metrics.ObserveDuration(metrics.ContentGenerationDuration.WithLabelValues(m.Name(), pathTemplate), now)
 	options := module.ContentOptions{
-		LabelSet: FiltersToLabelSet(state.GetFilters()),
+		LabelSet:     FiltersToLabelSet(state.GetFilters()),
+		ListContinue: state.GetListContinue(),
+		Namespaces:   state.GetView().Namespaces,
 	}
 
 	pluginState := ocontext.ClientState{
@@ -239,6 +293,14 @@ func (cm *ContentManager) Handlers() []octant.ClientRequestHandler {
 			RequestType: CheckLoading,
 			Handler:     cm.Loaded,
 		},
+		{
+			RequestType: RequestContentResync,
+			Handler:     cm.Resync,
+		},
+		{
+			RequestType: RequestSetListPage,
+			Handler:     cm.SetListPage,
+		},
 	}
 }
 
@@ -282,6 +344,37 @@ func (cm *ContentManager) SetContentPath(state octant.State, payload action.Payl
 	return nil
 }
 
+// SetListPage sets the continue token of the page of a paged list to view.
+// An empty token is the first page.
+func (cm *ContentManager) SetListPage(state octant.State, payload action.Payload) error {
+	token, err := payload.OptionalString("continue")
+	if err != nil {
+		return fmt.Errorf("extract continue from payload: %w", err)
+	}
+	state.SetListContinue(token)
+	return nil
+}
+
+// Resync sends full content to the client. Clients request it when they miss a
+// content patch.
+func (cm *ContentManager) Resync(state octant.State, payload action.Payload) error {
+	cm.patcher.Resync()
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.stopped {
+		return nil
+	}
+
+	select {
+	case cm.updateContentCh <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
 // Loaded is no-op once content is serving
 func (cm *ContentManager) Loaded(state octant.State, payload action.Payload) error {
 	return nil
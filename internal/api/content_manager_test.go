@@ -7,10 +7,14 @@ package api_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/vmware-tanzu/octant/internal/api"
 	"github.com/vmware-tanzu/octant/internal/api/fake"
@@ -21,8 +25,11 @@ import (
 	moduleFake "github.com/vmware-tanzu/octant/internal/module/fake"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	octantFake "github.com/vmware-tanzu/octant/internal/octant/fake"
+	"github.com/vmware-tanzu/octant/internal/preferences"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -40,6 +47,8 @@ func TestContentManager_Handlers(t *testing.T) {
 		api.RequestSetContentPath,
 		action.RequestSetNamespace,
 		api.CheckLoading,
+		api.RequestContentResync,
+		api.RequestSetListPage,
 	})
 }
 
@@ -56,8 +65,11 @@ func TestContentManager_GenerateContent(t *testing.T) {
 	state := octantFake.NewMockState(controller)
 
 	dashConfig.EXPECT().CurrentContext().Return("foo-context")
+	dashConfig.EXPECT().ObjectStore().Return(storeFake.NewMockStore(controller))
 	state.EXPECT().GetClientID().Return("foo-client")
 	state.EXPECT().GetFilters().Return(filters).AnyTimes()
+	state.EXPECT().GetListContinue().Return("page-2")
+	state.EXPECT().GetView().Return(preferences.View{Name: "foo", Namespaces: []string{"foo-namespace", "bar-namespace"}})
 	state.EXPECT().GetNamespace().Return("foo-namespace").AnyTimes()
 	state.EXPECT().GetQueryParams().Return(params)
 	state.EXPECT().GetContentPath().Return(".").AnyTimes()
@@ -71,14 +83,18 @@ func TestContentManager_GenerateContent(t *testing.T) {
 
 	contentResponse := component.ContentResponse{}
 	contentEvent := api.CreateContentEvent(contentResponse, "foo-namespace", ".", params)
+	contentEvent.Data.(map[string]interface{})["sequence"] = 1
 	octantClient.EXPECT().Send(contentEvent).AnyTimes()
 	octantClient.EXPECT().StopCh().Return(stopCh).AnyTimes()
 
 	moduleManager.EXPECT().ModuleForContentPath(gomock.Any()).Return(fakeModule, true)
 	moduleManager.EXPECT().Navigation(gomock.Any(), "foo-namespace", "foo-module").Return([]navigation.Navigation{}, nil)
 	fakeModule.EXPECT().Name().Return("foo-module").AnyTimes()
+	fakeModule.EXPECT().GvkFromPath(gomock.Any(), "foo-namespace").Return(schema.GroupVersionKind{}, errors.New("not a list")).AnyTimes()
 	fakeModule.EXPECT().Content(gomock.Any(), ".", gomock.Any()).
-		Do(func(ctx context.Context, _ string, _ module.ContentOptions) {
+		Do(func(ctx context.Context, _ string, options module.ContentOptions) {
+			require.Equal(t, "page-2", options.ListContinue)
+			require.Equal(t, []string{"foo-namespace", "bar-namespace"}, options.Namespaces)
 			clientState := ocontext.ClientStateFrom(ctx)
 			require.Equal(t, "foo-namespace", clientState.Namespace)
 			require.Equal(t, "foo", clientState.Filters[0].Key)
@@ -99,6 +115,88 @@ func TestContentManager_GenerateContent(t *testing.T) {
 	manager.Start(ctx, state, octantClient)
 }
 
+func TestContentManager_Start_regeneratesOnChange(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+	objectStore := &notifyingStore{
+		Store:    storeFake.NewMockStore(controller),
+		notifyCh: make(chan func(), 2),
+		stopped:  make(chan bool, 2),
+	}
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore)
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+
+	state := octantFake.NewMockState(controller)
+	state.EXPECT().GetContentPath().Return("/path").AnyTimes()
+	state.EXPECT().GetNamespace().Return("default").AnyTimes()
+	state.EXPECT().GetQueryParams().Return(nil).AnyTimes()
+	state.EXPECT().OnContentPathUpdate(gomock.Any()).Return(func() {})
+
+	stopCh := make(chan struct{})
+	sent := make(chan bool, 2)
+	octantClient := fake.NewMockOctantClient(controller)
+	octantClient.EXPECT().StopCh().Return(stopCh).AnyTimes()
+	octantClient.EXPECT().Send(gomock.Any()).Do(func(interface{}) {
+		sent <- true
+	}).Times(2)
+
+	generated := 0
+	generate := func(ctx context.Context, state octant.State) (api.Content, bool, error) {
+		generated++
+		store.RecordKey(ctx, key)
+		return api.Content{
+			Response: component.ContentResponse{Title: component.TitleFromString(fmt.Sprintf("%d", generated))},
+			Path:     "/path",
+		}, false, nil
+	}
+
+	manager := api.NewContentManager(moduleManager, dashConfig, log.NopLogger(),
+		api.WithContentGenerator(generate))
+
+	done := make(chan bool)
+	go func() {
+		manager.Start(context.Background(), state, octantClient)
+		done <- true
+	}()
+
+	<-sent
+	notify := <-objectStore.notifyCh
+	require.Equal(t, []store.Key{key}, objectStore.keys)
+
+	notify()
+	select {
+	case <-sent:
+	case <-time.After(5 * time.Second):
+		t.Fatal("content was not regenerated after a change")
+	}
+
+	close(stopCh)
+	<-done
+	require.True(t, <-objectStore.stopped)
+}
+
+type notifyingStore struct {
+	store.Store
+
+	keys     []store.Key
+	notifyCh chan func()
+	stopped  chan bool
+}
+
+var _ store.Notifier = (*notifyingStore)(nil)
+
+func (s *notifyingStore) Notify(keys []store.Key, fn func()) func() {
+	s.keys = keys
+	s.notifyCh <- fn
+	return func() {
+		s.stopped <- true
+	}
+}
+
 func TestContentManager_SetContentPath(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -124,6 +222,28 @@ func TestContentManager_SetContentPath(t *testing.T) {
 	require.NoError(t, manager.SetContentPath(state, payload))
 }
 
+func TestContentManager_SetListPage(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+	dashConfig := configFake.NewMockDash(controller)
+
+	state := octantFake.NewMockState(controller)
+	state.EXPECT().SetListContinue("page-2")
+
+	logger := log.NopLogger()
+
+	manager := api.NewContentManager(moduleManager, dashConfig, logger,
+		api.WithContentGeneratorPoller(api.NewSingleRunPoller()))
+
+	payload := action.Payload{
+		"continue": "page-2",
+	}
+
+	require.NoError(t, manager.SetListPage(state, payload))
+}
+
 func TestContentManager_SetNamespace(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
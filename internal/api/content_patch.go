@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+	"github.com/vmware-tanzu/octant/internal/util/jsonpatch"
+	oevent "github.com/vmware-tanzu/octant/pkg/event"
+)
+
+// contentPatcher tracks the content last sent to a client so changes can be
+// sent as JSON Patch operations instead of full content.
+type contentPatcher struct {
+	mu       sync.Mutex
+	sequence int
+	path     string
+	previous interface{}
+	resync   bool
+}
+
+// Resync makes the next event contain full content.
+func (p *contentPatcher) Resync() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.resync = true
+}
+
+// NeedsResync returns true if full content was requested.
+func (p *contentPatcher) NeedsResync() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.resync
+}
+
+// Event creates the event for content. It is a patch event if the client has
+// content for the same path and the patch is smaller than the content.
+func (p *contentPatcher) Event(content Content, namespace string, queryParams map[string][]string) (oevent.Event, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	data, err := json.Marshal(content.Response)
+	if err != nil {
+		return oevent.Event{}, fmt.Errorf("encode content: %w", err)
+	}
+
+	current, err := jsonpatch.Decode(data)
+	if err != nil {
+		return oevent.Event{}, fmt.Errorf("decode content: %w", err)
+	}
+
+	previous := p.previous
+	canPatch := !p.resync && previous != nil && p.path == content.Path
+
+	p.sequence++
+	p.previous = current
+	p.path = content.Path
+	p.resync = false
+
+	if canPatch {
+		patch := jsonpatch.Create(previous, current)
+		patchData, err := json.Marshal(patch)
+		if err != nil {
+			return oevent.Event{}, fmt.Errorf("encode content patch: %w", err)
+		}
+
+		if len(patchData) < len(data) {
+			return CreateContentPatchEvent(p.sequence, patch, namespace, content.Path, queryParams), nil
+		}
+	}
+
+	event := CreateContentEvent(content.Response, namespace, content.Path, queryParams)
+	event.Data.(map[string]interface{})["sequence"] = p.sequence
+	return event, nil
+}
+
+// CreateContentPatchEvent creates a content patch event. The patch applies to the
+// content sent in the event with the previous sequence number.
+func CreateContentPatchEvent(sequence int, patch []jsonpatch.Operation, namespace, contentPath string, queryParams map[string][]string) oevent.Event {
+	return oevent.Event{
+		Type: oevent.EventTypeContentPatch,
+		Data: map[string]interface{}{
+			"sequence":    sequence,
+			"patch":       patch,
+			"namespace":   namespace,
+			"contentPath": contentPath,
+			"queryParams": queryParams,
+		},
+	}
+}
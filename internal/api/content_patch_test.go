@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/util/jsonpatch"
+	oevent "github.com/vmware-tanzu/octant/pkg/event"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestContentPatcher_Event(t *testing.T) {
+	p := &contentPatcher{}
+
+	table := component.NewTableWithRows("Pods", "none", component.NewTableCols("Name"), nil)
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		table.Add(component.TableRow{"Name": component.NewText(name)})
+	}
+	content := func(path string) Content {
+		cr := component.NewContentResponse(component.TitleFromString("Pods"))
+		cr.Add(table)
+		return Content{Response: *cr, Path: path}
+	}
+
+	ev, err := p.Event(content("/pods"), "default", nil)
+	require.NoError(t, err)
+	assert.Equal(t, oevent.EventTypeContent, ev.Type)
+	assert.Equal(t, 1, ev.Data.(map[string]interface{})["sequence"])
+
+	table.Add(component.TableRow{"Name": component.NewText("f")})
+	ev, err = p.Event(content("/pods"), "default", nil)
+	require.NoError(t, err)
+	require.Equal(t, oevent.EventTypeContentPatch, ev.Type)
+
+	data := ev.Data.(map[string]interface{})
+	assert.Equal(t, 2, data["sequence"])
+	assert.Equal(t, "/pods", data["contentPath"])
+	patch := data["patch"].([]jsonpatch.Operation)
+	require.Len(t, patch, 1)
+	assert.Equal(t, jsonpatch.OpAdd, patch[0].Op)
+	assert.Equal(t, "/viewComponents/0/config/rows/5", patch[0].Path)
+
+	ev, err = p.Event(content("/other"), "default", nil)
+	require.NoError(t, err)
+	assert.Equal(t, oevent.EventTypeContent, ev.Type, "new content path")
+	assert.Equal(t, 3, ev.Data.(map[string]interface{})["sequence"])
+
+	p.Resync()
+	assert.True(t, p.NeedsResync())
+	ev, err = p.Event(content("/other"), "default", nil)
+	require.NoError(t, err)
+	assert.Equal(t, oevent.EventTypeContent, ev.Type, "resync")
+	assert.False(t, p.NeedsResync())
+}
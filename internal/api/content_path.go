@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/vmware-tanzu/octant/internal/module"
+)
+
+// namespacePathPattern matches the namespace at the start of a module path.
+var namespacePathPattern = regexp.MustCompile(`^/namespace/[^/]+`)
+
+const namespacePathTemplate = "/namespace/{namespace}"
+
+// contentPathTemplate returns the module path of a content path with its
+// namespace and object name replaced by placeholders, so it can label metrics
+// without creating a series for every object. Paths which aren't a list of a
+// kind or one of its objects only keep their first segment.
+func contentPathTemplate(m module.Module, contentPath, namespace string) string {
+	template := namespacePathPattern.ReplaceAllString(strings.TrimPrefix(contentPath, m.Name()), namespacePathTemplate)
+
+	if _, err := m.GvkFromPath(contentPath, namespace); err == nil {
+		return template
+	}
+	if _, err := m.GvkFromPath(path.Dir(contentPath), namespace); err == nil {
+		return path.Join(path.Dir(template), "{name}")
+	}
+
+	rest := strings.TrimPrefix(template, namespacePathTemplate)
+	segments := strings.SplitN(strings.TrimPrefix(rest, "/"), "/", 2)
+	if len(segments) < 2 {
+		return template
+	}
+	return template[:len(template)-len(rest)] + "/" + segments[0] + "/*"
+}
@@ -0,0 +1,71 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	moduleFake "github.com/vmware-tanzu/octant/internal/module/fake"
+)
+
+func Test_contentPathTemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentPath string
+		want        string
+	}{
+		{
+			name:        "list",
+			contentPath: "overview/namespace/default/workloads/pods",
+			want:        "/namespace/{namespace}/workloads/pods",
+		},
+		{
+			name:        "object",
+			contentPath: "overview/namespace/default/workloads/pods/web-1",
+			want:        "/namespace/{namespace}/workloads/pods/{name}",
+		},
+		{
+			name:        "namespace overview",
+			contentPath: "overview/namespace/default",
+			want:        "/namespace/{namespace}",
+		},
+		{
+			name:        "section",
+			contentPath: "overview/namespace/default/workloads",
+			want:        "/namespace/{namespace}/workloads",
+		},
+		{
+			name:        "unknown",
+			contentPath: "overview/namespace/default/custom-resources/foos.example.com/v1/foo",
+			want:        "/namespace/{namespace}/custom-resources/*",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			m := moduleFake.NewMockModule(controller)
+			m.EXPECT().Name().Return("overview").AnyTimes()
+			m.EXPECT().GvkFromPath(gomock.Any(), "default").
+				DoAndReturn(func(contentPath, _ string) (schema.GroupVersionKind, error) {
+					if contentPath == "overview/namespace/default/workloads/pods" {
+						return gvk.Pod, nil
+					}
+					return schema.GroupVersionKind{}, errors.New("unknown path")
+				}).AnyTimes()
+
+			assert.Equal(t, test.want, contentPathTemplate(m, test.contentPath, "default"))
+		})
+	}
+}
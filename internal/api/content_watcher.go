@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const (
+	// contentFallbackDelay is how often content is regenerated when the object store
+	// reports changes. It refreshes content built from sources the object store can't
+	// track, like plugins.
+	contentFallbackDelay = 15 * time.Second
+	// contentCoalesceDelay is how long changes are collected before content is regenerated.
+	contentCoalesceDelay = 250 * time.Millisecond
+)
+
+// contentWatcher watches the objects content was generated from and requests
+// new content when they change. Objects are watched in the store they were
+// read from, so content built from other contexts' stores follows them.
+type contentWatcher struct {
+	// notifier watches keys which were recorded without a store.
+	notifier store.Notifier
+	changeCh chan struct{}
+	delay    time.Duration
+
+	keys    map[store.Notifier]string
+	cancels []func()
+}
+
+func newContentWatcher(notifier store.Notifier) *contentWatcher {
+	return &contentWatcher{
+		notifier: notifier,
+		changeCh: make(chan struct{}, 1),
+		delay:    contentCoalesceDelay,
+	}
+}
+
+// Watch replaces the watched keys with the keys in recorder.
+func (w *contentWatcher) Watch(recorder *store.KeyRecorder) {
+	sources := recorder.Sources()
+	if keys, ok := sources[nil]; ok {
+		delete(sources, nil)
+		sources[w.notifier] = append(sources[w.notifier], keys...)
+	}
+
+	signatures := make(map[store.Notifier]string, len(sources))
+	for notifier, keys := range sources {
+		names := make([]string, len(keys))
+		for i := range keys {
+			names[i] = keys[i].String()
+		}
+		sort.Strings(names)
+		signatures[notifier] = strings.Join(names, "\n")
+	}
+
+	if w.cancels != nil && sameSignatures(signatures, w.keys) {
+		return
+	}
+	w.Stop()
+
+	w.keys = signatures
+	w.cancels = []func(){}
+	for notifier, keys := range sources {
+		w.cancels = append(w.cancels, notifier.Notify(keys, func() {
+			select {
+			case w.changeCh <- struct{}{}:
+			default:
+			}
+		}))
+	}
+}
+
+func sameSignatures(a, b map[store.Notifier]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for notifier, signature := range a {
+		if other, ok := b[notifier]; !ok || other != signature {
+			return false
+		}
+	}
+	return true
+}
+
+// Stop stops watching.
+func (w *contentWatcher) Stop() {
+	for _, cancel := range w.cancels {
+		cancel()
+	}
+	w.cancels = nil
+}
+
+// Run sends to updateCh after objects change. Changes made within the delay
+// are coalesced so a burst of changes results in a single update.
+func (w *contentWatcher) Run(ctx context.Context, updateCh chan<- struct{}) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.changeCh:
+		}
+
+		timer := time.NewTimer(w.delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		select {
+		case <-w.changeCh:
+		default:
+		}
+
+		select {
+		case updateCh <- struct{}{}:
+		default:
+		}
+	}
+}
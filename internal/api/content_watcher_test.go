@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+type fakeNotifier struct {
+	watched  []store.Key
+	fn       func()
+	canceled int
+}
+
+func (n *fakeNotifier) Notify(keys []store.Key, fn func()) func() {
+	n.watched = keys
+	n.fn = fn
+	return func() {
+		n.canceled++
+	}
+}
+
+func Test_contentWatcher_Watch(t *testing.T) {
+	pods := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+	services := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Service"}
+	nodes := store.Key{APIVersion: "v1", Kind: "Node"}
+
+	current := &fakeNotifier{}
+	other := &fakeNotifier{}
+	w := newContentWatcher(current)
+
+	recorder := store.NewKeyRecorder()
+	recorder.RecordFrom(current, pods)
+	recorder.RecordFrom(other, services)
+	recorder.Record(nodes)
+
+	w.Watch(recorder)
+	assert.Equal(t, []store.Key{pods, nodes}, current.watched, "keys without a store are watched in the current store")
+	assert.Equal(t, []store.Key{services}, other.watched, "keys are watched in the store they were read from")
+
+	other.fn()
+	assert.Len(t, w.changeCh, 1)
+
+	w.Watch(recorder)
+	assert.Equal(t, 0, current.canceled+other.canceled, "the same keys are not watched again")
+
+	recorder.RecordFrom(other, nodes)
+	w.Watch(recorder)
+	assert.Equal(t, 1, current.canceled)
+	assert.Equal(t, 1, other.canceled)
+	assert.Equal(t, []store.Key{nodes, services}, other.watched)
+
+	w.Stop()
+	assert.Equal(t, 2, other.canceled)
+}
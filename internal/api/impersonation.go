@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	"github.com/vmware-tanzu/octant/internal/cluster"
+)
+
+// Impersonation configures how authenticated users are mapped to the Kubernetes
+// identities that cluster calls are made as.
+type Impersonation struct {
+	// UserPrefix is prepended to user names.
+	UserPrefix string
+	// GroupPrefix is prepended to the groups from the identity provider.
+	GroupPrefix string
+	// Groups are added to every user.
+	Groups []string
+}
+
+// Identity returns the Kubernetes identity for an authenticated user.
+func (i *Impersonation) Identity(user auth.User) cluster.Identity {
+	identity := cluster.Identity{User: i.UserPrefix + user.Name}
+	for _, group := range user.Groups {
+		identity.Groups = append(identity.Groups, i.GroupPrefix+group)
+	}
+	identity.Groups = append(identity.Groups, i.Groups...)
+
+	return identity
+}
+
+// Middleware adds the authenticated user's identity to request contexts.
+// Requests without a user are rejected so they can't act as Octant.
+func (i *Impersonation) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, ok := auth.UserFrom(r.Context())
+		if !ok || user.Name == "" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		ctx := cluster.WithIdentity(r.Context(), i.Identity(user))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
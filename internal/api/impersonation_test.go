@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	"github.com/vmware-tanzu/octant/internal/cluster"
+)
+
+func TestImpersonation_Identity(t *testing.T) {
+	i := &Impersonation{UserPrefix: "octant:", GroupPrefix: "oidc:", Groups: []string{"octant-users"}}
+
+	got := i.Identity(auth.User{Name: "alice", Groups: []string{"dev"}})
+	expected := cluster.Identity{User: "octant:alice", Groups: []string{"oidc:dev", "octant-users"}}
+	assert.Equal(t, expected, got)
+}
+
+func TestImpersonation_Middleware(t *testing.T) {
+	i := &Impersonation{}
+
+	var got cluster.Identity
+	handler := i.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, _ = cluster.IdentityFrom(r.Context())
+	}))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusUnauthorized, rr.Code, "requests need a user")
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req = req.WithContext(auth.WithUser(req.Context(), auth.User{Name: "alice"}))
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "alice", got.User)
+}
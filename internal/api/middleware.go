@@ -19,7 +19,10 @@ import (
 	dashstrings "github.com/vmware-tanzu/octant/internal/util/strings"
 )
 
-var defaultPorts = map[string]string{"http": "80", "https": "443"}
+var defaultPorts = map[string]string{"http": "80", "https": "443", "ws": "80", "wss": "443"}
+
+// secureSchemes are origin schemes allowed when the request was made over TLS.
+var secureSchemes = map[string]bool{"https": true, "wss": true}
 
 // equalASCIIFold returns true if s is equal to t with ASCII case folding as
 // defined in RFC 4790. (Source: https://github.com/gorilla/websocket/blob/master/util.go#L176)
@@ -56,6 +59,11 @@ func checkSameOrigin(r *http.Request) bool {
 	if err != nil {
 		return false
 	}
+	// A page loaded over plain HTTP is a different origin than the dashboard
+	// served over HTTPS.
+	if r.TLS != nil && !secureSchemes[u.Scheme] {
+		return false
+	}
 	if equalASCIIFold(u.Host, r.Host) {
 		return true
 	}
@@ -7,6 +7,7 @@ package api
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -110,6 +111,7 @@ func Test_checkSameOrigin(t *testing.T) {
 		name     string
 		host     string
 		origin   string
+		tls      bool
 		expected bool
 	}{
 		{
@@ -124,6 +126,27 @@ func Test_checkSameOrigin(t *testing.T) {
 			origin:   "http://127.0.0.1:7777",
 			expected: false,
 		},
+		{
+			name:     "https origin over tls",
+			host:     "192.168.1.1:7777",
+			origin:   "https://192.168.1.1:7777",
+			tls:      true,
+			expected: true,
+		},
+		{
+			name:     "https origin with default port over tls",
+			host:     "octant.example.com:443",
+			origin:   "https://octant.example.com",
+			tls:      true,
+			expected: true,
+		},
+		{
+			name:     "http origin over tls",
+			host:     "192.168.1.1:7777",
+			origin:   "http://192.168.1.1:7777",
+			tls:      true,
+			expected: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -133,6 +156,9 @@ func Test_checkSameOrigin(t *testing.T) {
 				Header: make(http.Header, 1),
 			}
 			r.Header.Set("Origin", tc.origin)
+			if tc.tls {
+				r.TLS = &tls.ConnectionState{}
+			}
 			require.Equal(t, tc.expected, checkSameOrigin(r))
 		})
 	}
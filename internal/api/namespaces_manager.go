@@ -114,12 +114,16 @@ func (n *NamespacesManager) runUpdate(state octant.State, client OctantClient) P
 }
 
 // NamespacesGenerator generates a list of namespaces.
-func NamespacesGenerator(_ context.Context, config NamespaceManagerConfig) ([]string, error) {
+func NamespacesGenerator(ctx context.Context, config NamespaceManagerConfig) ([]string, error) {
 	if config == nil {
 		return nil, errors.New("namespaces manager config is nil")
 	}
 
-	clusterClient := config.ClusterClient()
+	clusterClient, err := cluster.ClientFor(ctx, config.ClusterClient())
+	if err != nil {
+		return nil, errors.Wrap(err, "retrieve cluster client")
+	}
+
 	namespaceClient, err := clusterClient.NamespaceClient()
 	if err != nil {
 		return nil, errors.Wrap(err, "retrieve namespaces client")
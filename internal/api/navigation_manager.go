@@ -147,6 +147,10 @@ func NavigationGenerator(ctx context.Context, state octant.State, config Navigat
 			if err != nil {
 				return fmt.Errorf("unable to generate navigation for module %s: %v", m.Name(), err)
 			}
+			if len(navList) == 0 {
+				// modules without navigation are not shown
+				return nil
+			}
 			navList[0].Module = m.Name()
 			navList[0].Description = m.Description()
 
@@ -85,6 +85,28 @@ func TestNavigationGenerator(t *testing.T) {
 				{Title: "module", Module: "module", Description: "description"},
 			},
 		},
+		{
+			name: "module without navigation",
+			setup: func(controller *gomock.Controller) (*configFake.MockDash, *octantFake.MockState) {
+				m := moduleFake.NewMockModule(controller)
+				m.EXPECT().ContentPath().Return("/module")
+				m.EXPECT().Name().Return("module").AnyTimes()
+				m.EXPECT().
+					Navigation(gomock.Any(), "default", "/module").
+					Return(nil, nil)
+
+				moduleManager := moduleFake.NewMockManagerInterface(controller)
+				moduleManager.EXPECT().Modules().Return([]module.Module{m})
+
+				dashConfig := configFake.NewMockDash(controller)
+				dashConfig.EXPECT().ModuleManager().Return(moduleManager)
+
+				state := octantFake.NewMockState(controller)
+				state.EXPECT().GetNamespace().Return("default")
+
+				return dashConfig, state
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -71,24 +71,27 @@ func (a *InterruptiblePoller) Run(ctx context.Context, ch <-chan struct{}, actio
 		func() {
 			cur, cancel := context.WithCancel(log.WithLoggerContext(ctx, logger))
 			defer cancel()
-			canceled := false
 
 			select {
 			case <-ctx.Done():
 				logger.Debugf("poller has been canceled")
 				done = true
 			case <-ch:
-				canceled = true
 				logger.Debugf("poller was interrupted")
+				// Run the action now instead of waiting for the timer.
+				if !timer.Stop() {
+					select {
+					case <-timer.C:
+					default:
+					}
+				}
+				timer.Reset(0)
 			case <-timer.C:
 				logger.Debugf("poller is running action")
 				now := time.Now()
 				action(cur)
 				logger.With("elapsed", fmt.Sprintf("%s", time.Since(now))).
 					Debugf("poller ran action")
-			}
-
-			if !canceled {
 				timer.Reset(resetDuration)
 			}
 
@@ -0,0 +1,327 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/event"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/internal/util/json"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	oevent "github.com/vmware-tanzu/octant/pkg/event"
+)
+
+const (
+	// RequestSetFavoriteNamespace is sent by clients to add or remove a
+	// favorite namespace.
+	RequestSetFavoriteNamespace = "action.octant.dev/setFavoriteNamespace"
+	// RequestSetHiddenColumns is sent by clients to save the hidden columns of
+	// a table.
+	RequestSetHiddenColumns = "action.octant.dev/setHiddenColumns"
+	// RequestSaveView is sent by clients to save a view.
+	RequestSaveView = "action.octant.dev/saveView"
+	// RequestDeleteView is sent by clients to delete a view.
+	RequestDeleteView = "action.octant.dev/deleteView"
+	// RequestSetView is sent by clients to select a view.
+	RequestSetView = "action.octant.dev/setView"
+)
+
+// PreferencesManagerOption is an option for configuring PreferencesManager.
+type PreferencesManagerOption func(manager *PreferencesManager)
+
+// WithPreferencesPoller sets the poller.
+func WithPreferencesPoller(poller Poller) PreferencesManagerOption {
+	return func(manager *PreferencesManager) {
+		manager.poller = poller
+	}
+}
+
+// PreferencesManager sends the current context's preferences to clients and
+// saves the preferences clients change.
+type PreferencesManager struct {
+	ctx        context.Context
+	dashConfig config.Dash
+	poller     Poller
+	updateCh   chan struct{}
+}
+
+var _ StateManager = (*PreferencesManager)(nil)
+
+// NewPreferencesManager creates an instance of PreferencesManager.
+func NewPreferencesManager(dashConfig config.Dash, options ...PreferencesManagerOption) *PreferencesManager {
+	pm := &PreferencesManager{
+		dashConfig: dashConfig,
+		poller:     NewInterruptiblePoller("preferences"),
+		updateCh:   make(chan struct{}, 1),
+	}
+
+	for _, option := range options {
+		option(pm)
+	}
+
+	return pm
+}
+
+// Handlers returns a slice of handlers.
+func (pm *PreferencesManager) Handlers() []octant.ClientRequestHandler {
+	return []octant.ClientRequestHandler{
+		{
+			RequestType: RequestSetFavoriteNamespace,
+			Handler:     pm.SetFavoriteNamespace,
+		},
+		{
+			RequestType: RequestSetHiddenColumns,
+			Handler:     pm.SetHiddenColumns,
+		},
+		{
+			RequestType: RequestSaveView,
+			Handler:     pm.SaveView,
+		},
+		{
+			RequestType: RequestDeleteView,
+			Handler:     pm.DeleteView,
+		},
+		{
+			RequestType: RequestSetView,
+			Handler:     pm.SetView,
+		},
+	}
+}
+
+// SetFavoriteNamespace adds or removes a favorite namespace.
+func (pm *PreferencesManager) SetFavoriteNamespace(state octant.State, payload action.Payload) error {
+	namespace, err := payload.String("namespace")
+	if err != nil {
+		return fmt.Errorf("extract namespace from payload: %w", err)
+	}
+
+	favorite, err := payload.Bool("favorite")
+	if err != nil {
+		return fmt.Errorf("extract favorite from payload: %w", err)
+	}
+
+	return pm.update(func(prefs *preferences.Context) {
+		var favorites []string
+		for _, existing := range prefs.FavoriteNamespaces {
+			if existing != namespace {
+				favorites = append(favorites, existing)
+			}
+		}
+		if favorite {
+			favorites = append(favorites, namespace)
+		}
+		prefs.FavoriteNamespaces = favorites
+	})
+}
+
+// SetHiddenColumns saves the hidden columns of a table. Tables are
+// identified by their title.
+func (pm *PreferencesManager) SetHiddenColumns(state octant.State, payload action.Payload) error {
+	table, err := payload.String("table")
+	if err != nil {
+		return fmt.Errorf("extract table from payload: %w", err)
+	}
+
+	var columns []string
+	if _, ok := payload["columns"]; ok {
+		columns, err = payload.StringSlice("columns")
+		if err != nil {
+			return fmt.Errorf("extract columns from payload: %w", err)
+		}
+	}
+
+	return pm.update(func(prefs *preferences.Context) {
+		prefs.SetHiddenColumns(table, columns)
+	})
+}
+
+// SaveView saves the current filters and a group of namespaces as a view and
+// selects it. The view's namespaces are the current namespace if none are
+// given.
+func (pm *PreferencesManager) SaveView(state octant.State, payload action.Payload) error {
+	name, err := payload.String("name")
+	if err != nil {
+		return fmt.Errorf("extract name from payload: %w", err)
+	}
+	if name == "" {
+		return fmt.Errorf("view name is blank")
+	}
+
+	var namespaces []string
+	if _, ok := payload["namespaces"]; ok {
+		namespaces, err = payload.StringSlice("namespaces")
+		if err != nil {
+			return fmt.Errorf("extract namespaces from payload: %w", err)
+		}
+	}
+	if len(namespaces) == 0 {
+		namespaces = []string{state.GetNamespace()}
+	}
+
+	view := preferences.View{
+		Name:       name,
+		Namespaces: namespaces,
+	}
+	for _, filter := range state.GetFilters() {
+		view.Filters = append(view.Filters, preferences.Filter{Key: filter.Key, Value: filter.Value})
+	}
+
+	if err := pm.update(func(prefs *preferences.Context) {
+		prefs.SaveView(view)
+	}); err != nil {
+		return err
+	}
+
+	pm.setView(state, view)
+	message := fmt.Sprintf("Saved view %s", name)
+	state.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+// DeleteView deletes a view. If the view is selected, no view is selected.
+func (pm *PreferencesManager) DeleteView(state octant.State, payload action.Payload) error {
+	name, err := payload.String("name")
+	if err != nil {
+		return fmt.Errorf("extract name from payload: %w", err)
+	}
+
+	if err := pm.update(func(prefs *preferences.Context) {
+		prefs.DeleteView(name)
+	}); err != nil {
+		return err
+	}
+
+	if state.GetView().Name == name {
+		pm.setView(state, preferences.View{})
+	}
+	message := fmt.Sprintf("Deleted view %s", name)
+	state.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+// SetView selects a saved view. A blank name clears the selection.
+func (pm *PreferencesManager) SetView(state octant.State, payload action.Payload) error {
+	name, err := payload.String("name")
+	if err != nil {
+		return fmt.Errorf("extract name from payload: %w", err)
+	}
+
+	var view preferences.View
+	if name != "" {
+		var ok bool
+		view, ok = pm.dashConfig.Preferences().Get(pm.dashConfig.CurrentContext()).FindView(name)
+		if !ok {
+			return fmt.Errorf("view %q does not exist", name)
+		}
+	}
+
+	pm.setView(state, view)
+	return nil
+}
+
+// setView selects a view and tells plugins and clients the filters and
+// preferences changed.
+func (pm *PreferencesManager) setView(state octant.State, view preferences.View) {
+	state.SetView(view)
+	dispatchFilters(pm.ctx, state)
+	pm.notify()
+}
+
+func (pm *PreferencesManager) update(fn func(prefs *preferences.Context)) error {
+	if err := pm.dashConfig.Preferences().Update(pm.dashConfig.CurrentContext(), fn); err != nil {
+		return fmt.Errorf("save preferences: %w", err)
+	}
+
+	pm.notify()
+	return nil
+}
+
+// notify sends the preferences to the client.
+func (pm *PreferencesManager) notify() {
+	select {
+	case pm.updateCh <- struct{}{}:
+	default:
+	}
+}
+
+// Start starts the manager.
+func (pm *PreferencesManager) Start(ctx context.Context, state octant.State, s OctantClient) {
+	pm.ctx = ctx
+	pm.poller.Run(ctx, pm.updateCh, pm.runUpdate(s), event.DefaultScheduleDelay)
+}
+
+func (pm *PreferencesManager) runUpdate(s OctantClient) PollerFunc {
+	var previous []byte
+
+	logger := pm.dashConfig.Logger()
+	return func(ctx context.Context) bool {
+		contextName := pm.dashConfig.CurrentContext()
+		ev := CreateContextPreferencesUpdate(contextName, pm.dashConfig.Preferences().Get(contextName), pm.dashConfig)
+
+		if ctx.Err() == nil {
+			cur, err := json.Marshal(ev)
+			if err != nil {
+				logger.WithErr(err).Errorf("unable to marshal preferences")
+				return false
+			}
+
+			if !bytes.Equal(previous, cur) {
+				previous = cur
+				s.Send(ev)
+			}
+		}
+
+		return false
+	}
+}
+
+// PinnedObject is a pinned object and the path to view it.
+type PinnedObject struct {
+	preferences.ObjectReference
+	Path string `json:"path"`
+}
+
+// CreateContextPreferencesUpdate creates a context preferences event. Pinned
+// objects without a path are skipped.
+func CreateContextPreferencesUpdate(contextName string, prefs preferences.Context, linkGenerator octant.LinkGenerator) oevent.Event {
+	pinned := make([]PinnedObject, 0, len(prefs.PinnedObjects))
+	for _, ref := range prefs.PinnedObjects {
+		objectPath, err := linkGenerator.ObjectPath(ref.Namespace, ref.APIVersion, ref.Kind, ref.Name)
+		if err != nil {
+			continue
+		}
+		pinned = append(pinned, PinnedObject{ObjectReference: ref, Path: objectPath})
+	}
+
+	favorites := prefs.FavoriteNamespaces
+	if favorites == nil {
+		favorites = make([]string, 0)
+	}
+
+	views := prefs.Views
+	if views == nil {
+		views = make([]preferences.View, 0)
+	}
+
+	hiddenColumns := prefs.HiddenColumns
+	if hiddenColumns == nil {
+		hiddenColumns = make(map[string][]string)
+	}
+
+	return oevent.CreateEvent(oevent.EventTypeContextPreferences, action.Payload{
+		"context":            contextName,
+		"favoriteNamespaces": favorites,
+		"pinnedObjects":      pinned,
+		"hiddenColumns":      hiddenColumns,
+		"views":              views,
+		"view":               prefs.View,
+	})
+}
@@ -0,0 +1,181 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/api/fake"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	octantFake "github.com/vmware-tanzu/octant/internal/octant/fake"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/event"
+)
+
+func newPreferencesDashConfig(t *testing.T, controller *gomock.Controller) (*configFake.MockDash, *preferences.FileStore) {
+	prefs, err := preferences.NewFileStore("")
+	require.NoError(t, err)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Preferences().Return(prefs).AnyTimes()
+	dashConfig.EXPECT().CurrentContext().Return("dev").AnyTimes()
+	dashConfig.EXPECT().Logger().Return(log.NopLogger()).AnyTimes()
+
+	return dashConfig, prefs
+}
+
+func TestPreferencesManager_Handlers(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+
+	manager := api.NewPreferencesManager(dashConfig)
+	AssertHandlers(t, manager, []string{
+		api.RequestSetFavoriteNamespace,
+		api.RequestSetHiddenColumns,
+		api.RequestSaveView,
+		api.RequestDeleteView,
+		api.RequestSetView,
+	})
+}
+
+func TestPreferencesManager_SetFavoriteNamespace(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig, prefs := newPreferencesDashConfig(t, controller)
+	state := octantFake.NewMockState(controller)
+
+	manager := api.NewPreferencesManager(dashConfig)
+
+	for _, payload := range []action.Payload{
+		{"namespace": "frontend", "favorite": true},
+		{"namespace": "backend", "favorite": true},
+		{"namespace": "frontend", "favorite": true},
+		{"namespace": "backend", "favorite": false},
+	} {
+		require.NoError(t, manager.SetFavoriteNamespace(state, payload))
+	}
+
+	assert.Equal(t, []string{"frontend"}, prefs.Get("dev").FavoriteNamespaces)
+	require.Error(t, manager.SetFavoriteNamespace(state, action.Payload{"namespace": "frontend"}))
+}
+
+func TestPreferencesManager_SetHiddenColumns(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig, prefs := newPreferencesDashConfig(t, controller)
+	state := octantFake.NewMockState(controller)
+
+	manager := api.NewPreferencesManager(dashConfig)
+
+	payload := action.Payload{"table": "Pods", "columns": []interface{}{"Node", "Age"}}
+	require.NoError(t, manager.SetHiddenColumns(state, payload))
+	assert.Equal(t, map[string][]string{"Pods": {"Node", "Age"}}, prefs.Get("dev").HiddenColumns)
+
+	require.NoError(t, manager.SetHiddenColumns(state, action.Payload{"table": "Pods"}))
+	assert.Empty(t, prefs.Get("dev").HiddenColumns)
+}
+
+func TestPreferencesManager_views(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig, prefs := newPreferencesDashConfig(t, controller)
+
+	payments := preferences.View{
+		Name:       "payments",
+		Namespaces: []string{"payments-a", "payments-b"},
+		Filters:    []preferences.Filter{{Key: "team", Value: "payments"}},
+	}
+
+	state := octantFake.NewMockState(controller)
+	state.EXPECT().GetFilters().Return([]octant.Filter{{Key: "team", Value: "payments"}}).AnyTimes()
+	state.EXPECT().Dispatch(gomock.Any(), action.RequestSetFilter, gomock.Any()).AnyTimes()
+	state.EXPECT().SendAlert(gomock.Any()).AnyTimes()
+
+	manager := api.NewPreferencesManager(dashConfig)
+
+	state.EXPECT().SetView(payments)
+	require.NoError(t, manager.SaveView(state, action.Payload{
+		"name":       "payments",
+		"namespaces": []interface{}{"payments-a", "payments-b"},
+	}))
+	assert.Equal(t, []preferences.View{payments}, prefs.Get("dev").Views)
+
+	state.EXPECT().GetNamespace().Return("search")
+	state.EXPECT().SetView(gomock.Any())
+	require.NoError(t, manager.SaveView(state, action.Payload{"name": "search"}))
+	search, ok := prefs.Get("dev").FindView("search")
+	require.True(t, ok)
+	assert.Equal(t, []string{"search"}, search.Namespaces, "views default to the current namespace")
+
+	require.Error(t, manager.SaveView(state, action.Payload{"name": ""}))
+
+	state.EXPECT().SetView(payments)
+	require.NoError(t, manager.SetView(state, action.Payload{"name": "payments"}))
+	state.EXPECT().SetView(preferences.View{})
+	require.NoError(t, manager.SetView(state, action.Payload{"name": ""}))
+	require.Error(t, manager.SetView(state, action.Payload{"name": "missing"}))
+
+	state.EXPECT().GetView().Return(payments)
+	state.EXPECT().SetView(preferences.View{})
+	require.NoError(t, manager.DeleteView(state, action.Payload{"name": "payments"}))
+	_, ok = prefs.Get("dev").FindView("payments")
+	assert.False(t, ok)
+}
+
+func TestPreferencesManager_Start(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig, prefs := newPreferencesDashConfig(t, controller)
+
+	pod := preferences.ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "pod"}
+	missing := preferences.ObjectReference{APIVersion: "example.com/v1", Kind: "Missing", Name: "missing"}
+	require.NoError(t, prefs.Update("dev", func(c *preferences.Context) {
+		c.FavoriteNamespaces = []string{"default"}
+		c.Pin(pod)
+		c.Pin(missing)
+		c.SaveView(preferences.View{Name: "default", Namespaces: []string{"default"}})
+		c.View = "default"
+	}))
+
+	dashConfig.EXPECT().ObjectPath("default", "v1", "Pod", "pod").Return("/overview/namespace/default/workloads/pods/pod", nil)
+	dashConfig.EXPECT().ObjectPath("", "example.com/v1", "Missing", "missing").Return("", fmt.Errorf("unknown kind"))
+
+	expected := event.CreateEvent(event.EventTypeContextPreferences, action.Payload{
+		"context":            "dev",
+		"favoriteNamespaces": []string{"default"},
+		"pinnedObjects": []api.PinnedObject{
+			{ObjectReference: pod, Path: "/overview/namespace/default/workloads/pods/pod"},
+		},
+		"hiddenColumns": map[string][]string{},
+		"views": []preferences.View{
+			{Name: "default", Namespaces: []string{"default"}},
+		},
+		"view": "default",
+	})
+
+	state := octantFake.NewMockState(controller)
+	octantClient := fake.NewMockOctantClient(controller)
+	octantClient.EXPECT().Send(expected)
+
+	manager := api.NewPreferencesManager(dashConfig, api.WithPreferencesPoller(api.NewSingleRunPoller()))
+	manager.Start(context.Background(), state, octantClient)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/event"
+	"github.com/vmware-tanzu/octant/internal/link"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/search"
+	"github.com/vmware-tanzu/octant/internal/util/json"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	oevent "github.com/vmware-tanzu/octant/pkg/event"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// RequestSearch is sent by clients to search cached objects.
+	RequestSearch = "action.octant.dev/search"
+
+	// searchResultLimit is the most results sent to a client.
+	searchResultLimit = 50
+)
+
+// SearchManagerOption is an option for configuring SearchManager.
+type SearchManagerOption func(manager *SearchManager)
+
+// WithSearchPoller sets the poller.
+func WithSearchPoller(poller Poller) SearchManagerOption {
+	return func(manager *SearchManager) {
+		manager.poller = poller
+	}
+}
+
+// SearchManager searches cached objects for a client. The client's last
+// query is searched again periodically so results follow the cache.
+type SearchManager struct {
+	dashConfig config.Dash
+	poller     Poller
+	query      *atomicString
+	updateCh   chan struct{}
+}
+
+var _ StateManager = (*SearchManager)(nil)
+
+// NewSearchManager creates an instance of SearchManager.
+func NewSearchManager(dashConfig config.Dash, options ...SearchManagerOption) *SearchManager {
+	sm := &SearchManager{
+		dashConfig: dashConfig,
+		poller:     NewInterruptiblePoller("search"),
+		query:      newStringValue(""),
+		updateCh:   make(chan struct{}, 1),
+	}
+
+	for _, option := range options {
+		option(sm)
+	}
+
+	return sm
+}
+
+// Handlers returns a slice of handlers.
+func (sm *SearchManager) Handlers() []octant.ClientRequestHandler {
+	return []octant.ClientRequestHandler{
+		{
+			RequestType: RequestSearch,
+			Handler:     sm.Search,
+		},
+	}
+}
+
+// Search sets the client's query and sends its results.
+func (sm *SearchManager) Search(state octant.State, payload action.Payload) error {
+	query, err := payload.String("query")
+	if err != nil {
+		return fmt.Errorf("extract query from payload: %w", err)
+	}
+
+	sm.query.set(query)
+
+	select {
+	case sm.updateCh <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Start starts the manager.
+func (sm *SearchManager) Start(ctx context.Context, state octant.State, s OctantClient) {
+	sm.poller.Run(ctx, sm.updateCh, sm.runUpdate(s), event.DefaultScheduleDelay)
+}
+
+func (sm *SearchManager) runUpdate(s OctantClient) PollerFunc {
+	var previous []byte
+
+	logger := sm.dashConfig.Logger()
+	return func(ctx context.Context) bool {
+		query := sm.query.get()
+		if query == "" && previous == nil {
+			return false
+		}
+
+		ev := CreateSearchResultsEvent(ctx, query, sm.dashConfig.Search(), sm.dashConfig)
+
+		if ctx.Err() == nil {
+			cur, err := json.Marshal(ev)
+			if err != nil {
+				logger.WithErr(err).Errorf("unable to marshal search results")
+				return false
+			}
+
+			if !bytes.Equal(previous, cur) {
+				previous = cur
+				s.Send(ev)
+			}
+		}
+
+		return false
+	}
+}
+
+// SearchResult is an object which matched a search and a link to it.
+type SearchResult struct {
+	Link       *component.Link `json:"link"`
+	APIVersion string          `json:"apiVersion"`
+	Kind       string          `json:"kind"`
+	Namespace  string          `json:"namespace,omitempty"`
+	Name       string          `json:"name"`
+	Score      int             `json:"score"`
+}
+
+// CreateSearchResultsEvent creates a search results event. Objects Octant
+// can't link to, or which the identity in ctx can't list, are skipped.
+func CreateSearchResultsEvent(ctx context.Context, query string, searcher search.Searcher, linkConfig link.Config) oevent.Event {
+	results := make([]SearchResult, 0)
+	payload := action.Payload{
+		"query":   query,
+		"results": results,
+	}
+
+	q, err := search.ParseQuery(query)
+	if err != nil {
+		payload["error"] = err.Error()
+		return oevent.CreateEvent(oevent.EventTypeSearchResults, payload)
+	}
+
+	linkGenerator, err := link.NewFromDashConfig(linkConfig)
+	if err != nil {
+		payload["error"] = err.Error()
+		return oevent.CreateEvent(oevent.EventTypeSearchResults, payload)
+	}
+
+	if searcher != nil {
+		for _, result := range searcher.Search(ctx, q, searchResultLimit) {
+			l, err := linkGenerator.ForObject(result.Object(), result.Name)
+			if err != nil {
+				continue
+			}
+
+			results = append(results, SearchResult{
+				Link:       l,
+				APIVersion: result.APIVersion,
+				Kind:       result.Kind,
+				Namespace:  result.Namespace,
+				Name:       result.Name,
+				Score:      result.Score,
+			})
+		}
+	}
+	payload["results"] = results
+
+	return oevent.CreateEvent(oevent.EventTypeSearchResults, payload)
+}
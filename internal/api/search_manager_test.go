@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/api/fake"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+	octantFake "github.com/vmware-tanzu/octant/internal/octant/fake"
+	"github.com/vmware-tanzu/octant/internal/search"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/event"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func newSearchIndex(names ...string) *search.Index {
+	index := search.NewIndex()
+	handler := index.Handler("pods")
+	for _, name := range names {
+		pod := &unstructured.Unstructured{}
+		pod.SetAPIVersion("v1")
+		pod.SetKind("Pod")
+		pod.SetNamespace("default")
+		pod.SetName(name)
+		handler.OnAdd(pod)
+	}
+	return index
+}
+
+func TestSearchManager_Handlers(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+
+	manager := api.NewSearchManager(dashConfig)
+	AssertHandlers(t, manager, []string{api.RequestSearch})
+}
+
+func TestSearchManager_Search(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Search().Return(newSearchIndex("web-1", "api-1"))
+	dashConfig.EXPECT().Logger().Return(log.NopLogger())
+	dashConfig.EXPECT().
+		ObjectPath("default", "v1", "Pod", "web-1").
+		Return("/overview/namespace/default/workloads/pods/web-1", nil)
+
+	expected := event.CreateEvent(event.EventTypeSearchResults, action.Payload{
+		"query": "web",
+		"results": []api.SearchResult{
+			{
+				Link:       component.NewLink("", "web-1", "/overview/namespace/default/workloads/pods/web-1"),
+				APIVersion: "v1",
+				Kind:       "Pod",
+				Namespace:  "default",
+				Name:       "web-1",
+				Score:      60,
+			},
+		},
+	})
+
+	state := octantFake.NewMockState(controller)
+	octantClient := fake.NewMockOctantClient(controller)
+	octantClient.EXPECT().Send(expected)
+
+	manager := api.NewSearchManager(dashConfig, api.WithSearchPoller(api.NewSingleRunPoller()))
+	require.NoError(t, manager.Search(state, action.Payload{"query": "web"}))
+	require.Error(t, manager.Search(state, action.Payload{}))
+
+	manager.Start(context.Background(), state, octantClient)
+}
+
+func TestCreateSearchResultsEvent(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().
+		ObjectPath("default", "v1", "Pod", "web-1").
+		Return("", fmt.Errorf("no path"))
+
+	got := api.CreateSearchResultsEvent(context.Background(), "web", newSearchIndex("web-1"), dashConfig)
+	require.Equal(t, event.CreateEvent(event.EventTypeSearchResults, action.Payload{
+		"query":   "web",
+		"results": []api.SearchResult{},
+	}), got)
+
+	got = api.CreateSearchResultsEvent(context.Background(), "label:=web", newSearchIndex("web-1"), dashConfig)
+	require.Equal(t, event.CreateEvent(event.EventTypeSearchResults, action.Payload{
+		"query":   "label:=web",
+		"results": []api.SearchResult{},
+		"error":   `parse label "=web": key is blank`,
+	}), got)
+}
@@ -19,10 +19,15 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/vmware-tanzu/octant/internal/audit"
+	"github.com/vmware-tanzu/octant/internal/cluster"
 	"github.com/vmware-tanzu/octant/internal/config"
+	ocontext "github.com/vmware-tanzu/octant/internal/context"
 	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/metrics"
 	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/readonly"
 	"github.com/vmware-tanzu/octant/internal/terminal"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/store"
@@ -82,6 +87,10 @@ func (s *terminalStateManager) Handlers() []octant.ClientRequestHandler {
 }
 
 func (s *terminalStateManager) SetActiveTerminal(state octant.State, payload action.Payload) error {
+	if readonly.Enabled(s.ctx) {
+		return readonly.ErrReadOnly
+	}
+
 	namespace, err := payload.String("namespace")
 	if err != nil {
 		return fmt.Errorf("getting namespace from payload: %w", err)
@@ -142,12 +151,39 @@ func (s *terminalStateManager) SetActiveTerminal(state octant.State, payload act
 		}
 	}
 
-	cancelFn := s.startStream(po, key, containerName)
+	cancelFn, err := s.startStream(po, key, containerName)
 	s.terminalSubscriptions.Store(eventType, cancelFn)
+	s.recordSession(state, key, containerName, err)
 	return nil
 }
 
-func (s *terminalStateManager) startStream(pod *corev1.Pod, key store.Key, container string) context.CancelFunc {
+// recordSession records the start of a terminal session in the audit log.
+// Terminal requests are not dispatched by the action manager, so they are not
+// recorded with other actions.
+func (s *terminalStateManager) recordSession(state octant.State, key store.Key, container string, err error) {
+	auditLog := audit.LogFrom(s.ctx)
+	if auditLog == nil {
+		return
+	}
+
+	ctx := ocontext.WithClientState(s.ctx, ocontext.ClientState{
+		ClientID:    state.GetClientID(),
+		Namespace:   key.Namespace,
+		ContextName: s.config.CurrentContext(),
+	})
+	payload := action.Payload{
+		"apiVersion":    key.APIVersion,
+		"kind":          key.Kind,
+		"name":          key.Name,
+		"namespace":     key.Namespace,
+		"containerName": container,
+	}
+	auditLog.Record(ctx, RequestActiveTerminal, payload, err)
+}
+
+// startStream starts a shell in a container. It returns an error if no shell
+// could be started.
+func (s *terminalStateManager) startStream(pod *corev1.Pod, key store.Key, container string) (context.CancelFunc, error) {
 	ctx, cancelFn := context.WithCancel(s.ctx)
 	logger := log.From(s.ctx).With("startStream", container)
 
@@ -158,8 +194,16 @@ func (s *terminalStateManager) startStream(pod *corev1.Pod, key store.Key, conta
 		commands = []string{"powershell", "cmd"}
 	}
 
+	clusterClient, err := cluster.ClientFor(ctx, s.config.ClusterClient())
+	if err != nil {
+		logger.WithErr(err).Errorf("get cluster client")
+		cancelFn()
+		return cancelFn, err
+	}
+
+	started := false
 	for _, command := range commands {
-		validInstance, err := terminal.NewTerminalInstance(ctx, s.config.ClusterClient(), logger, key, container, command, s.chanInstance)
+		validInstance, err := terminal.NewTerminalInstance(ctx, clusterClient, logger, key, container, command, s.chanInstance)
 		if err != nil {
 			logger.Debugf("streaming: %+v", err)
 			continue
@@ -167,15 +211,18 @@ func (s *terminalStateManager) startStream(pod *corev1.Pod, key store.Key, conta
 
 		if validInstance != nil {
 			s.instance = validInstance
+			metrics.TrackStream(ctx, metrics.StreamTerminal)
 			go s.sendTerminalEvents(ctx, eventType, s.instance, s.chanInstance)
+			started = true
 			break
 		}
 	}
 
-	if s.instance == nil {
+	if !started {
 		cancelFn()
+		return cancelFn, fmt.Errorf("no shell found in container %s", container)
 	}
-	return cancelFn
+	return cancelFn, nil
 }
 
 func (s *terminalStateManager) SendTerminalResize(state octant.State, payload action.Payload) error {
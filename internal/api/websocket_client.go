@@ -19,8 +19,11 @@ import (
 	"github.com/gorilla/websocket"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/vmware-tanzu/octant/internal/auth"
+	"github.com/vmware-tanzu/octant/internal/cluster"
 	"github.com/vmware-tanzu/octant/internal/config"
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/metrics"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/log"
@@ -80,7 +83,15 @@ func NewWebsocketClient(ctx context.Context, conn *websocket.Conn, manager *Webs
 		stopCh:     make(chan struct{}, 1),
 	}
 
-	state := NewWebsocketState(dashConfig, actionDispatcher, client)
+	var options []WebsocketStateOption
+	if identity, ok := cluster.IdentityFrom(ctx); ok {
+		options = append(options, WebsocketStateIdentity(identity))
+	}
+	if user, ok := auth.UserFrom(ctx); ok {
+		options = append(options, WebsocketStateUser(user))
+	}
+
+	state := NewWebsocketState(dashConfig, actionDispatcher, client, options...)
 	go state.Start(ctx)
 
 	client.state = state
@@ -164,6 +175,7 @@ func (c *WebsocketClient) readPump() {
 			c.cancel()
 			break
 		}
+		metrics.WebsocketMessageSize.WithLabelValues(metrics.DirectionReceived).Observe(float64(len(message)))
 
 		if err := c.handle(message); err != nil {
 			c.logger.WithErr(err).Errorf("Handle websocket message")
@@ -254,6 +266,7 @@ func (c *WebsocketClient) writePump() {
 				c.logger.WithErr(err).Errorf("Write websocket response")
 				return
 			}
+			metrics.WebsocketMessageSize.WithLabelValues(metrics.DirectionSent).Observe(float64(len(data)))
 
 			if err := w.Close(); err != nil {
 				c.logger.WithErr(err).Errorf("Close websocket writer")
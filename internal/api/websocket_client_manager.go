@@ -13,7 +13,10 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/vmware-tanzu/octant/internal/auth"
+	"github.com/vmware-tanzu/octant/internal/cluster"
 	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/metrics"
 )
 
 //go:generate mockgen -destination=./fake/mock_client_manager.go -package=fake github.com/vmware-tanzu/octant/internal/api ClientManager
@@ -81,10 +84,12 @@ func (m *WebsocketClientManager) Run(ctx context.Context) {
 			done = true
 		case meta := <-m.register:
 			m.clients[meta.client] = meta.cancelFunc
+			metrics.WebsocketClients.Set(float64(len(m.clients)))
 		case client := <-m.unregister:
 			if cancelFunc, ok := m.clients[client]; ok {
 				cancelFunc()
 				delete(m.clients, client)
+				metrics.WebsocketClients.Set(float64(len(m.clients)))
 			}
 		case <-m.requestList:
 			clients := []*WebsocketClient{}
@@ -109,6 +114,12 @@ func (m *WebsocketClientManager) ClientFromRequest(dashConfig config.Dash, w htt
 	}
 
 	ctx, cancel := context.WithCancel(m.ctx)
+	if user, ok := auth.UserFrom(r.Context()); ok {
+		ctx = auth.WithUser(ctx, user)
+	}
+	if identity, ok := cluster.IdentityFrom(r.Context()); ok {
+		ctx = cluster.WithIdentity(ctx, identity)
+	}
 	client := NewWebsocketClient(ctx, conn, m, dashConfig, m.actionDispatcher, clientID)
 	m.register <- &clientMeta{
 		cancelFunc: func() {
@@ -13,6 +13,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/vmware-tanzu/octant/internal/util/path_util"
 
@@ -20,14 +21,21 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/vmware-tanzu/octant/internal/auth"
+	"github.com/vmware-tanzu/octant/internal/cluster"
 	"github.com/vmware-tanzu/octant/internal/config"
 	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/preferences"
 	"github.com/vmware-tanzu/octant/pkg/action"
 )
 
 //go:generate mockgen -destination=./fake/mock_state_manager.go -package=fake github.com/vmware-tanzu/octant/internal/api StateManager
 //go:generate mockgen -destination=./fake/mock_octant_client.go -package=fake github.com/vmware-tanzu/octant/internal/api OctantClient
 
+// defaultContentPathSaveDelay is how long a page has to stay open before it is
+// saved as the last visited page.
+const defaultContentPathSaveDelay = 2 * time.Second
+
 var (
 	reContentPathNamespace = regexp.MustCompile(`^/namespace/(?P<namespace>[^/]+)/?`)
 )
@@ -48,6 +56,9 @@ func defaultStateManagers(clientID string, dashConfig config.Dash) []StateManage
 		NewNavigationManager(dashConfig),
 		NewNamespacesManager(dashConfig),
 		NewContextManager(dashConfig),
+		NewAuthManager(dashConfig),
+		NewPreferencesManager(dashConfig),
+		NewSearchManager(dashConfig),
 		NewActionRequestManager(dashConfig),
 		NewTerminalStateManager(dashConfig),
 		NewPodLogsStateManager(dashConfig),
@@ -97,6 +108,30 @@ func WebsocketStateManagers(managers []StateManager) WebsocketStateOption {
 	}
 }
 
+// WebsocketStateIdentity makes actions dispatched by WebsocketState call the
+// cluster as identity.
+func WebsocketStateIdentity(identity cluster.Identity) WebsocketStateOption {
+	return func(w *WebsocketState) {
+		w.identity = &identity
+	}
+}
+
+// WebsocketStateUser sets the authenticated user for actions dispatched by
+// WebsocketState.
+func WebsocketStateUser(user auth.User) WebsocketStateOption {
+	return func(w *WebsocketState) {
+		w.user = &user
+	}
+}
+
+// WebsocketStateContentPathSaveDelay sets how long a page has to stay open
+// before it is saved as the last visited page.
+func WebsocketStateContentPathSaveDelay(delay time.Duration) WebsocketStateOption {
+	return func(w *WebsocketState) {
+		w.contentPathSaveDelay = delay
+	}
+}
+
 // WebsocketState manages state for a websocket client.
 type WebsocketState struct {
 	dashConfig         config.Dash
@@ -104,18 +139,27 @@ type WebsocketState struct {
 	contentPath        *atomicString
 	namespace          *atomicString
 	filters            []octant.Filter
+	view               preferences.View
+	listContinue       *atomicString
 	contentPathUpdates map[string]octant.ContentPathUpdateFunc
 	namespaceUpdates   map[string]octant.NamespaceUpdateFunc
 
 	mu               sync.RWMutex
 	managers         []StateManager
 	actionDispatcher ActionDispatcher
+	identity         *cluster.Identity
+	user             *auth.User
 
 	startCtx           context.Context
 	managersCancelFunc context.CancelFunc
+
+	contentPathSaveDelay time.Duration
+	contentPathSaveMu    sync.Mutex
+	contentPathSave      *time.Timer
 }
 
 var _ octant.State = (*WebsocketState)(nil)
+var _ action.Previewer = (*WebsocketState)(nil)
 
 // NewWebsocketState creates an instance of WebsocketState.
 func NewWebsocketState(dashConfig config.Dash, actionDispatcher ActionDispatcher, wsClient OctantClient, options ...WebsocketStateOption) *WebsocketState {
@@ -129,13 +173,24 @@ func NewWebsocketState(dashConfig config.Dash, actionDispatcher ActionDispatcher
 		namespace:          newStringValue(defaultNamespace),
 		contentPath:        newStringValue(""),
 		filters:            make([]octant.Filter, 0),
+		listContinue:       newStringValue(""),
 		actionDispatcher:   actionDispatcher,
+
+		contentPathSaveDelay: defaultContentPathSaveDelay,
 	}
 
 	for _, option := range options {
 		option(w)
 	}
 
+	// Clients start where the user left off in the current context.
+	prefs := w.navigationPreferences()
+	if prefs.Namespace != "" {
+		w.namespace.set(prefs.Namespace)
+	}
+	w.filters = filtersFromPreferences(prefs.Filters)
+	w.view, _ = prefs.FindView(prefs.View)
+
 	if len(w.managers) < 1 {
 		w.managers = defaultStateManagers(wsClient.ID(), dashConfig)
 	}
@@ -148,6 +203,7 @@ func NewTemporaryWebsocketState(actionDispatcher ActionDispatcher, wsClient Octa
 		wsClient:           wsClient,
 		contentPathUpdates: make(map[string]octant.ContentPathUpdateFunc),
 		namespaceUpdates:   make(map[string]octant.NamespaceUpdateFunc),
+		listContinue:       newStringValue(""),
 		actionDispatcher:   actionDispatcher,
 	}
 
@@ -184,14 +240,26 @@ func (c *WebsocketState) Handlers() []octant.ClientRequestHandler {
 
 // Dispatch dispatches a message.
 func (c *WebsocketState) Dispatch(ctx context.Context, actionName string, payload action.Payload) error {
+	if c.identity != nil {
+		ctx = cluster.WithIdentity(ctx, *c.identity)
+	}
+	if c.user != nil {
+		ctx = auth.WithUser(ctx, *c.user)
+	}
 	return c.actionDispatcher.Dispatch(ctx, c, actionName, payload)
 }
 
-// SetContentPath sets the content path.
+// SetContentPath sets the content path. A blank content path is the last
+// visited page of the current context for a new client, and the overview of
+// the current namespace otherwise.
 func (c *WebsocketState) SetContentPath(contentPath string) {
 	if contentPath == "" {
-		contentPath = path_util.NamespacedPath("overview", c.namespace.get())
-		contentPath = path.Join("overview", "namespace", c.namespace.get())
+		if saved := c.navigationPreferences().ContentPath; saved != "" && c.contentPath.get() == "" {
+			contentPath = saved
+		} else {
+			contentPath = path_util.NamespacedPath("overview", c.namespace.get())
+			contentPath = path.Join("overview", "namespace", c.namespace.get())
+		}
 	} else if c.contentPath.get() == contentPath {
 		return
 	}
@@ -201,6 +269,8 @@ func (c *WebsocketState) SetContentPath(contentPath string) {
 		Debugf("setting content path")
 
 	c.contentPath.set(contentPath)
+	c.listContinue.set("")
+	c.saveContentPath(contentPath)
 
 	m, ok := c.dashConfig.ModuleManager().ModuleForContentPath(contentPath)
 	if !ok {
@@ -263,6 +333,9 @@ func (c *WebsocketState) SetNamespace(namespace string) {
 		With("namespace", namespace).
 		Debugf("setting namespace")
 	c.namespace.set(namespace)
+	c.updateNavigationPreferences(func(prefs *preferences.Context) {
+		prefs.Namespace = namespace
+	})
 
 	newPath := updateContentPathNamespace(c.contentPath.get(), namespace)
 	if newPath != c.contentPath.get() {
@@ -308,6 +381,8 @@ func (c *WebsocketState) AddFilter(filter octant.Filter) {
 	}
 
 	c.filters = append(c.filters, filter)
+	c.listContinue.set("")
+	c.saveFilters()
 }
 
 // RemoveFilter removes a content filter.
@@ -325,6 +400,8 @@ func (c *WebsocketState) RemoveFilter(filter octant.Filter) {
 	}
 
 	c.filters = newFilters
+	c.listContinue.set("")
+	c.saveFilters()
 }
 
 // GetFilters returns all filters.
@@ -344,9 +421,57 @@ func (c *WebsocketState) SetFilters(filters []octant.Filter) {
 	defer c.mu.Unlock()
 
 	c.filters = filters
+	c.listContinue.set("")
+	c.saveFilters()
+}
+
+// GetListContinue returns the continue token of the page of a paged list.
+func (c *WebsocketState) GetListContinue() string {
+	return c.listContinue.get()
 }
 
-// SetContext sets the Kubernetes context.
+// SetListContinue sets the continue token of the page of a paged list and
+// regenerates the current content.
+func (c *WebsocketState) SetListContinue(token string) {
+	c.listContinue.set(token)
+
+	for _, fn := range c.contentPathUpdates {
+		fn(c.GetContentPath())
+	}
+}
+
+// GetView returns the selected view.
+func (c *WebsocketState) GetView() preferences.View {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.view
+}
+
+// SetView selects a view and regenerates the current content. The filters
+// are replaced with the view's filters and, if the current namespace isn't
+// one of the view's namespaces, the view's first namespace is selected.
+func (c *WebsocketState) SetView(view preferences.View) {
+	c.mu.Lock()
+	c.view = view
+	c.mu.Unlock()
+
+	c.updateNavigationPreferences(func(prefs *preferences.Context) {
+		prefs.View = view.Name
+	})
+	c.SetFilters(filtersFromPreferences(view.Filters))
+
+	if len(view.Namespaces) > 0 && !view.HasNamespace(c.GetNamespace()) {
+		c.SetNamespace(view.Namespaces[0])
+	}
+
+	for _, fn := range c.contentPathUpdates {
+		fn(c.GetContentPath())
+	}
+}
+
+// SetContext sets the Kubernetes context. The namespace, filters, view and
+// page last used in the context are restored.
 func (c *WebsocketState) SetContext(requestedContext string) {
 	c.dashConfig.SetContextChosenInUI(true)
 
@@ -354,10 +479,26 @@ func (c *WebsocketState) SetContext(requestedContext string) {
 		c.dashConfig.Logger().WithErr(err).Errorf("update context")
 	}
 
-	c.SetNamespace(c.dashConfig.DefaultNamespace())
+	prefs := c.navigationPreferences()
 
-	for _, fn := range c.contentPathUpdates {
-		fn(c.GetContentPath())
+	namespace := c.dashConfig.DefaultNamespace()
+	if prefs.Namespace != "" {
+		namespace = prefs.Namespace
+	}
+	c.SetNamespace(namespace)
+	c.SetFilters(filtersFromPreferences(prefs.Filters))
+
+	view, _ := prefs.FindView(prefs.View)
+	c.mu.Lock()
+	c.view = view
+	c.mu.Unlock()
+
+	if prefs.ContentPath != "" && prefs.ContentPath != c.GetContentPath() {
+		c.SetContentPath(prefs.ContentPath)
+	} else {
+		for _, fn := range c.contentPathUpdates {
+			fn(c.GetContentPath())
+		}
 	}
 
 	c.wsClient.Send(CreateAlertUpdate(action.CreateAlert(
@@ -390,6 +531,11 @@ func (c *WebsocketState) SendAlert(alert action.Alert) {
 	c.wsClient.Send(CreateAlertUpdate(alert))
 }
 
+// SendPreview sends an action preview to the websocket client.
+func (c *WebsocketState) SendPreview(preview action.Preview) {
+	c.wsClient.Send(CreatePreviewUpdate(preview))
+}
+
 func (c *WebsocketState) GetClientID() string {
 	if c.wsClient == nil {
 		return ""
@@ -397,6 +543,91 @@ func (c *WebsocketState) GetClientID() string {
 	return c.wsClient.ID()
 }
 
+// contextPreferences returns the preferences of the current context.
+func (c *WebsocketState) contextPreferences() preferences.Context {
+	if c.dashConfig == nil || c.dashConfig.Preferences() == nil {
+		return preferences.Context{}
+	}
+	return c.dashConfig.Preferences().Get(c.dashConfig.CurrentContext())
+}
+
+// updateContextPreferences updates the preferences of a context.
+func (c *WebsocketState) updateContextPreferences(contextName string, fn func(prefs *preferences.Context)) {
+	if c.dashConfig == nil || c.dashConfig.Preferences() == nil {
+		return
+	}
+	if err := c.dashConfig.Preferences().Update(contextName, fn); err != nil {
+		c.dashConfig.Logger().WithErr(err).Errorf("save preferences")
+	}
+}
+
+// sharesNavigation returns true if the namespace, filters, view and page are
+// saved and restored. Preferences are shared by everyone using Octant, so
+// they aren't when users sign in.
+func (c *WebsocketState) sharesNavigation() bool {
+	return c.identity == nil && c.user == nil
+}
+
+// navigationPreferences returns the preferences of the current context used
+// to restore where the user left off. They are empty if navigation isn't
+// shared.
+func (c *WebsocketState) navigationPreferences() preferences.Context {
+	if !c.sharesNavigation() {
+		return preferences.Context{}
+	}
+	return c.contextPreferences()
+}
+
+// updateNavigationPreferences updates the preferences of the current context
+// if navigation is shared.
+func (c *WebsocketState) updateNavigationPreferences(fn func(prefs *preferences.Context)) {
+	if !c.sharesNavigation() || c.dashConfig == nil {
+		return
+	}
+	c.updateContextPreferences(c.dashConfig.CurrentContext(), fn)
+}
+
+// saveContentPath saves the last visited page once it has been open for the
+// save delay, so clicking through pages doesn't write the preferences for
+// each one.
+func (c *WebsocketState) saveContentPath(contentPath string) {
+	if !c.sharesNavigation() || c.dashConfig == nil {
+		return
+	}
+	contextName := c.dashConfig.CurrentContext()
+
+	c.contentPathSaveMu.Lock()
+	defer c.contentPathSaveMu.Unlock()
+
+	if c.contentPathSave != nil {
+		c.contentPathSave.Stop()
+	}
+	c.contentPathSave = time.AfterFunc(c.contentPathSaveDelay, func() {
+		c.updateContextPreferences(contextName, func(prefs *preferences.Context) {
+			prefs.ContentPath = contentPath
+		})
+	})
+}
+
+// saveFilters saves the filters. It is called with the lock held.
+func (c *WebsocketState) saveFilters() {
+	var filters []preferences.Filter
+	for _, filter := range c.filters {
+		filters = append(filters, preferences.Filter{Key: filter.Key, Value: filter.Value})
+	}
+	c.updateNavigationPreferences(func(prefs *preferences.Context) {
+		prefs.Filters = filters
+	})
+}
+
+func filtersFromPreferences(in []preferences.Filter) []octant.Filter {
+	filters := make([]octant.Filter, 0, len(in))
+	for _, filter := range in {
+		filters = append(filters, octant.Filter{Key: filter.Key, Value: filter.Value})
+	}
+	return filters
+}
+
 func updateContentPathNamespace(in, namespace string) string {
 	parts := strings.Split(in, "/")
 	if in == "" {
@@ -428,3 +659,14 @@ func CreateAlertUpdate(alert action.Alert) event.Event {
 		"expiration": alert.Expiration,
 	})
 }
+
+// CreatePreviewUpdate creates an action preview event.
+func CreatePreviewUpdate(preview action.Preview) event.Event {
+	return event.CreateEvent(event.EventTypePreview, action.Payload{
+		"title":               preview.Title,
+		"changes":             preview.Changes,
+		"error":               preview.Error,
+		"confirm":             preview.Confirm,
+		"propagationPolicies": preview.PropagationPolicies,
+	})
+}
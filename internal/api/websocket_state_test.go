@@ -17,10 +17,12 @@ import (
 
 	"github.com/vmware-tanzu/octant/internal/api"
 	"github.com/vmware-tanzu/octant/internal/api/fake"
+	"github.com/vmware-tanzu/octant/internal/auth"
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
 	"github.com/vmware-tanzu/octant/internal/log"
 	moduleFake "github.com/vmware-tanzu/octant/internal/module/fake"
 	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/preferences"
 )
 
 func TestWebsocketState_Start(t *testing.T) {
@@ -252,6 +254,32 @@ func TestWebsocketState_AddFilter(t *testing.T) {
 	assert.Equal(t, expected, got)
 }
 
+func TestWebsocketState_SetListContinue(t *testing.T) {
+	mocks := newWebsocketStateMocks(t, "default")
+	defer mocks.finish()
+
+	contentPath := "overview/foo"
+	mocks.moduleManager.EXPECT().
+		ModuleForContentPath(contentPath).
+		Return(mocks.module, true).AnyTimes()
+
+	s := mocks.factory()
+	s.SetContentPath(contentPath)
+
+	var updated []string
+	cancelUpdate := s.OnContentPathUpdate(func(contentPath string) {
+		updated = append(updated, contentPath)
+	})
+	defer cancelUpdate()
+
+	s.SetListContinue("page-2")
+	assert.Equal(t, "page-2", s.GetListContinue())
+	assert.Equal(t, []string{contentPath}, updated)
+
+	s.AddFilter(octant.Filter{Key: "key", Value: "value"})
+	assert.Equal(t, "", s.GetListContinue())
+}
+
 func TestWebSocketState_SetContext(t *testing.T) {
 	mocks := newWebsocketStateMocks(t, "default")
 	defer mocks.finish()
@@ -266,11 +294,166 @@ func TestWebSocketState_SetContext(t *testing.T) {
 	s.SetContext(contextName)
 }
 
+func TestWebsocketState_preferences(t *testing.T) {
+	mocks := newWebsocketStateMocks(t, "default")
+	defer mocks.finish()
+
+	require.NoError(t, mocks.preferences.Update("dev", func(prefs *preferences.Context) {
+		prefs.Namespace = "frontend"
+		prefs.Filters = []preferences.Filter{{Key: "app", Value: "web"}}
+		prefs.ContentPath = "overview/namespace/frontend/workloads"
+	}))
+
+	mocks.moduleManager.EXPECT().
+		ModuleForContentPath(gomock.Any()).
+		Return(mocks.module, true).AnyTimes()
+
+	options := append(mocks.options(), api.WebsocketStateContentPathSaveDelay(10*time.Millisecond))
+	s := api.NewWebsocketState(mocks.dashConfig, mocks.actionDispatcher, mocks.wsClient, options...)
+	assert.Equal(t, "frontend", s.GetNamespace())
+	assert.Equal(t, []octant.Filter{{Key: "app", Value: "web"}}, s.GetFilters())
+
+	s.SetContentPath("")
+	assert.Equal(t, "overview/namespace/frontend/workloads", s.GetContentPath(), "new clients start on the last visited page")
+
+	s.SetNamespace("backend")
+	s.RemoveFilter(octant.Filter{Key: "app", Value: "web"})
+
+	prefs := mocks.preferences.Get("dev")
+	assert.Equal(t, "backend", prefs.Namespace)
+	assert.Empty(t, prefs.Filters)
+	assert.Eventually(t, func() bool {
+		return mocks.preferences.Get("dev").ContentPath == "overview/namespace/backend/workloads"
+	}, time.Second, 10*time.Millisecond, "the last visited page is saved after a delay")
+
+	s.SetContentPath("")
+	assert.Equal(t, "overview/namespace/backend", s.GetContentPath())
+}
+
+func TestWebsocketState_preferences_contentPathSaveDelay(t *testing.T) {
+	mocks := newWebsocketStateMocks(t, "default")
+	defer mocks.finish()
+
+	mocks.moduleManager.EXPECT().
+		ModuleForContentPath(gomock.Any()).
+		Return(mocks.module, true).AnyTimes()
+
+	options := append(mocks.options(), api.WebsocketStateContentPathSaveDelay(50*time.Millisecond))
+	s := api.NewWebsocketState(mocks.dashConfig, mocks.actionDispatcher, mocks.wsClient, options...)
+
+	s.SetContentPath("overview/namespace/default/workloads")
+	s.SetContentPath("overview/namespace/default/config-and-storage")
+	assert.Empty(t, mocks.preferences.Get("dev").ContentPath, "pages aren't saved right away")
+
+	assert.Eventually(t, func() bool {
+		return mocks.preferences.Get("dev").ContentPath == "overview/namespace/default/config-and-storage"
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestWebsocketState_preferences_signedIn(t *testing.T) {
+	mocks := newWebsocketStateMocks(t, "default")
+	defer mocks.finish()
+
+	require.NoError(t, mocks.preferences.Update("dev", func(prefs *preferences.Context) {
+		prefs.Namespace = "frontend"
+		prefs.ContentPath = "overview/namespace/frontend/workloads"
+	}))
+
+	mocks.moduleManager.EXPECT().
+		ModuleForContentPath(gomock.Any()).
+		Return(mocks.module, true).AnyTimes()
+
+	options := append(mocks.options(),
+		api.WebsocketStateUser(auth.User{Name: "alice"}),
+		api.WebsocketStateContentPathSaveDelay(0))
+	s := api.NewWebsocketState(mocks.dashConfig, mocks.actionDispatcher, mocks.wsClient, options...)
+	assert.Equal(t, "default", s.GetNamespace(), "signed in users don't restore where others left off")
+
+	s.SetContentPath("")
+	assert.Equal(t, "overview/namespace/default", s.GetContentPath())
+
+	s.SetNamespace("backend")
+	time.Sleep(10 * time.Millisecond)
+
+	prefs := mocks.preferences.Get("dev")
+	assert.Equal(t, "frontend", prefs.Namespace, "signed in users don't save where they left off")
+	assert.Equal(t, "overview/namespace/frontend/workloads", prefs.ContentPath)
+}
+
+func TestWebSocketState_SetContext_restoresPreferences(t *testing.T) {
+	mocks := newWebsocketStateMocks(t, "default")
+	defer mocks.finish()
+
+	require.NoError(t, mocks.preferences.Update("dev", func(prefs *preferences.Context) {
+		prefs.Namespace = "frontend"
+		prefs.Filters = []preferences.Filter{{Key: "app", Value: "web"}}
+		prefs.ContentPath = "overview/namespace/frontend/workloads"
+		prefs.SaveView(preferences.View{Name: "web", Namespaces: []string{"frontend", "backend"}})
+		prefs.View = "web"
+	}))
+
+	mocks.moduleManager.EXPECT().
+		ModuleForContentPath(gomock.Any()).
+		Return(mocks.module, true).AnyTimes()
+	mocks.dashConfig.EXPECT().SetContextChosenInUI(true)
+	mocks.dashConfig.EXPECT().UseContext(context.TODO(), "dev")
+	mocks.dashConfig.EXPECT().DefaultNamespace().Return("default")
+	mocks.wsClient.EXPECT().Send(gomock.Any()).AnyTimes()
+
+	s := mocks.factory()
+	s.SetContext("dev")
+
+	assert.Equal(t, "frontend", s.GetNamespace())
+	assert.Equal(t, []octant.Filter{{Key: "app", Value: "web"}}, s.GetFilters())
+	assert.Equal(t, "overview/namespace/frontend/workloads", s.GetContentPath())
+	assert.Equal(t, "web", s.GetView().Name)
+}
+
+func TestWebsocketState_SetView(t *testing.T) {
+	mocks := newWebsocketStateMocks(t, "default")
+	defer mocks.finish()
+
+	mocks.moduleManager.EXPECT().
+		ModuleForContentPath(gomock.Any()).
+		Return(mocks.module, true).AnyTimes()
+
+	s := mocks.factory()
+	s.SetContentPath("overview/namespace/default/workloads")
+
+	var updated []string
+	cancelUpdate := s.OnContentPathUpdate(func(contentPath string) {
+		updated = append(updated, contentPath)
+	})
+	defer cancelUpdate()
+
+	view := preferences.View{
+		Name:       "payments",
+		Namespaces: []string{"payments-a", "payments-b"},
+		Filters:    []preferences.Filter{{Key: "team", Value: "payments"}},
+	}
+	s.SetView(view)
+
+	assert.Equal(t, view, s.GetView())
+	assert.Equal(t, []octant.Filter{{Key: "team", Value: "payments"}}, s.GetFilters())
+	assert.Equal(t, "payments-a", s.GetNamespace(), "the view's first namespace is selected")
+	assert.Equal(t, "overview/namespace/payments-a/workloads", s.GetContentPath())
+	assert.Contains(t, updated, "overview/namespace/payments-a/workloads")
+	assert.Equal(t, "payments", mocks.preferences.Get("dev").View)
+
+	s.SetNamespace("payments-b")
+	s.SetView(preferences.View{})
+	assert.Empty(t, s.GetView().Name)
+	assert.Empty(t, s.GetFilters())
+	assert.Equal(t, "payments-b", s.GetNamespace())
+	assert.Empty(t, mocks.preferences.Get("dev").View)
+}
+
 type websocketStateMocks struct {
 	controller       *gomock.Controller
 	module           *moduleFake.MockModule
 	moduleManager    *moduleFake.MockManagerInterface
 	dashConfig       *configFake.MockDash
+	preferences      *preferences.FileStore
 	wsClient         *fake.MockOctantClient
 	stateManager     *fake.MockStateManager
 	actionDispatcher *fake.MockActionDispatcher
@@ -285,6 +468,10 @@ func newWebsocketStateMocks(t *testing.T, namespace string) *websocketStateMocks
 	dashConfig.EXPECT().DefaultNamespace().Return(namespace)
 	dashConfig.EXPECT().ModuleManager().Return(moduleManager).AnyTimes()
 	dashConfig.EXPECT().Logger().Return(log.NopLogger()).AnyTimes()
+	prefs, err := preferences.NewFileStore("")
+	require.NoError(t, err)
+	dashConfig.EXPECT().Preferences().Return(prefs).AnyTimes()
+	dashConfig.EXPECT().CurrentContext().Return("dev").AnyTimes()
 	octantClient := fake.NewMockOctantClient(controller)
 	stateManager := fake.NewMockStateManager(controller)
 	actionDispatcher := fake.NewMockActionDispatcher(controller)
@@ -294,6 +481,7 @@ func newWebsocketStateMocks(t *testing.T, namespace string) *websocketStateMocks
 		module:           m,
 		moduleManager:    moduleManager,
 		dashConfig:       dashConfig,
+		preferences:      prefs,
 		wsClient:         octantClient,
 		stateManager:     stateManager,
 		actionDispatcher: actionDispatcher,
@@ -0,0 +1,234 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package audit records the actions users dispatch so shared installs can tell
+// who changed what.
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	ocontext "github.com/vmware-tanzu/octant/internal/context"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/util/json"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/log"
+)
+
+// DefaultCapacity is how many entries a log keeps in memory by default.
+const DefaultCapacity = 1000
+
+// ignoredActions change what a client is looking at, preview changes or change
+// preferences rather than change the cluster.
+var ignoredActions = map[string]bool{
+	action.RequestSetNamespace:       true,
+	action.RequestSetFilter:          true,
+	action.RequestSetContext:         true,
+	octant.ActionPreviewDeleteObject: true,
+	octant.ActionPreviewApplyYaml:    true,
+	octant.ActionPinObject:           true,
+	octant.ActionUnpinObject:         true,
+}
+
+// ObjectReference identifies the object an action was for.
+type ObjectReference struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+	Name       string `json:"name,omitempty"`
+}
+
+// Entry is a recorded action.
+type Entry struct {
+	Time      time.Time        `json:"time"`
+	ClientID  string           `json:"clientID,omitempty"`
+	User      string           `json:"user,omitempty"`
+	Context   string           `json:"context,omitempty"`
+	Namespace string           `json:"namespace,omitempty"`
+	Object    *ObjectReference `json:"object,omitempty"`
+	Action    string           `json:"action"`
+	// PayloadDigest is the SHA-256 digest of the action's payload. Payloads are
+	// not stored since they can contain secrets.
+	PayloadDigest string `json:"payloadDigest"`
+	// Error is set if the action failed.
+	Error string `json:"error,omitempty"`
+}
+
+// Sink stores entries outside of Octant.
+type Sink interface {
+	Write(entry Entry) error
+}
+
+// Log records actions. Recent entries are kept in memory for the dashboard and
+// every entry is written to the log's sinks.
+type Log struct {
+	logger   log.Logger
+	sinks    []Sink
+	capacity int
+	now      func() time.Time
+
+	mu      sync.RWMutex
+	entries []Entry
+	next    int
+}
+
+var _ action.Recorder = (*Log)(nil)
+
+// NewLog creates an instance of Log which keeps up to capacity entries in memory.
+func NewLog(logger log.Logger, capacity int, sinks ...Sink) *Log {
+	if capacity < 1 {
+		capacity = DefaultCapacity
+	}
+
+	return &Log{
+		logger:   logger.With("component", "audit"),
+		sinks:    sinks,
+		capacity: capacity,
+		now:      time.Now,
+	}
+}
+
+type logKey struct{}
+
+// WithLog returns a context carrying l, so actions which are not dispatched by
+// an action manager can be recorded.
+func WithLog(ctx context.Context, l *Log) context.Context {
+	return context.WithValue(ctx, logKey{}, l)
+}
+
+// LogFrom returns the log in ctx. It returns nil if there is no log.
+func LogFrom(ctx context.Context) *Log {
+	l, _ := ctx.Value(logKey{}).(*Log)
+	return l
+}
+
+// Record records a dispatched action.
+func (l *Log) Record(ctx context.Context, actionPath string, payload action.Payload, err error) {
+	if ignoredActions[actionPath] {
+		return
+	}
+
+	clientState := ocontext.ClientStateFrom(ctx)
+
+	entry := Entry{
+		Time:          l.now(),
+		ClientID:      clientState.ClientID,
+		Context:       clientState.ContextName,
+		Namespace:     clientState.Namespace,
+		Action:        actionPath,
+		PayloadDigest: digest(payload),
+	}
+
+	if user, ok := auth.UserFrom(ctx); ok {
+		entry.User = user.Name
+	}
+
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	object, namespace := objectReference(payload)
+	entry.Object = object
+	if namespace != "" {
+		entry.Namespace = namespace
+	}
+
+	l.add(entry)
+}
+
+func (l *Log) add(entry Entry) {
+	l.mu.Lock()
+	if len(l.entries) < l.capacity {
+		l.entries = append(l.entries, entry)
+	} else {
+		l.entries[l.next] = entry
+	}
+	l.next = (l.next + 1) % l.capacity
+	l.mu.Unlock()
+
+	for _, sink := range l.sinks {
+		if err := sink.Write(entry); err != nil {
+			l.logger.WithErr(err).Errorf("write audit entry")
+		}
+	}
+}
+
+// Entries returns the entries in memory, newest first.
+func (l *Log) Entries() []Entry {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	entries := make([]Entry, 0, len(l.entries))
+	for i := 1; i <= len(l.entries); i++ {
+		entries = append(entries, l.entries[(l.next-i+len(l.entries))%len(l.entries)])
+	}
+
+	return entries
+}
+
+func digest(payload action.Payload) string {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// objectReference returns the object a payload is for. Actions either name the
+// object or send its YAML as the update. Only the first object in multi-document
+// YAML is referenced.
+func objectReference(payload action.Payload) (*ObjectReference, string) {
+	apiVersion, _ := payload.OptionalString("apiVersion")
+	kind, _ := payload.OptionalString("kind")
+	name, _ := payload.OptionalString("name")
+	namespace, _ := payload.OptionalString("namespace")
+
+	if apiVersion != "" || kind != "" {
+		return &ObjectReference{APIVersion: apiVersion, Kind: kind, Name: name}, namespace
+	}
+
+	update, _ := payload.OptionalString("update")
+	if update == "" {
+		return nil, namespace
+	}
+
+	var object struct {
+		APIVersion string `json:"apiVersion"`
+		Kind       string `json:"kind"`
+		Metadata   struct {
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+		} `json:"metadata"`
+	}
+	document := update
+	for _, d := range strings.Split(update, "\n---") {
+		if strings.TrimSpace(d) != "" {
+			document = d
+			break
+		}
+	}
+	if err := yaml.Unmarshal([]byte(document), &object); err != nil || object.Kind == "" {
+		return nil, namespace
+	}
+
+	if object.Metadata.Namespace != "" {
+		namespace = object.Metadata.Namespace
+	}
+
+	return &ObjectReference{
+		APIVersion: object.APIVersion,
+		Kind:       object.Kind,
+		Name:       object.Metadata.Name,
+	}, namespace
+}
@@ -0,0 +1,161 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	ocontext "github.com/vmware-tanzu/octant/internal/context"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/util/json"
+	"github.com/vmware-tanzu/octant/pkg/action"
+)
+
+func TestLog_Record(t *testing.T) {
+	now := time.Unix(1000, 0)
+	l := NewLog(log.NopLogger(), 10)
+	l.now = func() time.Time { return now }
+
+	ctx := ocontext.WithClientState(context.Background(), ocontext.ClientState{
+		ClientID:    "client",
+		Namespace:   "default",
+		ContextName: "kind",
+	})
+	ctx = auth.WithUser(ctx, auth.User{Name: "alice"})
+
+	payload := action.Payload{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"name":       "web",
+		"namespace":  "app",
+	}
+	l.Record(ctx, "action.octant.dev/deleteObject", payload, fmt.Errorf("forbidden"))
+
+	entries := l.Entries()
+	require.Len(t, entries, 1)
+
+	expected := Entry{
+		Time:          now,
+		ClientID:      "client",
+		User:          "alice",
+		Context:       "kind",
+		Namespace:     "app",
+		Object:        &ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "web"},
+		Action:        "action.octant.dev/deleteObject",
+		PayloadDigest: digest(payload),
+		Error:         "forbidden",
+	}
+	assert.Equal(t, expected, entries[0])
+	assert.Len(t, entries[0].PayloadDigest, 64)
+}
+
+func TestLog_Record_ignored(t *testing.T) {
+	l := NewLog(log.NopLogger(), 10)
+
+	l.Record(context.Background(), action.RequestSetNamespace, action.Payload{"namespace": "default"}, nil)
+	l.Record(context.Background(), octant.ActionPinObject, action.Payload{"namespace": "default"}, nil)
+	assert.Empty(t, l.Entries())
+}
+
+func TestLogFrom(t *testing.T) {
+	assert.Nil(t, LogFrom(context.Background()))
+
+	l := NewLog(log.NopLogger(), 10)
+	assert.Same(t, l, LogFrom(WithLog(context.Background(), l)))
+}
+
+func TestLog_Entries(t *testing.T) {
+	l := NewLog(log.NopLogger(), 2)
+
+	for _, name := range []string{"a", "b", "c"} {
+		l.Record(context.Background(), name, action.Payload{}, nil)
+	}
+
+	entries := l.Entries()
+	require.Len(t, entries, 2, "entries over capacity are dropped")
+	assert.Equal(t, "c", entries[0].Action)
+	assert.Equal(t, "b", entries[1].Action)
+}
+
+func Test_objectReference(t *testing.T) {
+	tests := []struct {
+		name              string
+		payload           action.Payload
+		expected          *ObjectReference
+		expectedNamespace string
+	}{
+		{
+			name:    "no object",
+			payload: action.Payload{"id": "1"},
+		},
+		{
+			name: "update",
+			payload: action.Payload{
+				"update": "---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\n  namespace: app\n---\napiVersion: v1\nkind: Secret\n",
+			},
+			expected:          &ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "config"},
+			expectedNamespace: "app",
+		},
+		{
+			name:              "invalid update",
+			payload:           action.Payload{"update": ":", "namespace": "default"},
+			expectedNamespace: "default",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, namespace := objectReference(test.payload)
+			assert.Equal(t, test.expected, got)
+			assert.Equal(t, test.expectedNamespace, namespace)
+		})
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "audit", "audit.log")
+
+	sink, err := NewFileSink(name)
+	require.NoError(t, err)
+
+	l := NewLog(log.NopLogger(), 10, sink)
+	l.Record(context.Background(), "a", action.Payload{}, nil)
+	require.NoError(t, sink.Close())
+
+	// Entries are appended when the file is opened again.
+	sink, err = NewFileSink(name)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(Entry{Action: "b"}))
+	require.NoError(t, sink.Close())
+
+	info, err := os.Stat(name)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	f, err := os.Open(name)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var actions []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry Entry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		actions = append(actions, entry.Action)
+	}
+	assert.Equal(t, []string{"a", "b"}, actions)
+}
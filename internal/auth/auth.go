@@ -0,0 +1,323 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package auth authenticates users of Octant's HTTP listener.
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/mime"
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+// Mode is an authentication mode.
+type Mode string
+
+const (
+	// ModeAuto uses token authentication when the listener isn't a loopback address.
+	ModeAuto Mode = ""
+	// ModeNone disables authentication.
+	ModeNone Mode = "none"
+	// ModeToken authenticates users with a bearer token.
+	ModeToken Mode = "token"
+	// ModeHtpasswd authenticates users with an htpasswd file.
+	ModeHtpasswd Mode = "htpasswd"
+	// ModeOIDC authenticates users with an OpenID Connect provider.
+	ModeOIDC Mode = "oidc"
+)
+
+// DefaultSessionTTL is how long a session lasts if it isn't configured.
+const DefaultSessionTTL = 12 * time.Hour
+
+// ResolveMode resolves ModeAuto for a listener address.
+func ResolveMode(mode Mode, addr net.Addr) Mode {
+	if mode != ModeAuto {
+		return mode
+	}
+
+	if tcpAddr, ok := addr.(*net.TCPAddr); ok && tcpAddr.IP.IsLoopback() {
+		return ModeNone
+	}
+
+	return ModeToken
+}
+
+// Options configures an Authenticator.
+type Options struct {
+	Mode Mode
+	// PathPrefix is the path the authentication routes are served from.
+	PathPrefix string
+	// Token is the token for ModeToken. A token is generated if it is blank.
+	Token string
+	// HtpasswdFile is the htpasswd file for ModeHtpasswd.
+	HtpasswdFile string
+	// OIDC configures ModeOIDC.
+	OIDC OIDCOptions
+	// SessionSecret signs session cookies. A secret is generated if it is blank,
+	// so sessions end when Octant restarts.
+	SessionSecret string
+	// SessionTTL is how long a session lasts.
+	SessionTTL time.Duration
+}
+
+// User is an authenticated user.
+type User struct {
+	Name string
+	// Groups are the groups the identity provider put the user in.
+	Groups []string
+}
+
+// method authenticates requests which don't have a session.
+type method interface {
+	// credentials returns the user for credentials sent with a request.
+	credentials(r *http.Request) (User, bool)
+	// login serves the login route. If it returns false, it has written a response.
+	login(w http.ResponseWriter, r *http.Request, next string) (User, bool)
+}
+
+// callbackMethod is implemented by methods which redirect users elsewhere to log in.
+type callbackMethod interface {
+	// callback serves the callback route. It returns the user and the path the login
+	// started from. If it returns false, it has written a response.
+	callback(w http.ResponseWriter, r *http.Request) (user User, next string, ok bool)
+}
+
+// Authenticator authenticates requests. Authenticated users are given a session
+// stored in a signed cookie.
+type Authenticator struct {
+	mode       Mode
+	pathPrefix string
+	method     method
+	signer     *signer
+	sessionTTL time.Duration
+	token      string
+}
+
+// New creates an instance of Authenticator. It returns nil if authentication is disabled.
+func New(ctx context.Context, options Options) (*Authenticator, error) {
+	if options.Mode == ModeNone {
+		return nil, nil
+	}
+
+	secret := []byte(options.SessionSecret)
+	if len(secret) == 0 {
+		var err error
+		if secret, err = randomBytes(32); err != nil {
+			return nil, fmt.Errorf("generate session secret: %w", err)
+		}
+	}
+
+	a := &Authenticator{
+		mode:       options.Mode,
+		pathPrefix: strings.TrimSuffix(options.PathPrefix, "/"),
+		signer:     &signer{secret: secret, now: time.Now},
+		sessionTTL: options.SessionTTL,
+	}
+	if a.sessionTTL <= 0 {
+		a.sessionTTL = DefaultSessionTTL
+	}
+
+	switch options.Mode {
+	case ModeToken:
+		a.token = options.Token
+		if a.token == "" {
+			b, err := randomBytes(24)
+			if err != nil {
+				return nil, fmt.Errorf("generate token: %w", err)
+			}
+			a.token = hex.EncodeToString(b)
+		}
+		a.method = &tokenMethod{token: a.token}
+	case ModeHtpasswd:
+		m, err := newHtpasswdMethod(options.HtpasswdFile)
+		if err != nil {
+			return nil, err
+		}
+		a.method = m
+	case ModeOIDC:
+		m, err := newOIDCMethod(ctx, options.OIDC, a.signer, a.pathPrefix+"/callback")
+		if err != nil {
+			return nil, err
+		}
+		a.method = m
+	default:
+		return nil, fmt.Errorf("unknown authentication mode %q", options.Mode)
+	}
+
+	return a, nil
+}
+
+// Mode returns the authentication mode.
+func (a *Authenticator) Mode() Mode {
+	return a.mode
+}
+
+// PathPrefix returns the path the authentication routes are served from.
+func (a *Authenticator) PathPrefix() string {
+	return a.pathPrefix
+}
+
+// Token returns the token for token authentication.
+func (a *Authenticator) Token() string {
+	return a.token
+}
+
+// BrowserURL returns the URL to open in a browser to view path. For token
+// authentication, it logs the browser in.
+func (a *Authenticator) BrowserURL(baseURL, path string) string {
+	if a == nil || a.mode != ModeToken {
+		return baseURL + path
+	}
+
+	values := url.Values{}
+	values.Set("token", a.token)
+	if path != "" {
+		values.Set("next", path)
+	}
+	return baseURL + a.pathPrefix + "/login?" + values.Encode()
+}
+
+// Middleware rejects requests which aren't authenticated.
+func (a *Authenticator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, ok := a.user(w, r)
+		if !ok {
+			writeJSON(w, http.StatusUnauthorized, sessionResponse{Error: "unauthorized"})
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(WithUser(r.Context(), user)))
+	})
+}
+
+// Routes adds the authentication routes to a router.
+func (a *Authenticator) Routes(router *mux.Router) {
+	router.HandleFunc("/login", a.handleLogin).Methods(http.MethodGet, http.MethodPost)
+	router.HandleFunc("/logout", a.handleLogout).Methods(http.MethodGet, http.MethodPost)
+	router.HandleFunc("/session", a.handleSession).Methods(http.MethodGet)
+	if _, ok := a.method.(callbackMethod); ok {
+		router.HandleFunc("/callback", a.handleCallback).Methods(http.MethodGet)
+	}
+}
+
+// user returns the user for a request. Requests with credentials are given a session.
+func (a *Authenticator) user(w http.ResponseWriter, r *http.Request) (User, bool) {
+	if user, ok := a.signer.sessionUser(r); ok {
+		return user, true
+	}
+
+	user, ok := a.method.credentials(r)
+	if !ok {
+		return User{}, false
+	}
+
+	a.startSession(w, r, user)
+	return user, true
+}
+
+func (a *Authenticator) startSession(w http.ResponseWriter, r *http.Request, user User) {
+	value := session{
+		User:    user.Name,
+		Groups:  user.Groups,
+		Expires: a.signer.now().Add(a.sessionTTL).Unix(),
+	}
+	if err := a.signer.setCookie(w, r, SessionCookie, value, a.sessionTTL); err != nil {
+		log.From(r.Context()).WithErr(err).Errorf("start session")
+	}
+}
+
+func (a *Authenticator) handleLogin(w http.ResponseWriter, r *http.Request) {
+	next := safeNext(r.FormValue("next"))
+
+	user, ok := a.method.login(w, r, next)
+	if !ok {
+		return
+	}
+
+	log.From(r.Context()).With("user", user.Name).Infof("user logged in")
+	a.startSession(w, r, user)
+	http.Redirect(w, r, next, http.StatusFound)
+}
+
+func (a *Authenticator) handleCallback(w http.ResponseWriter, r *http.Request) {
+	user, next, ok := a.method.(callbackMethod).callback(w, r)
+	if !ok {
+		return
+	}
+
+	log.From(r.Context()).With("user", user.Name).Infof("user logged in")
+	a.startSession(w, r, user)
+	http.Redirect(w, r, safeNext(next), http.StatusFound)
+}
+
+func (a *Authenticator) handleLogout(w http.ResponseWriter, r *http.Request) {
+	clearCookie(w, SessionCookie)
+	http.Redirect(w, r, "/", http.StatusFound)
+}
+
+func (a *Authenticator) handleSession(w http.ResponseWriter, r *http.Request) {
+	user, ok := a.user(w, r)
+	if !ok {
+		writeJSON(w, http.StatusUnauthorized, sessionResponse{Error: "unauthorized"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, sessionResponse{User: user.Name, Groups: user.Groups})
+}
+
+// sessionResponse is the body of session and unauthorized responses.
+type sessionResponse struct {
+	User   string   `json:"user,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+	Error  string   `json:"error,omitempty"`
+}
+
+type userKey struct{}
+
+// WithUser returns a context with an authenticated user.
+func WithUser(ctx context.Context, user User) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// UserFrom returns the authenticated user for a context. It returns false if
+// authentication is disabled.
+func UserFrom(ctx context.Context) (User, bool) {
+	user, ok := ctx.Value(userKey{}).(User)
+	return user, ok
+}
+
+// safeNext returns next if it is a path on this server, and the root path otherwise.
+func safeNext(next string) string {
+	if !strings.HasPrefix(next, "/") || strings.HasPrefix(next, "//") || strings.HasPrefix(next, "/\\") {
+		return "/"
+	}
+	return next
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", mime.JSONContentType)
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func randomBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
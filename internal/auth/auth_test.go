@@ -0,0 +1,217 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package auth
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestResolveMode(t *testing.T) {
+	loopback := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 7777}
+	public := &net.TCPAddr{IP: net.ParseIP("0.0.0.0"), Port: 7777}
+
+	assert.Equal(t, ModeNone, ResolveMode(ModeAuto, loopback))
+	assert.Equal(t, ModeToken, ResolveMode(ModeAuto, public))
+	assert.Equal(t, ModeHtpasswd, ResolveMode(ModeHtpasswd, loopback))
+	assert.Equal(t, ModeNone, ResolveMode(ModeNone, public))
+}
+
+func TestNew_none(t *testing.T) {
+	a, err := New(context.Background(), Options{Mode: ModeNone})
+	require.NoError(t, err)
+	assert.Nil(t, a)
+	assert.Equal(t, "http://localhost/#/overview", a.BrowserURL("http://localhost", "/#/overview"))
+}
+
+func TestAuthenticator_token(t *testing.T) {
+	a, err := New(context.Background(), Options{Mode: ModeToken, PathPrefix: "/api/v1/auth", Token: "secret"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "http://localhost/api/v1/auth/login?next=%2F%23%2Foverview&token=secret",
+		a.BrowserURL("http://localhost", "/#/overview"))
+
+	server := newTestServer(a)
+
+	// API requests need a session or the token.
+	assert.Equal(t, http.StatusUnauthorized, serve(server, httptest.NewRequest(http.MethodGet, "/api/v1/stream", nil)).Code)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/stream", nil)
+	req.Header.Set("Authorization", "Bearer wrong")
+	assert.Equal(t, http.StatusUnauthorized, serve(server, req).Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/stream", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	rr := serve(server, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, TokenUser, rr.Body.String())
+
+	// The login page asks for the token.
+	rr = serve(server, httptest.NewRequest(http.MethodGet, "/api/v1/auth/login?next=/%23/overview", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `name="token"`)
+
+	rr = serve(server, httptest.NewRequest(http.MethodGet, "/api/v1/auth/login?token=wrong", nil))
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+
+	// Logging in with the token starts a session.
+	rr = serve(server, httptest.NewRequest(http.MethodGet, "/api/v1/auth/login?token=secret&next=/%23/overview", nil))
+	require.Equal(t, http.StatusFound, rr.Code)
+	assert.Equal(t, "/#/overview", rr.Header().Get("Location"))
+	cookie := sessionCookie(t, rr)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/stream", nil)
+	req.AddCookie(cookie)
+	assert.Equal(t, http.StatusOK, serve(server, req).Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/auth/session", nil)
+	req.AddCookie(cookie)
+	rr = serve(server, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"user":"token"}`, rr.Body.String())
+
+	// The login page posts the token so it stays out of URLs.
+	assert.Contains(t, serve(server, httptest.NewRequest(http.MethodGet, "/api/v1/auth/login", nil)).Body.String(), `method="post"`)
+
+	form := url.Values{"token": {"secret"}, "next": {"/#/overview"}}
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr = serve(server, req)
+	require.Equal(t, http.StatusFound, rr.Code)
+	assert.Equal(t, "/#/overview", rr.Header().Get("Location"))
+
+	form.Set("token", "wrong")
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	assert.Equal(t, http.StatusUnauthorized, serve(server, req).Code)
+
+	// Redirects stay on this server.
+	rr = serve(server, httptest.NewRequest(http.MethodGet, "/api/v1/auth/login?token=secret&next=//example.com", nil))
+	assert.Equal(t, "/", rr.Header().Get("Location"))
+
+	rr = serve(server, httptest.NewRequest(http.MethodGet, "/api/v1/auth/logout", nil))
+	assert.Equal(t, http.StatusFound, rr.Code)
+	assert.Equal(t, "", sessionCookie(t, rr).Value)
+}
+
+func TestAuthenticator_htpasswd(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("bcrypt-password"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	file := filepath.Join(t.TempDir(), "htpasswd")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`# users
+alice:`+string(hash)+`
+bob:{SHA}MNLW6wfRtawHZ/atRhQOJCUt398=
+`), 0600))
+
+	a, err := New(context.Background(), Options{Mode: ModeHtpasswd, PathPrefix: "/api/v1/auth", HtpasswdFile: file})
+	require.NoError(t, err)
+	server := newTestServer(a)
+
+	rr := serve(server, httptest.NewRequest(http.MethodGet, "/api/v1/auth/login", nil))
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.Contains(t, rr.Header().Get("WWW-Authenticate"), "Basic")
+
+	tests := []struct {
+		user     string
+		password string
+		expected int
+	}{
+		{user: "alice", password: "bcrypt-password", expected: http.StatusOK},
+		{user: "alice", password: "wrong", expected: http.StatusUnauthorized},
+		{user: "bob", password: "sha-password", expected: http.StatusOK},
+		{user: "carol", password: "sha-password", expected: http.StatusUnauthorized},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/stream", nil)
+		req.SetBasicAuth(test.user, test.password)
+		rr := serve(server, req)
+		assert.Equal(t, test.expected, rr.Code, test.user+":"+test.password)
+		if test.expected == http.StatusOK {
+			assert.Equal(t, test.user, rr.Body.String())
+		}
+	}
+}
+
+func TestParseHtpasswd_unsupportedHash(t *testing.T) {
+	_, err := parseHtpasswd([]byte("alice:$apr1$salt$hash\n"))
+	require.Error(t, err)
+}
+
+func TestSigner_sessionUser(t *testing.T) {
+	now := time.Unix(1000, 0)
+	s := &signer{secret: []byte("secret"), now: func() time.Time { return now }}
+
+	value, err := s.encode(session{User: "alice", Expires: 2000})
+	require.NoError(t, err)
+
+	request := func(value string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.AddCookie(&http.Cookie{Name: SessionCookie, Value: value})
+		return req
+	}
+
+	user, ok := s.sessionUser(request(value))
+	require.True(t, ok)
+	assert.Equal(t, "alice", user.Name)
+
+	forged, err := (&signer{secret: []byte("other")}).encode(session{User: "mallory", Expires: 2000})
+	require.NoError(t, err)
+	_, ok = s.sessionUser(request(forged))
+	assert.False(t, ok, "signed with another secret")
+
+	_, ok = s.sessionUser(request(value[1:]))
+	assert.False(t, ok, "modified")
+
+	now = time.Unix(2000, 0)
+	_, ok = s.sessionUser(request(value))
+	assert.False(t, ok, "expired")
+}
+
+func newTestServer(a *Authenticator) *mux.Router {
+	router := mux.NewRouter()
+	a.Routes(router.PathPrefix(a.PathPrefix()).Subrouter())
+
+	s := router.PathPrefix("/api/v1").Subrouter()
+	s.Use(a.Middleware)
+	s.HandleFunc("/stream", func(w http.ResponseWriter, r *http.Request) {
+		user, _ := UserFrom(r.Context())
+		_, _ = w.Write([]byte(strings.Join(append([]string{user.Name}, user.Groups...), ",")))
+	})
+
+	return router
+}
+
+func serve(handler http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	return rr
+}
+
+func sessionCookie(t *testing.T, rr *httptest.ResponseRecorder) *http.Cookie {
+	for _, cookie := range rr.Result().Cookies() {
+		if cookie.Name == SessionCookie {
+			return cookie
+		}
+	}
+
+	t.Fatal("no session cookie")
+	return nil
+}
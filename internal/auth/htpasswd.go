@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package auth
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// htpasswdMethod authenticates requests with HTTP basic authentication against
+// users in an htpasswd file. Passwords must be hashed with bcrypt or SHA1.
+type htpasswdMethod struct {
+	users map[string]string
+}
+
+var _ method = (*htpasswdMethod)(nil)
+
+func newHtpasswdMethod(file string) (*htpasswdMethod, error) {
+	if file == "" {
+		return nil, fmt.Errorf("htpasswd authentication requires an htpasswd file")
+	}
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read htpasswd file: %w", err)
+	}
+
+	users, err := parseHtpasswd(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse htpasswd file %s: %w", file, err)
+	}
+
+	return &htpasswdMethod{users: users}, nil
+}
+
+func parseHtpasswd(data []byte) (map[string]string, error) {
+	users := map[string]string{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		parts := strings.SplitN(text, ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("line %d: expected user:hash", line)
+		}
+
+		if !isBcrypt(parts[1]) && !strings.HasPrefix(parts[1], "{SHA}") {
+			return nil, fmt.Errorf("line %d: password for %s must be hashed with bcrypt (htpasswd -B) or SHA1 (htpasswd -s)", line, parts[0])
+		}
+
+		users[parts[0]] = parts[1]
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (m *htpasswdMethod) credentials(r *http.Request) (User, bool) {
+	user, password, ok := r.BasicAuth()
+	if !ok || !m.valid(user, password) {
+		return User{}, false
+	}
+
+	return User{Name: user}, true
+}
+
+func (m *htpasswdMethod) login(w http.ResponseWriter, r *http.Request, next string) (User, bool) {
+	if user, ok := m.credentials(r); ok {
+		return user, true
+	}
+
+	w.Header().Set("WWW-Authenticate", `Basic realm="Octant", charset="UTF-8"`)
+	http.Error(w, "unauthorized", http.StatusUnauthorized)
+	return User{}, false
+}
+
+func (m *htpasswdMethod) valid(user, password string) bool {
+	hash, ok := m.users[user]
+	if !ok {
+		return false
+	}
+
+	if isBcrypt(hash) {
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+	}
+
+	sum := sha1.Sum([]byte(password))
+	expected := "{SHA}" + base64.StdEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(expected)) == 1
+}
+
+func isBcrypt(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
@@ -0,0 +1,469 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+const (
+	// loginTTL is how long a user has to log in with the OIDC provider.
+	loginTTL = 10 * time.Minute
+	// keyRefetchInterval is the least time between fetches of the provider's
+	// keys, so tokens with unknown key IDs can't make Octant flood the provider.
+	keyRefetchInterval = time.Minute
+	// keyFetchTimeout bounds a fetch of the provider's keys.
+	keyFetchTimeout = 10 * time.Second
+)
+
+// OIDCOptions configures OpenID Connect authentication.
+type OIDCOptions struct {
+	// Issuer is the issuer URL of the provider.
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is the callback URL registered with the provider. If it is blank,
+	// it is built from the request's host.
+	RedirectURL string
+	// UsernameClaim is the ID token claim used as the user name. It defaults to email.
+	UsernameClaim string
+	// GroupsClaim is the ID token claim listing the user's groups. It defaults to groups.
+	GroupsClaim string
+}
+
+// oidcMethod authenticates users with the authorization code flow.
+type oidcMethod struct {
+	options      OIDCOptions
+	signer       *signer
+	callbackPath string
+	client       *http.Client
+
+	authURL  string
+	tokenURL string
+	jwksURL  string
+
+	mu   sync.Mutex
+	keys map[string]*rsa.PublicKey
+	// fetched is when the keys were last fetched.
+	fetched time.Time
+	// fetching is closed when the fetch in progress finishes.
+	fetching chan struct{}
+}
+
+var _ method = (*oidcMethod)(nil)
+var _ callbackMethod = (*oidcMethod)(nil)
+
+// loginState is stored in a cookie while the user logs in with the provider.
+type loginState struct {
+	State   string `json:"state"`
+	Nonce   string `json:"nonce"`
+	Next    string `json:"next"`
+	Expires int64  `json:"exp"`
+}
+
+func newOIDCMethod(ctx context.Context, options OIDCOptions, signer *signer, callbackPath string) (*oidcMethod, error) {
+	if options.Issuer == "" || options.ClientID == "" {
+		return nil, fmt.Errorf("OIDC authentication requires an issuer and client ID")
+	}
+	if options.UsernameClaim == "" {
+		options.UsernameClaim = "email"
+	}
+	if options.GroupsClaim == "" {
+		options.GroupsClaim = "groups"
+	}
+
+	m := &oidcMethod{
+		options:      options,
+		signer:       signer,
+		callbackPath: callbackPath,
+		client:       http.DefaultClient,
+		keys:         map[string]*rsa.PublicKey{},
+	}
+
+	var discovery struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		JWKSURI               string `json:"jwks_uri"`
+	}
+	discoveryURL := strings.TrimSuffix(options.Issuer, "/") + "/.well-known/openid-configuration"
+	if err := m.getJSON(ctx, discoveryURL, &discovery); err != nil {
+		return nil, fmt.Errorf("discover OIDC provider: %w", err)
+	}
+
+	if strings.TrimSuffix(discovery.Issuer, "/") != strings.TrimSuffix(options.Issuer, "/") {
+		return nil, fmt.Errorf("OIDC provider issuer %q does not match %q", discovery.Issuer, options.Issuer)
+	}
+
+	m.options.Issuer = discovery.Issuer
+	m.authURL = discovery.AuthorizationEndpoint
+	m.tokenURL = discovery.TokenEndpoint
+	m.jwksURL = discovery.JWKSURI
+
+	return m, nil
+}
+
+// credentials accepts ID tokens sent as bearer tokens.
+func (m *oidcMethod) credentials(r *http.Request) (User, bool) {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return User{}, false
+	}
+
+	user, err := m.verify(r.Context(), strings.TrimPrefix(header, "Bearer "), "")
+	if err != nil {
+		log.From(r.Context()).WithErr(err).Debugf("invalid ID token")
+		return User{}, false
+	}
+
+	return user, true
+}
+
+func (m *oidcMethod) login(w http.ResponseWriter, r *http.Request, next string) (User, bool) {
+	state, err := randomHex()
+	if err != nil {
+		http.Error(w, "unable to start login", http.StatusInternalServerError)
+		return User{}, false
+	}
+	nonce, err := randomHex()
+	if err != nil {
+		http.Error(w, "unable to start login", http.StatusInternalServerError)
+		return User{}, false
+	}
+
+	value := loginState{
+		State:   state,
+		Nonce:   nonce,
+		Next:    next,
+		Expires: m.signer.now().Add(loginTTL).Unix(),
+	}
+	if err := m.signer.setCookie(w, r, stateCookie, value, loginTTL); err != nil {
+		http.Error(w, "unable to start login", http.StatusInternalServerError)
+		return User{}, false
+	}
+
+	authCodeURL := m.config(r).AuthCodeURL(state, oauth2.SetAuthURLParam("nonce", nonce))
+	http.Redirect(w, r, authCodeURL, http.StatusFound)
+	return User{}, false
+}
+
+func (m *oidcMethod) callback(w http.ResponseWriter, r *http.Request) (User, string, bool) {
+	logger := log.From(r.Context())
+
+	cookie, err := r.Cookie(stateCookie)
+	if err != nil {
+		http.Error(w, "login was not started", http.StatusBadRequest)
+		return User{}, "", false
+	}
+	clearCookie(w, stateCookie)
+
+	var state loginState
+	if err := m.signer.decode(cookie.Value, &state); err != nil || m.signer.now().Unix() >= state.Expires {
+		http.Error(w, "login expired", http.StatusBadRequest)
+		return User{}, "", false
+	}
+
+	query := r.URL.Query()
+	if query.Get("state") != state.State {
+		http.Error(w, "login state does not match", http.StatusBadRequest)
+		return User{}, "", false
+	}
+
+	if providerErr := query.Get("error"); providerErr != "" {
+		logger.With("error", providerErr, "description", query.Get("error_description")).
+			Infof("OIDC login failed")
+		http.Error(w, "login failed: "+providerErr, http.StatusUnauthorized)
+		return User{}, "", false
+	}
+
+	ctx := context.WithValue(r.Context(), oauth2.HTTPClient, m.client)
+	token, err := m.config(r).Exchange(ctx, query.Get("code"))
+	if err != nil {
+		logger.WithErr(err).Errorf("exchange OIDC authorization code")
+		http.Error(w, "login failed", http.StatusUnauthorized)
+		return User{}, "", false
+	}
+
+	idToken, _ := token.Extra("id_token").(string)
+	if idToken == "" {
+		http.Error(w, "login failed: the provider did not return an ID token", http.StatusUnauthorized)
+		return User{}, "", false
+	}
+
+	user, err := m.verify(r.Context(), idToken, state.Nonce)
+	if err != nil {
+		logger.WithErr(err).Errorf("verify OIDC ID token")
+		http.Error(w, "login failed", http.StatusUnauthorized)
+		return User{}, "", false
+	}
+
+	return user, state.Next, true
+}
+
+func (m *oidcMethod) config(r *http.Request) *oauth2.Config {
+	redirectURL := m.options.RedirectURL
+	if redirectURL == "" {
+		scheme := "http"
+		if r.TLS != nil {
+			scheme = "https"
+		}
+		redirectURL = scheme + "://" + r.Host + m.callbackPath
+	}
+
+	return &oauth2.Config{
+		ClientID:     m.options.ClientID,
+		ClientSecret: m.options.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  m.authURL,
+			TokenURL: m.tokenURL,
+		},
+		RedirectURL: redirectURL,
+		Scopes:      []string{"openid", "email", "profile"},
+	}
+}
+
+// verify verifies an RS256 signed ID token and returns its user.
+func (m *oidcMethod) verify(ctx context.Context, raw, nonce string) (User, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return User{}, fmt.Errorf("malformed ID token")
+	}
+
+	var header struct {
+		Algorithm string `json:"alg"`
+		KeyID     string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return User{}, fmt.Errorf("decode ID token header: %w", err)
+	}
+	if header.Algorithm != "RS256" {
+		return User{}, fmt.Errorf("unsupported ID token algorithm %q", header.Algorithm)
+	}
+
+	key, err := m.key(ctx, header.KeyID)
+	if err != nil {
+		return User{}, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return User{}, fmt.Errorf("decode ID token signature: %w", err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return User{}, fmt.Errorf("ID token signature is invalid")
+	}
+
+	claims := map[string]interface{}{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return User{}, fmt.Errorf("decode ID token claims: %w", err)
+	}
+
+	if issuer, _ := claims["iss"].(string); issuer != m.options.Issuer {
+		return User{}, fmt.Errorf("ID token issuer %q is not %q", issuer, m.options.Issuer)
+	}
+	if !hasAudience(claims["aud"], m.options.ClientID) {
+		return User{}, fmt.Errorf("ID token was not issued for %s", m.options.ClientID)
+	}
+	if expires, ok := claims["exp"].(float64); !ok || m.signer.now().Unix() >= int64(expires) {
+		return User{}, fmt.Errorf("ID token has expired")
+	}
+	if nonce != "" {
+		if actual, _ := claims["nonce"].(string); actual != nonce {
+			return User{}, fmt.Errorf("ID token nonce does not match")
+		}
+	}
+
+	name, _ := claims[m.options.UsernameClaim].(string)
+	if name == "" {
+		return User{}, fmt.Errorf("ID token has no %s claim", m.options.UsernameClaim)
+	}
+
+	return User{Name: name, Groups: stringList(claims[m.options.GroupsClaim])}, nil
+}
+
+// key returns the provider's signing key. Keys are fetched again when the key ID
+// is unknown so provider key rotation is picked up, but at most once every
+// keyRefetchInterval. Requests wait for a fetch in progress instead of starting
+// their own.
+func (m *oidcMethod) key(ctx context.Context, keyID string) (*rsa.PublicKey, error) {
+	m.mu.Lock()
+	if key, ok := m.keys[keyID]; ok {
+		m.mu.Unlock()
+		return key, nil
+	}
+
+	fetching := m.fetching
+	if fetching == nil {
+		if !m.fetched.IsZero() && m.signer.now().Sub(m.fetched) < keyRefetchInterval {
+			m.mu.Unlock()
+			return nil, fmt.Errorf("OIDC provider has no key %q", keyID)
+		}
+
+		fetching = make(chan struct{})
+		m.fetching = fetching
+		m.fetched = m.signer.now()
+		m.mu.Unlock()
+
+		// The fetch outlives the request which started it, since other
+		// requests may be waiting for it.
+		fetchCtx, cancel := context.WithTimeout(context.Background(), keyFetchTimeout)
+		keys, err := m.fetchKeys(fetchCtx)
+		cancel()
+
+		m.mu.Lock()
+		if err == nil {
+			m.keys = keys
+		}
+		m.fetching = nil
+		close(fetching)
+		m.mu.Unlock()
+
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		m.mu.Unlock()
+
+		select {
+		case <-fetching:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key, ok := m.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("OIDC provider has no key %q", keyID)
+	}
+
+	return key, nil
+}
+
+// fetchKeys fetches the provider's RSA signing keys.
+func (m *oidcMethod) fetchKeys(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	var jwks struct {
+		Keys []struct {
+			KeyType string `json:"kty"`
+			KeyID   string `json:"kid"`
+			N       string `json:"n"`
+			E       string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := m.getJSON(ctx, m.jwksURL, &jwks); err != nil {
+		return nil, fmt.Errorf("get OIDC provider keys: %w", err)
+	}
+
+	keys := map[string]*rsa.PublicKey{}
+	for _, jwk := range jwks.Keys {
+		if jwk.KeyType != "RSA" {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			continue
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			continue
+		}
+
+		keys[jwk.KeyID] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	return keys, nil
+}
+
+func (m *oidcMethod) getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("get %s: %s", url, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func hasAudience(aud interface{}, clientID string) bool {
+	switch aud := aud.(type) {
+	case string:
+		return aud == clientID
+	case []interface{}:
+		for _, item := range aud {
+			if item == clientID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// stringList returns the strings in a claim which is a string or a list of strings.
+func stringList(claim interface{}) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var list []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
+	}
+
+	return nil
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func randomHex() (string, error) {
+	b, err := randomBytes(16)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
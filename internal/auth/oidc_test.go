@@ -0,0 +1,223 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	stdjson "encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProvider struct {
+	*httptest.Server
+	key   *rsa.PrivateKey
+	nonce string
+	// keyFetches counts requests for the provider's keys.
+	keyFetches int32
+}
+
+func newFakeProvider(t *testing.T) *fakeProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	p := &fakeProvider{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = stdjson.NewEncoder(w).Encode(struct {
+			Issuer                string `json:"issuer"`
+			AuthorizationEndpoint string `json:"authorization_endpoint"`
+			TokenEndpoint         string `json:"token_endpoint"`
+			JWKSURI               string `json:"jwks_uri"`
+		}{p.URL, p.URL + "/authorize", p.URL + "/token", p.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&p.keyFetches, 1)
+		type jwk struct {
+			KeyType string `json:"kty"`
+			KeyID   string `json:"kid"`
+			N       string `json:"n"`
+			E       string `json:"e"`
+		}
+		_ = stdjson.NewEncoder(w).Encode(struct {
+			Keys []jwk `json:"keys"`
+		}{[]jwk{{
+			KeyType: "RSA",
+			KeyID:   "key",
+			N:       base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:       base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != "code" {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = stdjson.NewEncoder(w).Encode(struct {
+			AccessToken string `json:"access_token"`
+			TokenType   string `json:"token_type"`
+			IDToken     string `json:"id_token"`
+		}{"access", "Bearer", p.idToken(t, "octant", p.nonce)})
+	})
+
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+
+	return p
+}
+
+func (p *fakeProvider) idToken(t *testing.T, audience, nonce string) string {
+	header, err := stdjson.Marshal(struct {
+		Algorithm string `json:"alg"`
+		KeyID     string `json:"kid"`
+	}{"RS256", "key"})
+	require.NoError(t, err)
+
+	claims, err := stdjson.Marshal(struct {
+		Issuer   string   `json:"iss"`
+		Audience string   `json:"aud"`
+		Expires  int64    `json:"exp"`
+		Nonce    string   `json:"nonce,omitempty"`
+		Email    string   `json:"email"`
+		Groups   []string `json:"groups"`
+	}{p.URL, audience, time.Now().Add(time.Hour).Unix(), nonce, "alice@example.com", []string{"admins", "dev"}})
+	require.NoError(t, err)
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, p.key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestAuthenticator_oidc(t *testing.T) {
+	provider := newFakeProvider(t)
+
+	a, err := New(context.Background(), Options{
+		Mode:       ModeOIDC,
+		PathPrefix: "/api/v1/auth",
+		OIDC: OIDCOptions{
+			Issuer:   provider.URL,
+			ClientID: "octant",
+		},
+	})
+	require.NoError(t, err)
+	server := newTestServer(a)
+
+	// Logging in redirects to the provider.
+	rr := serve(server, httptest.NewRequest(http.MethodGet, "http://octant.test/api/v1/auth/login?next=/%23/overview", nil))
+	require.Equal(t, http.StatusFound, rr.Code)
+
+	location, err := url.Parse(rr.Header().Get("Location"))
+	require.NoError(t, err)
+	assert.Equal(t, provider.URL+"/authorize", location.Scheme+"://"+location.Host+location.Path)
+	assert.Equal(t, "http://octant.test/api/v1/auth/callback", location.Query().Get("redirect_uri"))
+
+	var stateCookieValue *http.Cookie
+	for _, cookie := range rr.Result().Cookies() {
+		if cookie.Name == stateCookie {
+			stateCookieValue = cookie
+		}
+	}
+	require.NotNil(t, stateCookieValue)
+
+	state := location.Query().Get("state")
+	provider.nonce = location.Query().Get("nonce")
+
+	// The callback must have the state the login started with.
+	req := httptest.NewRequest(http.MethodGet, "http://octant.test/api/v1/auth/callback?code=code&state=wrong", nil)
+	req.AddCookie(stateCookieValue)
+	assert.Equal(t, http.StatusBadRequest, serve(server, req).Code)
+
+	req = httptest.NewRequest(http.MethodGet, "http://octant.test/api/v1/auth/callback?code=code&state="+state, nil)
+	assert.Equal(t, http.StatusBadRequest, serve(server, req).Code, "no state cookie")
+
+	req = httptest.NewRequest(http.MethodGet, "http://octant.test/api/v1/auth/callback?code=code&state="+state, nil)
+	req.AddCookie(stateCookieValue)
+	rr = serve(server, req)
+	require.Equal(t, http.StatusFound, rr.Code, rr.Body.String())
+	assert.Equal(t, "/#/overview", rr.Header().Get("Location"))
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/stream", nil)
+	req.AddCookie(sessionCookie(t, rr))
+	rr = serve(server, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "alice@example.com,admins,dev", rr.Body.String())
+
+	// ID tokens are accepted as bearer tokens.
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/stream", nil)
+	req.Header.Set("Authorization", "Bearer "+provider.idToken(t, "octant", ""))
+	assert.Equal(t, http.StatusOK, serve(server, req).Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/stream", nil)
+	req.Header.Set("Authorization", "Bearer "+provider.idToken(t, "other", ""))
+	assert.Equal(t, http.StatusUnauthorized, serve(server, req).Code, "issued for another client")
+}
+
+func TestOIDCMethod_key(t *testing.T) {
+	provider := newFakeProvider(t)
+
+	now := time.Now()
+	s := &signer{secret: []byte("secret"), now: func() time.Time { return now }}
+
+	m, err := newOIDCMethod(context.Background(), OIDCOptions{
+		Issuer:   provider.URL,
+		ClientID: "octant",
+	}, s, "/callback")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = m.key(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&provider.keyFetches))
+
+	// Unknown keys don't fetch the keys again until the refetch interval passes.
+	_, err = m.key(ctx, "unknown")
+	require.Error(t, err)
+	_, err = m.key(ctx, "unknown")
+	require.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&provider.keyFetches))
+
+	now = now.Add(keyRefetchInterval)
+	_, err = m.key(ctx, "unknown")
+	require.Error(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&provider.keyFetches))
+
+	_, err = m.key(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&provider.keyFetches))
+}
+
+func TestNew_oidcUnknownIssuer(t *testing.T) {
+	provider := newFakeProvider(t)
+
+	_, err := New(context.Background(), Options{
+		Mode: ModeOIDC,
+		OIDC: OIDCOptions{
+			Issuer:   provider.URL + "/other",
+			ClientID: "octant",
+		},
+	})
+	require.Error(t, err)
+}
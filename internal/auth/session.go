@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+const (
+	// SessionCookie is the name of the session cookie.
+	SessionCookie = "octant_session"
+	// stateCookie holds the state of an OIDC login.
+	stateCookie = "octant_auth_state"
+)
+
+var errInvalidCookie = errors.New("invalid cookie")
+
+// signer signs cookie values so they can't be forged or modified.
+type signer struct {
+	secret []byte
+	now    func() time.Time
+}
+
+// session is the content of a session cookie.
+type session struct {
+	User    string   `json:"user"`
+	Groups  []string `json:"groups,omitempty"`
+	Expires int64    `json:"exp"`
+}
+
+// encode signs v and encodes it for use as a cookie value.
+func (s *signer) encode(v interface{}) (string, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("encode cookie: %w", err)
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.mac(encoded)), nil
+}
+
+// decode verifies a cookie value and decodes it into v.
+func (s *signer) decode(value string, v interface{}) error {
+	parts := strings.Split(value, ".")
+	if len(parts) != 2 {
+		return errInvalidCookie
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(mac, s.mac(parts[0])) {
+		return errInvalidCookie
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return errInvalidCookie
+	}
+
+	if err := json.Unmarshal(payload, v); err != nil {
+		return errInvalidCookie
+	}
+
+	return nil
+}
+
+func (s *signer) mac(value string) []byte {
+	h := hmac.New(sha256.New, s.secret)
+	h.Write([]byte(value))
+	return h.Sum(nil)
+}
+
+// setCookie sets a signed cookie.
+func (s *signer) setCookie(w http.ResponseWriter, r *http.Request, name string, v interface{}, ttl time.Duration) error {
+	value, err := s.encode(v)
+	if err != nil {
+		return err
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     "/",
+		Expires:  s.now().Add(ttl),
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	return nil
+}
+
+// clearCookie removes a cookie.
+func clearCookie(w http.ResponseWriter, name string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     name,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+	})
+}
+
+// sessionUser returns the user for the request's session cookie.
+func (s *signer) sessionUser(r *http.Request) (User, bool) {
+	cookie, err := r.Cookie(SessionCookie)
+	if err != nil {
+		return User{}, false
+	}
+
+	var current session
+	if err := s.decode(cookie.Value, &current); err != nil {
+		return User{}, false
+	}
+
+	if current.User == "" || s.now().Unix() >= current.Expires {
+		return User{}, false
+	}
+
+	return User{Name: current.User, Groups: current.Groups}, true
+}
@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package auth
+
+import (
+	"crypto/subtle"
+	"html/template"
+	"net/http"
+	"strings"
+)
+
+// TokenUser is the user name for requests authenticated with the token.
+const TokenUser = "token"
+
+// tokenMethod authenticates requests with a bearer token.
+type tokenMethod struct {
+	token string
+}
+
+var _ method = (*tokenMethod)(nil)
+
+func (m *tokenMethod) credentials(r *http.Request) (User, bool) {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return User{}, false
+	}
+
+	return User{Name: TokenUser}, m.valid(strings.TrimPrefix(header, "Bearer "))
+}
+
+// login accepts the token posted by the login page, or sent in the query of the
+// URL Octant prints when it starts.
+func (m *tokenMethod) login(w http.ResponseWriter, r *http.Request, next string) (User, bool) {
+	values := r.URL.Query()
+	if r.Method == http.MethodPost {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, "invalid login form", http.StatusBadRequest)
+			return User{}, false
+		}
+		values = r.PostForm
+	}
+
+	token, sent := values["token"]
+	if sent && m.valid(token[0]) {
+		return User{Name: TokenUser}, true
+	}
+
+	code := http.StatusOK
+	message := ""
+	if sent {
+		code = http.StatusUnauthorized
+		message = "The token is not valid."
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(code)
+	_ = tokenLoginPage.Execute(w, map[string]string{
+		"Message": message,
+		"Next":    next,
+	})
+
+	return User{}, false
+}
+
+func (m *tokenMethod) valid(token string) bool {
+	return subtle.ConstantTimeCompare([]byte(token), []byte(m.token)) == 1
+}
+
+var tokenLoginPage = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Octant</title></head>
+<body>
+<h1>Log in to Octant</h1>
+<p>Enter the token Octant printed when it started.</p>
+{{if .Message}}<p>{{.Message}}</p>{{end}}
+<form method="post">
+<input type="password" name="token" autofocus>
+<input type="hidden" name="next" value="{{.Next}}">
+<button type="submit">Log in</button>
+</form>
+</body>
+</html>
+`))
@@ -0,0 +1,302 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/transport"
+)
+
+const (
+	// credentialRefreshTimeout is how long a credential plugin has to finish.
+	// It is generous because plugins may wait for a login in a browser.
+	credentialRefreshTimeout = 2 * time.Minute
+	// maxStderrLength is the amount of a credential plugin's stderr which is
+	// kept. The end of the output is kept since that is where errors are.
+	maxStderrLength = 4096
+	// stderrCaptureInterval is the least time between runs of an exec plugin
+	// to capture its stderr after requests fail in it.
+	stderrCaptureInterval = time.Minute
+	// stderrCaptureTimeout is how long those runs have to finish. It is short
+	// since nobody is waiting to log in.
+	stderrCaptureTimeout = 30 * time.Second
+
+	execInfoEnv = "KUBERNETES_EXEC_INFO"
+	execInfo    = `{"apiVersion":"client.authentication.k8s.io/v1alpha1","kind":"ExecCredential","spec":{"interactive":false}}`
+)
+
+// AuthStatus is the status of the credentials used to talk to a cluster.
+type AuthStatus struct {
+	// Failed is true if the cluster rejected the credentials or they could
+	// not be created.
+	Failed bool `json:"failed"`
+	// Message describes the failure.
+	Message string `json:"message,omitempty"`
+	// Plugin is the exec or auth provider plugin which creates the
+	// credentials, if any.
+	Plugin string `json:"plugin,omitempty"`
+	// Command is the command run by an exec plugin.
+	Command string `json:"command,omitempty"`
+	// Stderr is the output of the exec plugin's last failed run.
+	Stderr string `json:"stderr,omitempty"`
+}
+
+// AuthChecker checks and refreshes the credentials used to talk to a cluster.
+type AuthChecker interface {
+	// AuthStatus returns the last known status of the credentials.
+	AuthStatus() AuthStatus
+	// CheckAuth makes a request to the cluster to find the status of the
+	// credentials.
+	CheckAuth(ctx context.Context) AuthStatus
+	// RefreshCredentials runs the credential plugin again and checks the
+	// credentials it creates.
+	RefreshCredentials(ctx context.Context) AuthStatus
+}
+
+var _ AuthChecker = (*Cluster)(nil)
+
+// authTracker tracks the status of a cluster's credentials.
+type authTracker struct {
+	execConfig *clientcmdapi.ExecConfig
+	runPlugin  func(ctx context.Context, config *clientcmdapi.ExecConfig) (string, error)
+
+	mu          sync.Mutex
+	status      AuthStatus
+	lastCapture time.Time
+}
+
+func newAuthTracker(restConfig *rest.Config) *authTracker {
+	a := &authTracker{runPlugin: runExecPlugin}
+
+	switch {
+	case restConfig.ExecProvider != nil:
+		a.execConfig = restConfig.ExecProvider
+		a.status.Plugin = "exec"
+		a.status.Command = strings.Join(append([]string{a.execConfig.Command}, a.execConfig.Args...), " ")
+	case restConfig.AuthProvider != nil:
+		a.status.Plugin = restConfig.AuthProvider.Name
+	}
+
+	return a
+}
+
+// wrap records the status of the credentials from the responses to requests
+// made by rt.
+func (a *authTracker) wrap(rt http.RoundTripper) http.RoundTripper {
+	return &authRoundTripper{tracker: a, next: rt}
+}
+
+func (a *authTracker) get() AuthStatus {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.status
+}
+
+// fail records a failure. The stderr of an earlier failure is kept if stderr
+// is empty, since requests after a plugin fails don't have its output. When
+// the credentials first fail without stderr, the exec plugin is run to
+// capture it.
+func (a *authTracker) fail(message, stderr string) AuthStatus {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	wasFailed := a.status.Failed
+	a.status.Failed = true
+	a.status.Message = message
+	if stderr != "" {
+		a.status.Stderr = stderr
+	} else if !wasFailed {
+		a.captureStderr()
+	}
+
+	return a.status
+}
+
+// captureStderr runs the exec plugin in the background and records its stderr
+// if it fails. Runs are at least stderrCaptureInterval apart. The plugin has
+// no stdin, so plugins which would prompt for a login fail instead. a.mu must
+// be held.
+func (a *authTracker) captureStderr() {
+	if a.execConfig == nil || time.Since(a.lastCapture) < stderrCaptureInterval {
+		return
+	}
+	a.lastCapture = time.Now()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), stderrCaptureTimeout)
+		defer cancel()
+
+		stderr, err := a.runPlugin(ctx, a.execConfig)
+		if err == nil || stderr == "" {
+			return
+		}
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		if a.status.Failed {
+			a.status.Stderr = stderr
+		}
+	}()
+}
+
+func (a *authTracker) succeed() AuthStatus {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.status.Failed = false
+	a.status.Message = ""
+	a.status.Stderr = ""
+
+	return a.status
+}
+
+type authRoundTripper struct {
+	tracker *authTracker
+	next    http.RoundTripper
+}
+
+var _ http.RoundTripper = (*authRoundTripper)(nil)
+
+func (rt *authRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if probe, ok := req.Context().Value(authProbeKey{}).(*authProbe); ok {
+		atomic.StoreInt32(&probe.reached, 1)
+	}
+
+	res, err := rt.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == http.StatusUnauthorized {
+		rt.tracker.fail("the cluster rejected the credentials", "")
+	} else if rt.tracker.get().Failed {
+		rt.tracker.succeed()
+	}
+
+	return res, nil
+}
+
+type authProbeKey struct{}
+
+// authProbe records whether a request reached an authRoundTripper. Credential
+// plugins wrap the transport outside of the tracker, so a request which fails
+// without reaching it failed while its credentials were created.
+type authProbe struct {
+	reached int32
+}
+
+func (p *authProbe) wasReached() bool {
+	return atomic.LoadInt32(&p.reached) == 1
+}
+
+// withAuthTracker returns a copy of restConfig whose requests are tracked by
+// tracker.
+func withAuthTracker(restConfig *rest.Config, tracker *authTracker) *rest.Config {
+	config := rest.CopyConfig(restConfig)
+	config.WrapTransport = transport.Wrappers(config.WrapTransport, tracker.wrap)
+	return config
+}
+
+// AuthStatus returns the last known status of the cluster's credentials.
+func (c *Cluster) AuthStatus() AuthStatus {
+	return c.auth.get()
+}
+
+// CheckAuth requests the cluster's version to find the status of the
+// credentials.
+func (c *Cluster) CheckAuth(ctx context.Context) AuthStatus {
+	return checkAuth(ctx, c.kubernetesClient, c.auth)
+}
+
+// RefreshCredentials runs the cluster's exec credential plugin and checks the
+// credentials it creates. Clusters without an exec plugin are only checked.
+func (c *Cluster) RefreshCredentials(ctx context.Context) AuthStatus {
+	if c.auth.execConfig != nil {
+		if stderr, err := c.auth.runPlugin(ctx, c.auth.execConfig); err != nil {
+			return c.auth.fail(err.Error(), stderr)
+		}
+	}
+
+	return c.CheckAuth(ctx)
+}
+
+func checkAuth(ctx context.Context, client kubernetes.Interface, tracker *authTracker) AuthStatus {
+	probe := &authProbe{}
+	probeCtx := context.WithValue(ctx, authProbeKey{}, probe)
+
+	err := client.Discovery().RESTClient().Get().AbsPath("/version").Do(probeCtx).Error()
+	switch {
+	case err == nil:
+		return tracker.succeed()
+	case kerrors.IsUnauthorized(err):
+		return tracker.fail("the cluster rejected the credentials", "")
+	case tracker.get().Plugin != "" && !probe.wasReached() && ctx.Err() == nil:
+		// The request failed in the credential plugin's round tripper.
+		return tracker.fail(err.Error(), "")
+	default:
+		// The cluster may be unreachable, which says nothing about the
+		// credentials.
+		return tracker.get()
+	}
+}
+
+// runExecPlugin runs an exec credential plugin and returns the end of its
+// stderr. The credentials it prints are discarded; the plugin is run so it
+// can refresh any credentials it caches, such as after a login.
+func runExecPlugin(ctx context.Context, config *clientcmdapi.ExecConfig) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, credentialRefreshTimeout)
+	defer cancel()
+
+	env := os.Environ()
+	for _, e := range config.Env {
+		env = append(env, fmt.Sprintf("%s=%s", e.Name, e.Value))
+	}
+	if config.APIVersion == "client.authentication.k8s.io/v1alpha1" {
+		env = append(env, fmt.Sprintf("%s=%s", execInfoEnv, execInfo))
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, config.Command, config.Args...)
+	cmd.Env = env
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		message := fmt.Sprintf("exec: executable %s failed: %v", config.Command, err)
+		if execErr, ok := err.(*exec.Error); ok && execErr.Err == exec.ErrNotFound {
+			message = fmt.Sprintf("exec: executable %s not found", config.Command)
+			if config.InstallHint != "" {
+				message = fmt.Sprintf("%s\n\n%s", message, config.InstallHint)
+			}
+		}
+		return tail(stderr.String(), maxStderrLength), errors.New(message)
+	}
+
+	return "", nil
+}
+
+// tail returns the last n bytes of s.
+func tail(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[len(s)-n:]
+}
@@ -0,0 +1,183 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestCluster_CheckAuth(t *testing.T) {
+	var unauthorized int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&unauthorized) == 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"major":"1","minor":"19"}`))
+	}))
+	defer server.Close()
+
+	c, err := newCluster(context.Background(), nil, &rest.Config{Host: server.URL}, "default", nil)
+	require.NoError(t, err)
+	defer c.Close()
+
+	ctx := context.Background()
+	assert.Equal(t, AuthStatus{}, c.CheckAuth(ctx))
+
+	atomic.StoreInt32(&unauthorized, 1)
+	status := c.CheckAuth(ctx)
+	assert.True(t, status.Failed)
+	assert.Equal(t, "the cluster rejected the credentials", status.Message)
+
+	atomic.StoreInt32(&unauthorized, 0)
+	_, err = c.kubernetesClient.Discovery().ServerVersion()
+	require.NoError(t, err)
+	assert.False(t, c.AuthStatus().Failed, "successful requests clear failures")
+}
+
+func TestCluster_CheckAuth_execFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"major":"1","minor":"19"}`))
+	}))
+	defer server.Close()
+
+	c, err := newCluster(context.Background(), nil, &rest.Config{
+		Host: server.URL,
+		ExecProvider: &clientcmdapi.ExecConfig{
+			APIVersion: "client.authentication.k8s.io/v1beta1",
+			Command:    "sh",
+			Args:       []string{"-c", "echo token expired >&2; exit 1"},
+		},
+	}, "default", nil)
+	require.NoError(t, err)
+	defer c.Close()
+
+	status := c.CheckAuth(context.Background())
+	assert.True(t, status.Failed, "requests which fail in the plugin fail the credentials")
+	assert.NotEmpty(t, status.Message)
+	assert.Eventually(t, func() bool {
+		return c.AuthStatus().Stderr == "token expired\n"
+	}, 5*time.Second, 10*time.Millisecond, "the plugin is run to capture its stderr")
+}
+
+func Test_authTracker_captureStderr(t *testing.T) {
+	var runs int32
+	tracker := newAuthTracker(&rest.Config{ExecProvider: &clientcmdapi.ExecConfig{Command: "plugin"}})
+	tracker.runPlugin = func(ctx context.Context, config *clientcmdapi.ExecConfig) (string, error) {
+		atomic.AddInt32(&runs, 1)
+		return "login required", errors.New("exec: executable plugin failed")
+	}
+
+	tracker.fail("the cluster rejected the credentials", "")
+	assert.Eventually(t, func() bool {
+		return tracker.get().Stderr == "login required"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	tracker.fail("the cluster rejected the credentials", "")
+	tracker.succeed()
+	tracker.fail("the cluster rejected the credentials", "")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&runs), "the plugin is run at most once an interval")
+}
+
+func TestCluster_CheckAuth_unreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	c, err := newCluster(context.Background(), nil, &rest.Config{
+		Host: server.URL,
+		ExecProvider: &clientcmdapi.ExecConfig{
+			APIVersion: "client.authentication.k8s.io/v1beta1",
+			Command:    "sh",
+			Args:       []string{"-c", `echo '{"apiVersion":"client.authentication.k8s.io/v1beta1","kind":"ExecCredential","status":{"token":"abc"}}'`},
+		},
+	}, "default", nil)
+	require.NoError(t, err)
+	defer c.Close()
+
+	status := c.CheckAuth(context.Background())
+	assert.False(t, status.Failed, "an unreachable cluster says nothing about the credentials")
+}
+
+func TestCluster_RefreshCredentials(t *testing.T) {
+	c := &Cluster{auth: newAuthTracker(&rest.Config{
+		ExecProvider: &clientcmdapi.ExecConfig{
+			Command: "sh",
+			Args:    []string{"-c", "echo token expired >&2; exit 1"},
+		},
+	})}
+
+	status := c.RefreshCredentials(context.Background())
+	assert.True(t, status.Failed)
+	assert.Equal(t, "exec", status.Plugin)
+	assert.Equal(t, "sh -c echo token expired >&2; exit 1", status.Command)
+	assert.Equal(t, "token expired\n", status.Stderr)
+	assert.Contains(t, status.Message, "exec: executable sh failed")
+}
+
+func Test_runExecPlugin(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *clientcmdapi.ExecConfig
+		stderr   string
+		expected string
+	}{
+		{
+			name: "success",
+			config: &clientcmdapi.ExecConfig{
+				Command: "sh",
+				Args:    []string{"-c", `test "$TOKEN" = "abc"`},
+				Env:     []clientcmdapi.ExecEnvVar{{Name: "TOKEN", Value: "abc"}},
+			},
+		},
+		{
+			name: "failure",
+			config: &clientcmdapi.ExecConfig{
+				Command: "sh",
+				Args:    []string{"-c", "echo login required >&2; exit 2"},
+			},
+			stderr:   "login required\n",
+			expected: "exec: executable sh failed: exit status 2",
+		},
+		{
+			name: "not found",
+			config: &clientcmdapi.ExecConfig{
+				Command:     "octant-missing-credential-plugin",
+				InstallHint: "install the plugin",
+			},
+			expected: "exec: executable octant-missing-credential-plugin not found\n\ninstall the plugin",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stderr, err := runExecPlugin(context.Background(), test.config)
+			if test.expected == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, test.expected, err.Error())
+			assert.Equal(t, test.stderr, stderr)
+		})
+	}
+}
+
+func Test_tail(t *testing.T) {
+	assert.Equal(t, "abc", tail("abc", 5))
+	assert.Equal(t, strings.Repeat("b", 3), tail("aa"+strings.Repeat("b", 3), 3))
+}
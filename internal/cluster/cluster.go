@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -86,6 +87,11 @@ type Cluster struct {
 
 	defaultNamespace   string
 	providedNamespaces []string
+
+	auth *authTracker
+
+	impersonatedMu sync.Mutex
+	impersonated   map[string]*impersonatedCluster
 }
 
 var _ ClientInterface = (*Cluster)(nil)
@@ -97,6 +103,9 @@ func newCluster(ctx context.Context, clientConfig clientcmd.ClientConfig, restCl
 	_ = admissionregistrationv1.AddToScheme(scheme.Scheme)
 	_ = apiregistrationv1.AddToScheme(scheme.Scheme)
 
+	auth := newAuthTracker(restClient)
+	restClient = withAuthTracker(restClient, auth)
+
 	kubernetesClient, err := kubernetes.NewForConfig(restClient)
 	if err != nil {
 		return nil, errors.Wrap(err, "create kubernetes client")
@@ -136,6 +145,7 @@ func newCluster(ctx context.Context, clientConfig clientcmd.ClientConfig, restCl
 		logger:             internalLog.From(ctx),
 		defaultNamespace:   defaultNamespace,
 		providedNamespaces: providedNamespaces,
+		auth:               auth,
 	}
 
 	ctx, cancel := context.WithCancel(ctx)
@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	clusterTypes "github.com/vmware-tanzu/octant/pkg/cluster"
+)
+
+// Identity is a Kubernetes user that cluster calls are made as.
+type Identity struct {
+	User   string   `json:"user"`
+	Groups []string `json:"groups,omitempty"`
+}
+
+// String returns a string which is unique for the identity.
+func (i Identity) String() string {
+	groups := append([]string(nil), i.Groups...)
+	sort.Strings(groups)
+	return i.User + "|" + strings.Join(groups, ",")
+}
+
+type identityKey struct{}
+
+// WithIdentity returns a context whose cluster calls are made as identity.
+func WithIdentity(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFrom returns the identity for a context. It returns false if cluster
+// calls are made as Octant.
+func IdentityFrom(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
+
+// Impersonator is implemented by clients which can make calls as another identity.
+type Impersonator interface {
+	// Impersonate returns a client which makes calls as identity.
+	Impersonate(identity Identity) (ClientInterface, error)
+}
+
+// ClientFor returns a client which makes calls as the identity in ctx. It returns
+// client if ctx doesn't have an identity.
+func ClientFor(ctx context.Context, client ClientInterface) (ClientInterface, error) {
+	identity, ok := IdentityFrom(ctx)
+	if !ok {
+		return client, nil
+	}
+
+	impersonator, ok := client.(Impersonator)
+	if !ok {
+		return nil, fmt.Errorf("cluster client is unable to impersonate %s", identity.User)
+	}
+
+	return impersonator.Impersonate(identity)
+}
+
+// Impersonate returns a client which makes calls as identity. Clients are reused
+// for an identity.
+func (c *Cluster) Impersonate(identity Identity) (ClientInterface, error) {
+	c.impersonatedMu.Lock()
+	defer c.impersonatedMu.Unlock()
+
+	key := identity.String()
+	if client, ok := c.impersonated[key]; ok {
+		return client, nil
+	}
+
+	restConfig := rest.CopyConfig(c.restConfig)
+	restConfig.Impersonate = rest.ImpersonationConfig{
+		UserName: identity.User,
+		Groups:   identity.Groups,
+	}
+
+	kubernetesClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "create impersonating kubernetes client")
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "create impersonating dynamic client")
+	}
+
+	client := &impersonatedCluster{
+		Cluster:          c,
+		restConfig:       restConfig,
+		kubernetesClient: kubernetesClient,
+		dynamicClient:    dynamicClient,
+	}
+
+	if c.impersonated == nil {
+		c.impersonated = map[string]*impersonatedCluster{}
+	}
+	c.impersonated[key] = client
+
+	return client, nil
+}
+
+// impersonatedCluster makes calls as another identity. Discovery is shared with
+// the cluster it was created from since it doesn't depend on the identity.
+type impersonatedCluster struct {
+	*Cluster
+
+	restConfig       *rest.Config
+	kubernetesClient kubernetes.Interface
+	dynamicClient    dynamic.Interface
+}
+
+var _ ClientInterface = (*impersonatedCluster)(nil)
+
+// KubernetesClient returns a Kubernetes client.
+func (c *impersonatedCluster) KubernetesClient() (kubernetes.Interface, error) {
+	return c.kubernetesClient, nil
+}
+
+// DynamicClient returns a dynamic client.
+func (c *impersonatedCluster) DynamicClient() (dynamic.Interface, error) {
+	return c.dynamicClient, nil
+}
+
+// RESTClient returns a RESTClient for the cluster.
+func (c *impersonatedCluster) RESTClient() (rest.Interface, error) {
+	return rest.RESTClientFor(c.restConfig)
+}
+
+// RESTConfig returns configuration for communicating with the cluster.
+func (c *impersonatedCluster) RESTConfig() *rest.Config {
+	return c.restConfig
+}
+
+// NamespaceClient returns a namespace client.
+func (c *impersonatedCluster) NamespaceClient() (clusterTypes.NamespaceInterface, error) {
+	rc, err := c.RESTClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ns, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return nil, errors.Wrap(err, "resolving initial namespace")
+	}
+	return newNamespaceClient(c.dynamicClient, rc, ns, c.providedNamespaces), nil
+}
+
+// Impersonate returns a client which makes calls as identity.
+func (c *impersonatedCluster) Impersonate(identity Identity) (ClientInterface, error) {
+	return c.Cluster.Impersonate(identity)
+}
+
+// Close does nothing since the cluster the client was created from owns its resources.
+func (c *impersonatedCluster) Close() {}
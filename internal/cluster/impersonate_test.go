@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+)
+
+func TestIdentity_String(t *testing.T) {
+	a := Identity{User: "alice", Groups: []string{"b", "a"}}
+	b := Identity{User: "alice", Groups: []string{"a", "b"}}
+	assert.Equal(t, a.String(), b.String())
+	assert.Equal(t, []string{"b", "a"}, a.Groups, "groups are not sorted in place")
+
+	assert.NotEqual(t, a.String(), Identity{User: "alice"}.String())
+}
+
+func TestClientFor(t *testing.T) {
+	c := &Cluster{restConfig: &rest.Config{Host: "https://cluster.test"}}
+
+	client, err := ClientFor(context.Background(), c)
+	require.NoError(t, err)
+	assert.Equal(t, c, client, "contexts without an identity use the cluster's client")
+
+	identity := Identity{User: "alice", Groups: []string{"dev"}}
+	ctx := WithIdentity(context.Background(), identity)
+
+	client, err = ClientFor(ctx, c)
+	require.NoError(t, err)
+	assert.Equal(t, rest.ImpersonationConfig{UserName: "alice", Groups: []string{"dev"}}, client.RESTConfig().Impersonate)
+	assert.Empty(t, c.RESTConfig().Impersonate.UserName, "cluster config is not modified")
+
+	again, err := ClientFor(ctx, c)
+	require.NoError(t, err)
+	assert.Same(t, client, again, "clients are reused for an identity")
+
+	other, err := ClientFor(WithIdentity(context.Background(), Identity{User: "bob"}), client)
+	require.NoError(t, err)
+	assert.Equal(t, "bob", other.RESTConfig().Impersonate.UserName, "impersonated clients impersonate from the cluster")
+}
+
+func TestClientFor_unsupported(t *testing.T) {
+	ctx := WithIdentity(context.Background(), Identity{User: "alice"})
+
+	_, err := ClientFor(ctx, nil)
+	require.Error(t, err)
+}
@@ -7,12 +7,17 @@ package commands
 
 import (
 	"context"
+	"crypto/tls"
 	"flag"
 	"fmt"
 	golog "log"
+	"net"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"runtime"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -20,10 +25,17 @@ import (
 	"k8s.io/klog"
 
 	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/audit"
+	"github.com/vmware-tanzu/octant/internal/auth"
 	"github.com/vmware-tanzu/octant/internal/config"
 	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/objectstore"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/internal/servertls"
 	pconfig "github.com/vmware-tanzu/octant/pkg/config"
 	"github.com/vmware-tanzu/octant/pkg/dash"
+	plog "github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
 )
 
 func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Command {
@@ -73,6 +85,32 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 				os.Exit(1)
 			}
 
+			authenticator, err := newAuthenticator(ctx, listener)
+			if err != nil {
+				golog.Printf("unable to configure authentication: %v", err)
+				os.Exit(1)
+			}
+
+			impersonation, err := newImpersonation(authenticator)
+			if err != nil {
+				golog.Printf("unable to configure impersonation: %v", err)
+				os.Exit(1)
+			}
+
+			tlsConfig, err := newTLSConfig(listener)
+			if err != nil {
+				golog.Printf("unable to configure TLS: %v", err)
+				os.Exit(1)
+			}
+
+			auditLog, err := newAuditLog(logger)
+			if err != nil {
+				golog.Printf("unable to configure audit log: %v", err)
+				os.Exit(1)
+			}
+
+			preferenceStore := newPreferences(logger)
+
 			go func() {
 				buildInfo := config.BuildInfo{
 					Version: version,
@@ -92,12 +130,26 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 					dash.WithClientUserAgent(fmt.Sprintf("octant/%s", version)),
 					dash.WithBuildInfo(buildInfo),
 					dash.WithListener(listener),
+					dash.WithAuthenticator(authenticator),
+					dash.WithImpersonation(impersonation),
+					dash.WithTLSConfig(tlsConfig),
+					dash.WithAuditLog(auditLog),
+					dash.WithPreferences(preferenceStore),
+					dash.WithCachePolicy(objectstore.CachePolicy{
+						MaxInformers:      viper.GetInt("cache-max-informers"),
+						IdleTimeout:       viper.GetDuration("cache-idle-timeout"),
+						MaxAnnotationSize: viper.GetInt("cache-max-annotation-size"),
+						ListPageThreshold: viper.GetInt("cache-list-page-threshold"),
+					}),
 				}
 				if viper.GetBool("disable-cluster-overview") {
 					options = append(options, dash.WithoutClusterOverview())
 				}
-				if viper.GetBool("enable-opencensus") {
-					options = append(options, dash.WithOpenCensus())
+				if viper.GetBool("read-only") {
+					options = append(options, dash.WithReadOnly())
+				}
+				if contexts := viper.GetStringSlice("contexts"); len(contexts) > 0 {
+					options = append(options, dash.WithContexts(contexts))
 				}
 				if file := viper.GetString("memstats"); file != "" {
 					options = append(options, dash.WithMemStats())
@@ -154,6 +206,7 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 	octantCmd.Flags().SortFlags = false
 
 	octantCmd.Flags().StringP("context", "", "", "initial context")
+	octantCmd.Flags().StringSlice("contexts", []string{}, "a list of contexts to browse side by side under the fleet page (read-only)")
 	octantCmd.Flags().BoolP("disable-cluster-overview", "", false, "disable cluster overview")
 	octantCmd.Flags().BoolP("enable-feature-applications", "", false, "enable applications feature")
 	octantCmd.Flags().String("kubeconfig", "", "absolute path to kubeConfig file")
@@ -163,11 +216,41 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 	octantCmd.Flags().BoolP("verbose", "v", false, "turn on debug logging")
 	octantCmd.Flags().IntP("client-max-recv-msg-size", "", pconfig.MaxMessageSize, "client max receiver message size")
 
+	octantCmd.Flags().String("auth-mode", "", "authentication mode: none, token, htpasswd, or oidc (default token when the listener is not a loopback address, otherwise none)")
+	octantCmd.Flags().String("auth-token", "", "token for token authentication (default generated)")
+	octantCmd.Flags().String("auth-htpasswd", "", "htpasswd file for htpasswd authentication")
+	octantCmd.Flags().String("auth-oidc-issuer", "", "issuer URL for OIDC authentication")
+	octantCmd.Flags().String("auth-oidc-client-id", "", "client ID for OIDC authentication")
+	octantCmd.Flags().String("auth-oidc-client-secret", "", "client secret for OIDC authentication")
+	octantCmd.Flags().String("auth-oidc-redirect-url", "", "callback URL registered with the OIDC provider (default built from the request host)")
+	octantCmd.Flags().String("auth-oidc-username-claim", "email", "ID token claim used as the user name")
+	octantCmd.Flags().String("auth-oidc-groups-claim", "groups", "ID token claim listing the user's groups")
+	octantCmd.Flags().String("auth-session-secret", "", "secret for signing session cookies (default generated, so sessions end when Octant restarts)")
+	octantCmd.Flags().Duration("auth-session-ttl", auth.DefaultSessionTTL, "how long a login session lasts")
+
+	octantCmd.Flags().Bool("impersonate", false, "make cluster calls as the logged in user instead of the kube config user (requires htpasswd or oidc authentication)")
+	octantCmd.Flags().String("impersonate-user-prefix", "", "prefix added to impersonated user names")
+	octantCmd.Flags().String("impersonate-group-prefix", "", "prefix added to impersonated groups from the identity provider")
+	octantCmd.Flags().StringSlice("impersonate-groups", nil, "groups added to every impersonated user")
+
+	octantCmd.Flags().Bool("read-only", false, "prevent changes to the cluster: disables editing, deleting, terminals, and port forwards")
+
+	octantCmd.Flags().String("audit-log-file", "", "append a JSON line for every dispatched action to this file")
+	octantCmd.Flags().Int("audit-log-size", audit.DefaultCapacity, "number of audit entries shown in the dashboard")
+
+	octantCmd.Flags().Int("cache-max-informers", 0, "number of resources cached at once; the least recently viewed resource stops being cached when it is exceeded (0 for no limit)")
+	octantCmd.Flags().Duration("cache-idle-timeout", 10*time.Minute, "stop caching resources which haven't been viewed for this long (0 to keep caching them)")
+	octantCmd.Flags().Int("cache-max-annotation-size", 0, "annotation values larger than this many bytes are not cached (0 for no limit)")
+	octantCmd.Flags().Int("cache-list-page-threshold", 5000, "resources with more objects than this are listed a page at a time instead of being cached (0 to always cache them)")
+
+	octantCmd.Flags().String("tls-cert-file", "", "PEM encoded certificate file for serving the dashboard over HTTPS")
+	octantCmd.Flags().String("tls-private-key-file", "", "PEM encoded private key file for --tls-cert-file")
+	octantCmd.Flags().Bool("tls-self-signed", false, "serve the dashboard over HTTPS with a self-signed certificate stored in the config directory")
+
 	octantCmd.Flags().StringP("accepted-hosts", "", "", "accepted hosts list [DEV]")
 	octantCmd.Flags().Float32P("client-qps", "", 200, "maximum QPS for client [DEV]")
 	octantCmd.Flags().IntP("client-burst", "", 400, "maximum burst for client throttle [DEV]")
 	octantCmd.Flags().BoolP("disable-open-browser", "", false, "disable automatic launching of the browser [DEV]")
-	octantCmd.Flags().BoolP("enable-opencensus", "c", false, "enable open census [DEV]")
 	octantCmd.Flags().IntP("klog-verbosity", "", 0, "klog verbosity level [DEV]")
 	octantCmd.Flags().StringP("listener-addr", "", "", "listener address for the octant frontend [DEV]")
 	octantCmd.Flags().StringP("local-content", "", "", "local content path [DEV]")
@@ -180,6 +263,111 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 	return octantCmd
 }
 
+// newAuthenticator creates an authenticator from flags. It returns nil if
+// authentication is disabled.
+func newAuthenticator(ctx context.Context, listener net.Listener) (*auth.Authenticator, error) {
+	mode := auth.ResolveMode(auth.Mode(viper.GetString("auth-mode")), listener.Addr())
+
+	options := auth.Options{
+		Mode:         mode,
+		PathPrefix:   api.PathPrefix + "/auth",
+		Token:        viper.GetString("auth-token"),
+		HtpasswdFile: viper.GetString("auth-htpasswd"),
+		OIDC: auth.OIDCOptions{
+			Issuer:        viper.GetString("auth-oidc-issuer"),
+			ClientID:      viper.GetString("auth-oidc-client-id"),
+			ClientSecret:  viper.GetString("auth-oidc-client-secret"),
+			RedirectURL:   viper.GetString("auth-oidc-redirect-url"),
+			UsernameClaim: viper.GetString("auth-oidc-username-claim"),
+			GroupsClaim:   viper.GetString("auth-oidc-groups-claim"),
+		},
+		SessionSecret: viper.GetString("auth-session-secret"),
+		SessionTTL:    viper.GetDuration("auth-session-ttl"),
+	}
+
+	return auth.New(ctx, options)
+}
+
+// newImpersonation creates impersonation configuration from flags. It returns
+// nil if impersonation is disabled.
+func newImpersonation(authenticator *auth.Authenticator) (*api.Impersonation, error) {
+	if !viper.GetBool("impersonate") {
+		return nil, nil
+	}
+
+	if authenticator == nil || (authenticator.Mode() != auth.ModeHtpasswd && authenticator.Mode() != auth.ModeOIDC) {
+		return nil, fmt.Errorf("impersonation requires htpasswd or oidc authentication so users have names")
+	}
+
+	return &api.Impersonation{
+		UserPrefix:  viper.GetString("impersonate-user-prefix"),
+		GroupPrefix: viper.GetString("impersonate-group-prefix"),
+		Groups:      viper.GetStringSlice("impersonate-groups"),
+	}, nil
+}
+
+// newAuditLog creates the audit log from flags. Entries are only kept in memory
+// if no audit log file is set.
+func newAuditLog(logger plog.Logger) (*audit.Log, error) {
+	var sinks []audit.Sink
+	if name := viper.GetString("audit-log-file"); name != "" {
+		sink, err := audit.NewFileSink(name)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+
+	return audit.NewLog(logger, viper.GetInt("audit-log-size"), sinks...), nil
+}
+
+// newPreferences loads per context preferences from the configuration
+// directory. Preferences are only kept in memory if the file can't be read.
+func newPreferences(logger plog.Logger) preferences.Store {
+	var path string
+	if dir := configDir(); dir != "" {
+		path = filepath.Join(dir, "preferences.json")
+	}
+
+	store, err := preferences.NewFileStore(path)
+	if err != nil {
+		logger.WithErr(err).Warnf("preferences will not be saved")
+		store, _ = preferences.NewFileStore("")
+	}
+	return store
+}
+
+// newTLSConfig creates a TLS config from flags. It returns nil if TLS is disabled.
+func newTLSConfig(listener net.Listener) (*tls.Config, error) {
+	options := servertls.Options{
+		CertFile:   viper.GetString("tls-cert-file"),
+		KeyFile:    viper.GetString("tls-private-key-file"),
+		SelfSigned: viper.GetBool("tls-self-signed"),
+		Hosts:      servertls.Hosts(listener.Addr()),
+	}
+
+	if dir := configDir(); dir != "" {
+		options.Dir = filepath.Join(dir, "tls")
+	}
+
+	return servertls.Config(options)
+}
+
+// configDir returns Octant's configuration directory. It uses the same rules as
+// the plugin directory.
+func configDir() string {
+	home := plugin.DefaultConfig.Home()
+	if home == "" {
+		return ""
+	}
+
+	if runtime.GOOS == "windows" || viper.GetString("xdg-config-home") != "" {
+		return filepath.Join(home, "octant")
+	}
+
+	return filepath.Join(home, ".config", "octant")
+}
+
 func bindViper(cmd *cobra.Command) error {
 	replacer := strings.NewReplacer("-", "_")
 	viper.SetEnvKeyReplacer(replacer)
@@ -0,0 +1,195 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+	"github.com/vmware-tanzu/octant/pkg/plugin/install"
+)
+
+func newPluginCmd() *cobra.Command {
+	pluginCmd := &cobra.Command{
+		Use:   "plugin",
+		Short: "Manage plugins",
+		Long:  "Install, update, list, and remove plugins listed in a plugin index",
+	}
+
+	// Like the dashboard flags, these can be set with OCTANT_PLUGIN_INDEX and OCTANT_PLUGIN_PATH.
+	pluginCmd.PersistentFlags().String("plugin-index", "", "location of the plugin index (path, file URL, or HTTP URL)")
+	pluginCmd.PersistentFlags().String("plugin-path", "", "plugin path; plugins are installed into its first directory")
+
+	pluginCmd.AddCommand(
+		newPluginInstallCmd(),
+		newPluginListCmd(),
+		newPluginRemoveCmd(),
+		newPluginUpdateCmd(),
+	)
+
+	return pluginCmd
+}
+
+func newPluginInstallCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "install NAME...",
+		Short: "Install plugins from the plugin index",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			installer, index, err := pluginInstaller(cmd, true)
+			if err != nil {
+				return err
+			}
+
+			for _, name := range args {
+				receipt, err := installer.Install(context.Background(), index, name)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "Installed %s %s to %s\n", receipt.Name, receipt.Version, installer.Dir())
+			}
+
+			return nil
+		},
+	}
+}
+
+func newPluginListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List installed plugins",
+		Long:  "List installed plugins. If a plugin index is given, the version available in the index is shown.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			installer, index, err := pluginInstaller(cmd, false)
+			if err != nil {
+				return err
+			}
+
+			receipts, err := installer.Installed()
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 2, ' ', 0)
+			if index == nil {
+				fmt.Fprintln(w, "NAME\tVERSION\tFILE")
+				for _, receipt := range receipts {
+					fmt.Fprintf(w, "%s\t%s\t%s\n", receipt.Name, receipt.Version, receipt.File)
+				}
+				return w.Flush()
+			}
+
+			fmt.Fprintln(w, "NAME\tVERSION\tAVAILABLE\tFILE")
+			for _, receipt := range receipts {
+				available := "-"
+				if entry, ok := index.Find(receipt.Name); ok {
+					available = entry.Version
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", receipt.Name, receipt.Version, available, receipt.File)
+			}
+			return w.Flush()
+		},
+	}
+}
+
+func newPluginRemoveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove NAME...",
+		Short: "Remove installed plugins",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			installer, _, err := pluginInstaller(cmd, false)
+			if err != nil {
+				return err
+			}
+
+			for _, name := range args {
+				if err := installer.Remove(name); err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "Removed %s\n", name)
+			}
+
+			return nil
+		},
+	}
+}
+
+func newPluginUpdateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "update [NAME...]",
+		Short: "Update installed plugins to the versions in the plugin index",
+		Long:  "Update installed plugins to the versions in the plugin index. If no names are given, all installed plugins are updated.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			installer, index, err := pluginInstaller(cmd, true)
+			if err != nil {
+				return err
+			}
+
+			names := args
+			if len(names) == 0 {
+				receipts, err := installer.Installed()
+				if err != nil {
+					return err
+				}
+				for _, receipt := range receipts {
+					if _, ok := index.Find(receipt.Name); ok {
+						names = append(names, receipt.Name)
+					}
+				}
+			}
+
+			for _, name := range names {
+				receipt, updated, err := installer.Update(context.Background(), index, name)
+				if err != nil {
+					return err
+				}
+				if updated {
+					fmt.Fprintf(cmd.OutOrStdout(), "Updated %s to %s\n", receipt.Name, receipt.Version)
+				} else {
+					fmt.Fprintf(cmd.OutOrStdout(), "%s %s is up to date\n", receipt.Name, receipt.Version)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// pluginInstaller creates an installer for the default plugin configuration. The
+// plugin index is loaded if it was set.
+func pluginInstaller(cmd *cobra.Command, indexRequired bool) (*install.Installer, *install.Index, error) {
+	if err := bindViper(cmd); err != nil {
+		return nil, nil, errors.Wrap(err, "bind flags")
+	}
+
+	installer, err := install.NewInstaller(plugin.DefaultConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	location := viper.GetString("plugin-index")
+	if location == "" {
+		if indexRequired {
+			return nil, nil, errors.New("a plugin index is required; set it with --plugin-index or OCTANT_PLUGIN_INDEX")
+		}
+		return installer, nil, nil
+	}
+
+	index, err := install.LoadIndex(context.Background(), plugin.DefaultConfig.Fs(), location)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return installer, index, nil
+}
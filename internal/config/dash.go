@@ -20,6 +20,8 @@ import (
 	internalErr "github.com/vmware-tanzu/octant/internal/errors"
 	"github.com/vmware-tanzu/octant/internal/module"
 	"github.com/vmware-tanzu/octant/internal/portforward"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/internal/search"
 	"github.com/vmware-tanzu/octant/pkg/log"
 	"github.com/vmware-tanzu/octant/pkg/plugin"
 )
@@ -156,6 +158,8 @@ type Live struct {
 	logger               log.Logger
 	moduleManager        module.ManagerInterface
 	objectStore          store.Store
+	preferences          preferences.Store
+	searcher             search.Searcher
 	errorStore           internalErr.ErrorStore
 	pluginManager        plugin.ManagerInterface
 	portForwarder        portforward.PortForwarder
@@ -173,6 +177,8 @@ func NewLiveConfig(
 	logger log.Logger,
 	moduleManager module.ManagerInterface,
 	objectStore store.Store,
+	preferences preferences.Store,
+	searcher search.Searcher,
 	errorStore internalErr.ErrorStore,
 	pluginManager plugin.ManagerInterface,
 	portForwarder portforward.PortForwarder,
@@ -186,6 +192,8 @@ func NewLiveConfig(
 		logger:               logger,
 		moduleManager:        moduleManager,
 		objectStore:          objectStore,
+		preferences:          preferences,
+		searcher:             searcher,
 		errorStore:           errorStore,
 		pluginManager:        pluginManager,
 		portForwarder:        portForwarder,
@@ -217,6 +225,16 @@ func (l *Live) ObjectStore() store.Store {
 	return l.objectStore
 }
 
+// Preferences returns the per context preferences store.
+func (l *Live) Preferences() preferences.Store {
+	return l.preferences
+}
+
+// Search returns the searcher for cached objects.
+func (l *Live) Search() search.Searcher {
+	return l.searcher
+}
+
 // ErrorStore returns an error store.
 func (l *Live) ErrorStore() internalErr.ErrorStore {
 	return l.errorStore
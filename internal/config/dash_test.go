@@ -24,6 +24,8 @@ import (
 	"github.com/vmware-tanzu/octant/internal/module"
 	moduleFake "github.com/vmware-tanzu/octant/internal/module/fake"
 	portForwardFake "github.com/vmware-tanzu/octant/internal/portforward/fake"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/internal/search"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	pluginFake "github.com/vmware-tanzu/octant/pkg/plugin/fake"
 	objectStoreFake "github.com/vmware-tanzu/octant/pkg/store/fake"
@@ -91,6 +93,11 @@ func TestLiveConfig(t *testing.T) {
 	portForwarder := portForwardFake.NewMockPortForwarder(controller)
 	buildInfo := BuildInfo{}
 
+	preferenceStore, err := preferences.NewFileStore("")
+	require.NoError(t, err)
+
+	searchIndex := search.NewIndex()
+
 	restConfigOptions := cluster.RESTConfigOptions{}
 
 	config := NewLiveConfig(
@@ -99,6 +106,8 @@ func TestLiveConfig(t *testing.T) {
 		logger,
 		moduleManager,
 		objectStore,
+		preferenceStore,
+		searchIndex,
 		errorStore,
 		pluginManager,
 		portForwarder,
@@ -112,6 +121,8 @@ func TestLiveConfig(t *testing.T) {
 	assert.Equal(t, crdWatcher, config.CRDWatcher())
 	assert.Equal(t, logger, config.Logger())
 	assert.Equal(t, objectStore, config.ObjectStore())
+	assert.Equal(t, preferenceStore, config.Preferences())
+	assert.Equal(t, searchIndex, config.Search())
 	assert.Equal(t, pluginManager, config.PluginManager())
 	assert.Equal(t, portForwarder, config.PortForwarder())
 
@@ -147,6 +158,8 @@ func TestLiveConfig_UseContext_WithContextChosenByUISetToTrue(t *testing.T) {
 		logger,
 		moduleManager,
 		objectStore,
+		nil,
+		nil,
 		errorStore,
 		pluginManager,
 		portForwarder,
@@ -204,6 +217,8 @@ func TestLiveConfig_UseContext_WithContextChosenByUISetToFalse(t *testing.T) {
 		logger,
 		moduleManager,
 		objectStore,
+		nil,
+		nil,
 		errorStore,
 		pluginManager,
 		portForwarder,
@@ -16,6 +16,8 @@ import (
 	kubeconfig "github.com/vmware-tanzu/octant/internal/kubeconfig"
 	module "github.com/vmware-tanzu/octant/internal/module"
 	portforward "github.com/vmware-tanzu/octant/internal/portforward"
+	preferences "github.com/vmware-tanzu/octant/internal/preferences"
+	search "github.com/vmware-tanzu/octant/internal/search"
 	log "github.com/vmware-tanzu/octant/pkg/log"
 	plugin "github.com/vmware-tanzu/octant/pkg/plugin"
 	store "github.com/vmware-tanzu/octant/pkg/store"
@@ -201,6 +203,20 @@ func (mr *MockDashMockRecorder) ObjectStore() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectStore", reflect.TypeOf((*MockDash)(nil).ObjectStore))
 }
 
+// Preferences mocks base method
+func (m *MockDash) Preferences() preferences.Store {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preferences")
+	ret0, _ := ret[0].(preferences.Store)
+	return ret0
+}
+
+// Preferences indicates an expected call of Preferences
+func (mr *MockDashMockRecorder) Preferences() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preferences", reflect.TypeOf((*MockDash)(nil).Preferences))
+}
+
 // PluginManager mocks base method
 func (m *MockDash) PluginManager() plugin.ManagerInterface {
 	m.ctrl.T.Helper()
@@ -229,6 +245,20 @@ func (mr *MockDashMockRecorder) PortForwarder() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortForwarder", reflect.TypeOf((*MockDash)(nil).PortForwarder))
 }
 
+// Search mocks base method
+func (m *MockDash) Search() search.Searcher {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search")
+	ret0, _ := ret[0].(search.Searcher)
+	return ret0
+}
+
+// Search indicates an expected call of Search
+func (mr *MockDashMockRecorder) Search() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockDash)(nil).Search))
+}
+
 // SetContextChosenInUI mocks base method
 func (m *MockDash) SetContextChosenInUI(arg0 bool) {
 	m.ctrl.T.Helper()
@@ -8,6 +8,7 @@ package context
 import (
 	"context"
 
+	"github.com/vmware-tanzu/octant/internal/cluster"
 	"github.com/vmware-tanzu/octant/internal/octant"
 )
 
@@ -32,6 +33,10 @@ type ClientState struct {
 	Filters     []octant.Filter `json:"filters"`
 	Namespace   string          `json:"namespace"`
 	ContextName string          `json:"contextName"`
+	// Identity is the identity cluster calls are made as. It is sent to
+	// plugins so their calls to the dashboard API are made as the same
+	// identity.
+	Identity *cluster.Identity `json:"identity,omitempty"`
 }
 
 func WithClientState(ctx context.Context, state ClientState) context.Context {
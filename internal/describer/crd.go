@@ -19,6 +19,7 @@ func defaultCustomResourceTabs(crdName string) []Tab {
 		{Name: "Metadata", Factory: MetadataTab},
 		{Name: "Resource Viewer", Factory: ResourceViewerTab},
 		{Name: "YAML", Factory: YAMLViewerTab},
+		{Name: "Compare", Factory: CompareTab},
 	}
 }
 
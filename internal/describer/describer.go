@@ -42,6 +42,10 @@ func (f *ObjectLoaderFactory) LoadObjects(ctx context.Context, namespace string,
 	return LoadObjects(ctx, f.dashConfig.ObjectStore(), f.dashConfig.ErrorStore(), namespace, fields, objectStoreKeys)
 }
 
+func (f *ObjectLoaderFactory) LoadHealth(ctx context.Context, objectStoreKey store.Key) (store.Health, error) {
+	return f.dashConfig.ObjectStore().Health(ctx, objectStoreKey)
+}
+
 // loadObject loads a single object from the object store.
 func LoadObject(ctx context.Context, objectStore store.Store, errorStore oerrors.ErrorStore, namespace string, fields map[string]string, objectStoreKey store.Key) (*unstructured.Unstructured, error) {
 	objectStoreKey.Namespace = namespace
@@ -100,6 +104,13 @@ func LoadObjects(ctx context.Context, objectStore store.Store, errorStore oerror
 		}
 
 		list.Items = append(list.Items, storedObjects.Items...)
+		// Paged lists keep their position so the next page can be requested.
+		if token := storedObjects.GetContinue(); token != "" {
+			list.SetContinue(token)
+		}
+		if remaining := storedObjects.GetRemainingItemCount(); remaining != nil {
+			list.SetRemainingItemCount(remaining)
+		}
 	}
 
 	sort.SliceStable(list.Items, func(i, j int) bool {
@@ -122,9 +133,17 @@ type Options struct {
 	Printer  printer.Printer
 	LabelSet *kLabels.Set
 	Link     link.Interface
+	// ListContinue is the continue token of the page of a paged list being
+	// viewed. It is empty for the first page.
+	ListContinue string
+	// Namespaces are the namespaces of the selected view. Lists in one of
+	// them show objects from all of them.
+	Namespaces []string
 
 	LoadObjects func(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error)
 	LoadObject  func(ctx context.Context, namespace string, fields map[string]string, objectStoreKey store.Key) (*unstructured.Unstructured, error)
+	// LoadHealth loads the health of the cache of a resource. It is optional.
+	LoadHealth func(ctx context.Context, objectStoreKey store.Key) (store.Health, error)
 }
 
 // Describer creates content.
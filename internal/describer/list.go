@@ -7,16 +7,27 @@ package describer
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/printer"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
+// ListPageSize is the number of objects in a page of a list. Lists are only
+// paged when the object store decides their collection is too large to cache,
+// in which case every page has a continue token or a remaining item count.
+const ListPageSize = 100
+
 type ListConfig struct {
 	Path          string
 	Title         string
@@ -24,6 +35,9 @@ type ListConfig struct {
 	ListType      func() interface{}
 	ObjectType    func() interface{}
 	IsClusterWide bool
+	// MetadataOnly is true if the list's printer only reads object metadata.
+	// Objects are then listed without their spec or status.
+	MetadataOnly bool
 }
 
 // List describes a list of objects.
@@ -36,6 +50,7 @@ type List struct {
 	objectType     func() interface{}
 	objectStoreKey store.Key
 	isClusterWide  bool
+	metadataOnly   bool
 }
 
 // NewList creates an instance of List.
@@ -48,6 +63,7 @@ func NewList(c ListConfig) *List {
 		listType:       c.ListType,
 		objectType:     c.ObjectType,
 		isClusterWide:  c.IsClusterWide,
+		metadataOnly:   c.MetadataOnly,
 	}
 }
 
@@ -65,11 +81,40 @@ func (d *List) Describe(ctx context.Context, namespace string, options Options)
 		namespace = ""
 	}
 
-	objectList, err := options.LoadObjects(ctx, namespace, options.Fields, []store.Key{key})
-	if err != nil {
-		return component.EmptyContentResponse, err
+	var health store.Health
+	if options.LoadHealth != nil {
+		var err error
+		health, err = options.LoadHealth(ctx, key)
+		if err != nil {
+			return component.EmptyContentResponse, err
+		}
 	}
 
+	// Lists in a namespace of the selected view show objects from all of the
+	// view's namespaces.
+	viewNamespaces := !d.isClusterWide && len(options.Namespaces) > 1 && containsString(options.Namespaces, namespace)
+
+	loadCtx := ctx
+	if d.metadataOnly {
+		loadCtx = store.WithMetadataOnly(ctx)
+	}
+
+	// Forbidden resources aren't listed until the credentials change.
+	objectList := &unstructured.UnstructuredList{}
+	var failedNamespaces []string
+	if !health.Forbidden && viewNamespaces {
+		objectList, failedNamespaces = loadViewObjects(loadCtx, options, key)
+		ctx = printer.WithNamespaceColumn(ctx)
+	} else if !health.Forbidden {
+		var err error
+		pageCtx := store.WithListPage(loadCtx, store.ListPage{Limit: ListPageSize, Continue: options.ListContinue})
+		objectList, err = options.LoadObjects(pageCtx, namespace, options.Fields, []store.Key{key})
+		if err != nil {
+			return component.EmptyContentResponse, err
+		}
+	}
+	paged := objectList.GetContinue() != "" || objectList.GetRemainingItemCount() != nil
+
 	title := component.Title(component.NewText(d.title))
 	list := component.NewList(title, nil)
 
@@ -102,6 +147,21 @@ func (d *List) Describe(ctx context.Context, namespace string, options Options)
 
 	if viewComponent != nil {
 		if table, ok := viewComponent.(*component.Table); ok {
+			if paged {
+				table.SetPagination(&component.TablePagination{
+					Continue:  options.ListContinue,
+					Next:      objectList.GetContinue(),
+					Remaining: objectList.GetRemainingItemCount(),
+					PageSize:  ListPageSize,
+				})
+			}
+			if alert, ok := healthAlert(d.title, health); ok {
+				table.SetAlert(alert)
+			} else if len(failedNamespaces) > 0 {
+				table.SetAlert(component.NewAlert(component.AlertTypeWarning,
+					fmt.Sprintf("%s in these namespaces couldn't be loaded: %s",
+						d.title, strings.Join(failedNamespaces, ", "))))
+			}
 			list.Add(table)
 		} else {
 			list.Add(viewComponent)
@@ -119,3 +179,56 @@ func (d *List) PathFilters() []PathFilter {
 		*NewPathFilter(d.path, d),
 	}
 }
+
+// loadViewObjects loads objects from every namespace of the selected view.
+// These lists aren't paged because a continue token only applies to a single
+// namespace. Namespaces whose objects can't be loaded are skipped and
+// returned.
+func loadViewObjects(ctx context.Context, options Options, key store.Key) (*unstructured.UnstructuredList, []string) {
+	list := &unstructured.UnstructuredList{}
+	var failed []string
+	for _, namespace := range options.Namespaces {
+		objects, err := options.LoadObjects(ctx, namespace, options.Fields, []store.Key{key})
+		if err != nil {
+			log.From(ctx).WithErr(err).With("namespace", namespace).Errorf("load %s for view", key.Kind)
+			failed = append(failed, namespace)
+			continue
+		}
+		list.Items = append(list.Items, objects.Items...)
+	}
+
+	sort.SliceStable(list.Items, func(i, j int) bool {
+		a, b := list.Items[i], list.Items[j]
+		if a.GetName() != b.GetName() {
+			return a.GetName() < b.GetName()
+		}
+		return a.GetNamespace() < b.GetNamespace()
+	})
+
+	return list, failed
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// healthAlert returns an alert describing an unhealthy resource cache.
+func healthAlert(title string, health store.Health) (component.Alert, bool) {
+	switch {
+	case health.Forbidden:
+		return component.NewAlert(component.AlertTypeError,
+			fmt.Sprintf("%s can't be watched with the current credentials: %s. They will be listed again when the credentials change.",
+				title, health.LastError)), true
+	case health.Stale:
+		return component.NewAlert(component.AlertTypeWarning,
+			fmt.Sprintf("%s may be out of date: %s. Retrying in %s.",
+				title, health.LastError, health.Backoff)), true
+	default:
+		return component.Alert{}, false
+	}
+}
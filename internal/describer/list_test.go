@@ -7,13 +7,16 @@ package describer
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
 	moduleFake "github.com/vmware-tanzu/octant/internal/module/fake"
@@ -78,3 +81,247 @@ func TestListDescriber(t *testing.T) {
 
 	assert.Equal(t, expected.Title, cResponse.Title)
 }
+
+func TestListDescriber_health(t *testing.T) {
+	pod := testutil.CreatePod("pod")
+	pod.CreationTimestamp = *testutil.CreateTimestamp()
+
+	key, err := store.KeyFromObject(pod)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		health        store.Health
+		expectedItems []corev1.Pod
+		expectedAlert *component.Alert
+	}{
+		{
+			name:          "healthy",
+			health:        store.Health{Synced: true},
+			expectedItems: []corev1.Pod{*pod},
+		},
+		{
+			name: "stale",
+			health: store.Health{
+				Synced:    true,
+				Stale:     true,
+				LastError: "connection refused",
+				Failures:  1,
+				Backoff:   time.Second,
+			},
+			expectedItems: []corev1.Pod{*pod},
+			expectedAlert: &component.Alert{
+				Type:    component.AlertTypeWarning,
+				Message: "Pods may be out of date: connection refused. Retrying in 1s.",
+			},
+		},
+		{
+			name: "forbidden",
+			health: store.Health{
+				Forbidden: true,
+				Stale:     true,
+				LastError: "pods is forbidden",
+				Failures:  1,
+			},
+			expectedAlert: &component.Alert{
+				Type:    component.AlertTypeError,
+				Message: "Pods can't be watched with the current credentials: pods is forbidden. They will be listed again when the credentials change.",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			objectPrinter := printerFake.NewMockPrinter(controller)
+			objectPrinter.EXPECT().
+				Print(gomock.Any(), &corev1.PodList{Items: test.expectedItems}).
+				Return(createPodTable(test.expectedItems...), nil)
+
+			options := Options{
+				Printer: objectPrinter,
+				LoadObjects: func(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error) {
+					require.False(t, test.health.Forbidden, "forbidden resources aren't listed")
+					return testutil.ToUnstructuredList(t, pod), nil
+				},
+				LoadHealth: func(ctx context.Context, objectStoreKey store.Key) (store.Health, error) {
+					return test.health, nil
+				},
+			}
+
+			d := NewList(ListConfig{
+				Path:       "/",
+				Title:      "Pods",
+				StoreKey:   key,
+				ListType:   PodListType,
+				ObjectType: PodObjectType,
+			})
+			cResponse, err := d.Describe(context.Background(), "default", options)
+			require.NoError(t, err)
+
+			require.Len(t, cResponse.Components, 1)
+			list, ok := cResponse.Components[0].(*component.List)
+			require.True(t, ok)
+			require.Len(t, list.Config.Items, 1)
+			table, ok := list.Config.Items[0].(*component.Table)
+			require.True(t, ok)
+			assert.Equal(t, test.expectedAlert, table.Config.Alert)
+		})
+	}
+}
+
+func TestListDescriber_viewNamespaces(t *testing.T) {
+	newPod := func(namespace, name string) *corev1.Pod {
+		pod := testutil.CreatePod(name)
+		pod.Namespace = namespace
+		return pod
+	}
+	podsByNamespace := map[string][]*corev1.Pod{
+		"payments-a": {newPod("payments-a", "web"), newPod("payments-a", "api")},
+		"payments-b": {newPod("payments-b", "web")},
+		"other":      {newPod("other", "web")},
+	}
+
+	key, err := store.KeyFromObject(podsByNamespace["other"][0])
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		namespace     string
+		expectedItems []corev1.Pod
+	}{
+		{
+			name:      "namespace in the view",
+			namespace: "payments-b",
+			expectedItems: []corev1.Pod{
+				*podsByNamespace["payments-a"][1],
+				*podsByNamespace["payments-a"][0],
+				*podsByNamespace["payments-b"][0],
+			},
+		},
+		{
+			name:          "namespace outside the view",
+			namespace:     "other",
+			expectedItems: []corev1.Pod{*podsByNamespace["other"][0]},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			objectPrinter := printerFake.NewMockPrinter(controller)
+			objectPrinter.EXPECT().
+				Print(gomock.Any(), &corev1.PodList{Items: test.expectedItems}).
+				Return(createPodTable(test.expectedItems...), nil)
+
+			options := Options{
+				Printer:    objectPrinter,
+				Namespaces: []string{"payments-a", "payments-b"},
+				LoadObjects: func(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error) {
+					var objects []runtime.Object
+					for _, pod := range podsByNamespace[namespace] {
+						objects = append(objects, pod)
+					}
+					return testutil.ToUnstructuredList(t, objects...), nil
+				},
+			}
+
+			d := NewList(ListConfig{
+				Path:       "/",
+				Title:      "Pods",
+				StoreKey:   key,
+				ListType:   PodListType,
+				ObjectType: PodObjectType,
+			})
+			_, err := d.Describe(context.Background(), test.namespace, options)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestListDescriber_viewNamespaces_failed(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := testutil.CreatePod("web")
+	pod.Namespace = "payments-a"
+
+	key, err := store.KeyFromObject(pod)
+	require.NoError(t, err)
+
+	objectPrinter := printerFake.NewMockPrinter(controller)
+	objectPrinter.EXPECT().
+		Print(gomock.Any(), &corev1.PodList{Items: []corev1.Pod{*pod}}).
+		Return(createPodTable(*pod), nil)
+
+	options := Options{
+		Printer:    objectPrinter,
+		Namespaces: []string{"payments-a", "payments-b"},
+		LoadObjects: func(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error) {
+			if namespace == "payments-b" {
+				return nil, fmt.Errorf("forbidden")
+			}
+			return testutil.ToUnstructuredList(t, pod), nil
+		},
+	}
+
+	d := NewList(ListConfig{
+		Path:       "/",
+		Title:      "Pods",
+		StoreKey:   key,
+		ListType:   PodListType,
+		ObjectType: PodObjectType,
+	})
+	got, err := d.Describe(context.Background(), "payments-a", options)
+	require.NoError(t, err, "namespaces which can't be loaded are skipped")
+
+	list := got.Components[0].(*component.List)
+	table := list.Config.Items[0].(*component.Table)
+	require.NotNil(t, table.Config.Alert)
+	assert.Equal(t, "Pods in these namespaces couldn't be loaded: payments-b", table.Config.Alert.Message)
+}
+
+func TestListDescriber_metadataOnly(t *testing.T) {
+	pod := testutil.CreatePod("pod")
+
+	key, err := store.KeyFromObject(pod)
+	require.NoError(t, err)
+
+	for _, metadataOnly := range []bool{false, true} {
+		t.Run(fmt.Sprintf("metadata only %t", metadataOnly), func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			objectPrinter := printerFake.NewMockPrinter(controller)
+			objectPrinter.EXPECT().
+				Print(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, _ runtime.Object) (component.Component, error) {
+					assert.False(t, store.MetadataOnly(ctx), "printers can read full objects")
+					return createPodTable(*pod), nil
+				})
+
+			options := Options{
+				Printer: objectPrinter,
+				LoadObjects: func(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error) {
+					assert.Equal(t, metadataOnly, store.MetadataOnly(ctx))
+					return testutil.ToUnstructuredList(t, pod), nil
+				},
+			}
+
+			d := NewList(ListConfig{
+				Path:         "/",
+				Title:        "Pods",
+				StoreKey:     key,
+				ListType:     PodListType,
+				ObjectType:   PodObjectType,
+				MetadataOnly: metadataOnly,
+			})
+			_, err := d.Describe(context.Background(), "default", options)
+			require.NoError(t, err)
+		})
+	}
+}
@@ -14,6 +14,8 @@ import (
 
 	"github.com/vmware-tanzu/octant/internal/api"
 	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/internal/readonly"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/store"
@@ -29,6 +31,7 @@ func defaultObjectTabs() []Tab {
 		{Name: "YAML", Factory: YAMLViewerTab},
 		{Name: "Logs", Factory: LogsTab},
 		{Name: "Terminal", Factory: TerminalTab},
+		{Name: "Compare", Factory: CompareTab},
 	}
 }
 
@@ -124,19 +127,30 @@ func (d *Object) Describe(ctx context.Context, namespace string, options Options
 		return component.EmptyContentResponse, err
 	}
 
-	if objAccessor.GetDeletionTimestamp() == nil {
+	if objAccessor.GetDeletionTimestamp() == nil && !readonly.Enabled(ctx) {
 		key, err := store.KeyFromObject(currentObject)
 		if err != nil {
 			return component.EmptyContentResponse, err
 		}
 
-		confirmation, err := octant.DeleteObjectConfirmationButton(currentObject)
+		// The preview is shown for confirmation before the object is deleted.
+		cr.AddButton("Delete", action.CreatePayload(octant.ActionPreviewDeleteObject,
+			key.ToActionPayload()), component.WithButtonStatus(component.ButtonStatusDanger))
+	}
+
+	// Pinning only changes preferences, so it is allowed in read-only mode.
+	if options.Dash != nil && options.Preferences() != nil {
+		key, err := store.KeyFromObject(currentObject)
 		if err != nil {
 			return component.EmptyContentResponse, err
 		}
 
-		cr.AddButton("Delete", action.CreatePayload(octant.ActionDeleteObject,
-			key.ToActionPayload()), confirmation)
+		prefs := options.Preferences().Get(options.CurrentContext())
+		if prefs.IsPinned(preferences.ObjectReferenceFromKey(key)) {
+			cr.AddButton("Unpin", action.CreatePayload(octant.ActionUnpinObject, key.ToActionPayload()))
+		} else {
+			cr.AddButton("Pin", action.CreatePayload(octant.ActionPinObject, key.ToActionPayload()))
+		}
 	}
 
 	config := TabsGeneratorConfig{
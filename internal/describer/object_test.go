@@ -23,6 +23,7 @@ import (
 
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
 	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/preferences"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/plugin"
@@ -52,6 +53,14 @@ func TestObjectDescriber(t *testing.T) {
 	pluginManager := plugin.NewManager(nil, moduleRegistrar, actionRegistrar, wsClient)
 	dashConfig.EXPECT().PluginManager().Return(pluginManager).AnyTimes()
 
+	prefs, err := preferences.NewFileStore("")
+	require.NoError(t, err)
+	require.NoError(t, prefs.Update("dev", func(c *preferences.Context) {
+		c.Pin(preferences.ObjectReferenceFromKey(key))
+	}))
+	dashConfig.EXPECT().Preferences().Return(prefs).AnyTimes()
+	dashConfig.EXPECT().CurrentContext().Return("dev").AnyTimes()
+
 	podSummary := component.NewText("summary")
 
 	tg := describerFake.NewMockTabsGenerator(controller)
@@ -103,11 +112,11 @@ func TestObjectDescriber(t *testing.T) {
 
 	buttonGroup.AddButton(
 		component.NewButton("Delete",
-			action.CreatePayload(octant.ActionDeleteObject, key.ToActionPayload()),
-			component.WithButtonConfirmation(
-				"Delete Pod",
-				"Are you sure you want to delete *Pod* **pod**? This action is permanent and cannot be recovered.",
-			)))
+			action.CreatePayload(octant.ActionPreviewDeleteObject, key.ToActionPayload()),
+			component.WithButtonStatus(component.ButtonStatusDanger)))
+	buttonGroup.AddButton(
+		component.NewButton("Unpin",
+			action.CreatePayload(octant.ActionUnpinObject, key.ToActionPayload())))
 
 	expected := component.ContentResponse{
 		Title: component.Title(component.NewText("pod")),
@@ -216,6 +216,7 @@ func initNamespacedOverview() *Section {
 		ListType:       &rbacv1.RoleList{},
 		ObjectType:     &rbacv1.Role{},
 		Titles:         ResourceTitle{List: "Roles", Object: "Roles"},
+		MetadataOnly:   true,
 	})
 
 	rbacRoleBindings := NewResource(ResourceOptions{
@@ -34,6 +34,8 @@ type ResourceOptions struct {
 	DisableResourceViewer bool
 	ClusterWide           bool
 	IconName              string
+	// MetadataOnly is true if the list printer only reads object metadata.
+	MetadataOnly bool
 }
 
 type Resource struct {
@@ -66,6 +68,7 @@ func (r *Resource) List() *List {
 				return reflect.New(reflect.ValueOf(r.ObjectType).Elem().Type()).Interface()
 			},
 			IsClusterWide: r.ClusterWide,
+			MetadataOnly:  r.MetadataOnly,
 		},
 	)
 }
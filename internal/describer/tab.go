@@ -15,11 +15,14 @@ import (
 
 	"github.com/vmware-tanzu/octant/internal/link"
 	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/modules/compare"
 	"github.com/vmware-tanzu/octant/internal/modules/overview/logviewer"
 	"github.com/vmware-tanzu/octant/internal/modules/overview/terminalviewer"
 	"github.com/vmware-tanzu/octant/internal/modules/overview/yamlviewer"
 	"github.com/vmware-tanzu/octant/internal/printer"
+	"github.com/vmware-tanzu/octant/internal/readonly"
 	"github.com/vmware-tanzu/octant/internal/resourceviewer"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -98,12 +101,13 @@ func ResourceViewerTab(ctx context.Context, object runtime.Object, options Optio
 }
 
 // YAMLViewerTab generates a yaml viewer for an object.
-func YAMLViewerTab(_ context.Context, object runtime.Object, _ Options) (component.Component, error) {
+func YAMLViewerTab(ctx context.Context, object runtime.Object, _ Options) (component.Component, error) {
 	yvComponent, err := yamlviewer.ToComponent(object)
 	if err != nil {
 		return nil, fmt.Errorf("create yaml viewer: %w", err)
 	}
 
+	yvComponent.Config.ReadOnly = readonly.Enabled(ctx)
 	yvComponent.SetAccessor("yaml")
 	return yvComponent, nil
 }
@@ -124,10 +128,10 @@ func LogsTab(_ context.Context, object runtime.Object, _ Options) (component.Com
 	return nil, nil
 }
 
-// TerminalTab generates a terminal tab for a pod. If the object is not a pod,
-// the returned component will be nil with a nil error.
+// TerminalTab generates a terminal tab for a pod. If the object is not a pod or
+// Octant is read-only, the returned component will be nil with a nil error.
 func TerminalTab(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
-	if isPod(object) {
+	if isPod(object) && !readonly.Enabled(ctx) {
 		logger := log.From(ctx)
 
 		terminalComponent, err := terminalviewer.ToComponent(ctx, object, logger, options.Dash)
@@ -141,3 +145,27 @@ func TerminalTab(ctx context.Context, object runtime.Object, options Options) (c
 
 	return nil, nil
 }
+
+// CompareTab generates a tab linking to a list of the objects with the same name
+// in other namespaces and contexts. The objects are only searched for when the
+// list is opened. If objects can't be compared, the returned component will be
+// nil with a nil error.
+func CompareTab(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
+	if compare.SourcesFrom(ctx) == nil {
+		return nil, nil
+	}
+
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return nil, err
+	}
+
+	r := compare.TargetsRequest{
+		GroupVersionKind: key.GroupVersionKind(),
+		Name:             key.Name,
+		Current:          compare.Target{Context: options.Dash.CurrentContext(), Namespace: key.Namespace},
+	}
+	link := component.NewLink("", "Compare with…", compare.TargetsPath(r))
+	link.SetAccessor("compare")
+	return link, nil
+}
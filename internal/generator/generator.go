@@ -37,7 +37,9 @@ var _ Interface = (*Generator)(nil)
 
 // Options are additional options to pass a Generator
 type Options struct {
-	LabelSet *kLabels.Set
+	LabelSet     *kLabels.Set
+	ListContinue string
+	Namespaces   []string
 }
 
 // NewGenerator creates a Generator.
@@ -110,8 +112,12 @@ func (g *Generator) Generate(ctx context.Context, contentPath string, opts Optio
 		Dash:     g.dashConfig,
 		Link:     linkGenerator,
 
+		ListContinue: opts.ListContinue,
+		Namespaces:   opts.Namespaces,
+
 		LoadObjects: loaderFactory.LoadObjects,
 		LoadObject:  loaderFactory.LoadObject,
+		LoadHealth:  loaderFactory.LoadHealth,
 	}
 
 	span.AddAttributes(
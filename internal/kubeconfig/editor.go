@@ -0,0 +1,420 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kubeconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	internalStrings "github.com/vmware-tanzu/octant/internal/util/strings"
+)
+
+// ErrInvalidKubeConfig is returned when an imported kubeconfig can't be parsed.
+// The parser's error isn't returned since it may quote the kubeconfig.
+var ErrInvalidKubeConfig = errors.New("kubeconfig can't be parsed")
+
+// ContextEntry is a context and the file it is read from.
+type ContextEntry struct {
+	Name      string
+	File      string
+	Cluster   string
+	AuthInfo  string
+	Namespace string
+	Current   bool
+}
+
+// ClusterEntry is a cluster and the file it is read from.
+type ClusterEntry struct {
+	Name   string
+	File   string
+	Server string
+}
+
+// UserEntry is a user and the file it is read from.
+type UserEntry struct {
+	Name string
+	File string
+	// Auth describes how the user authenticates.
+	Auth string
+}
+
+// Chain is the merged contents of a kubeconfig chain. Like kubectl, an
+// entry is read from the first file which defines its name.
+type Chain struct {
+	Files          []string
+	CurrentContext string
+	Contexts       []ContextEntry
+	Clusters       []ClusterEntry
+	Users          []UserEntry
+}
+
+// Editor edits the files in a kubeconfig chain. Files are written
+// atomically so the config watcher never reads a partial file.
+type Editor struct {
+	files []string
+
+	mu sync.Mutex
+}
+
+// NewEditor creates an instance of Editor for a kubeconfig list, such as the
+// value of --kubeconfig.
+func NewEditor(kubeConfigList string) *Editor {
+	return &Editor{
+		files: internalStrings.Deduplicate(filepath.SplitList(kubeConfigList)),
+	}
+}
+
+// Files returns the files in the chain.
+func (e *Editor) Files() []string {
+	return e.files
+}
+
+// Load loads the chain.
+func (e *Editor) Load() (*Chain, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	configs, err := e.loadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	chain := &Chain{Files: e.files}
+	seenContexts := map[string]bool{}
+	seenClusters := map[string]bool{}
+	seenUsers := map[string]bool{}
+
+	for i, config := range configs {
+		file := e.files[i]
+		if chain.CurrentContext == "" {
+			chain.CurrentContext = config.CurrentContext
+		}
+
+		for name, context := range config.Contexts {
+			if seenContexts[name] {
+				continue
+			}
+			seenContexts[name] = true
+			chain.Contexts = append(chain.Contexts, ContextEntry{
+				Name:      name,
+				File:      file,
+				Cluster:   context.Cluster,
+				AuthInfo:  context.AuthInfo,
+				Namespace: context.Namespace,
+			})
+		}
+
+		for name, cluster := range config.Clusters {
+			if seenClusters[name] {
+				continue
+			}
+			seenClusters[name] = true
+			chain.Clusters = append(chain.Clusters, ClusterEntry{Name: name, File: file, Server: cluster.Server})
+		}
+
+		for name, authInfo := range config.AuthInfos {
+			if seenUsers[name] {
+				continue
+			}
+			seenUsers[name] = true
+			chain.Users = append(chain.Users, UserEntry{Name: name, File: file, Auth: describeAuth(authInfo)})
+		}
+	}
+
+	for i := range chain.Contexts {
+		chain.Contexts[i].Current = chain.Contexts[i].Name == chain.CurrentContext
+	}
+
+	sort.Slice(chain.Contexts, func(i, j int) bool { return chain.Contexts[i].Name < chain.Contexts[j].Name })
+	sort.Slice(chain.Clusters, func(i, j int) bool { return chain.Clusters[i].Name < chain.Clusters[j].Name })
+	sort.Slice(chain.Users, func(i, j int) bool { return chain.Users[i].Name < chain.Users[j].Name })
+
+	return chain, nil
+}
+
+// Import merges the clusters, users and contexts in data into the chain. New
+// entries are written to the first existing file in the chain. Entries whose
+// names are already in the chain are an error unless overwrite is true, in
+// which case they replace the entry in the file which defines it.
+func (e *Editor) Import(data []byte, overwrite bool) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	imported, err := clientcmd.Load(data)
+	if err != nil {
+		return ErrInvalidKubeConfig
+	}
+	if len(imported.Contexts) == 0 && len(imported.Clusters) == 0 && len(imported.AuthInfos) == 0 {
+		return errors.New("kubeconfig has no clusters, users or contexts")
+	}
+
+	configs, err := e.loadAll()
+	if err != nil {
+		return err
+	}
+
+	destination := e.defaultFile()
+	changed := map[int]bool{}
+
+	var conflicts []string
+	merge := func(kind, name string, has func(*clientcmdapi.Config) bool, set func(*clientcmdapi.Config)) {
+		for i, config := range configs {
+			if has(config) {
+				if !overwrite {
+					conflicts = append(conflicts, kind+" "+name)
+					return
+				}
+				set(config)
+				changed[i] = true
+				return
+			}
+		}
+		set(configs[destination])
+		changed[destination] = true
+	}
+
+	for name, context := range imported.Contexts {
+		name, context := name, context
+		merge("context", name,
+			func(c *clientcmdapi.Config) bool { _, ok := c.Contexts[name]; return ok },
+			func(c *clientcmdapi.Config) { c.Contexts[name] = context })
+	}
+	for name, cluster := range imported.Clusters {
+		name, cluster := name, cluster
+		merge("cluster", name,
+			func(c *clientcmdapi.Config) bool { _, ok := c.Clusters[name]; return ok },
+			func(c *clientcmdapi.Config) { c.Clusters[name] = cluster })
+	}
+	for name, authInfo := range imported.AuthInfos {
+		name, authInfo := name, authInfo
+		merge("user", name,
+			func(c *clientcmdapi.Config) bool { _, ok := c.AuthInfos[name]; return ok },
+			func(c *clientcmdapi.Config) { c.AuthInfos[name] = authInfo })
+	}
+
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return errors.Errorf("kubeconfig already has %s", strings.Join(conflicts, ", "))
+	}
+
+	return e.writeChanged(configs, changed)
+}
+
+// SetNamespace sets the default namespace of a context.
+func (e *Editor) SetNamespace(contextName, namespace string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	configs, err := e.loadAll()
+	if err != nil {
+		return err
+	}
+
+	i, context := findContext(configs, contextName)
+	if context == nil {
+		return errors.Errorf("context %s does not exist", contextName)
+	}
+	context.Namespace = namespace
+
+	return e.writeChanged(configs, map[int]bool{i: true})
+}
+
+// RenameContext renames a context. The current context is updated in every
+// file where it is the renamed context.
+func (e *Editor) RenameContext(contextName, newName string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if newName == "" {
+		return errors.New("context name is blank")
+	}
+
+	configs, err := e.loadAll()
+	if err != nil {
+		return err
+	}
+
+	if _, existing := findContext(configs, newName); existing != nil {
+		return errors.Errorf("context %s already exists", newName)
+	}
+
+	i, context := findContext(configs, contextName)
+	if context == nil {
+		return errors.Errorf("context %s does not exist", contextName)
+	}
+
+	changed := map[int]bool{i: true}
+	delete(configs[i].Contexts, contextName)
+	configs[i].Contexts[newName] = context
+
+	for j, config := range configs {
+		if config.CurrentContext == contextName {
+			config.CurrentContext = newName
+			changed[j] = true
+		}
+	}
+
+	return e.writeChanged(configs, changed)
+}
+
+// DeleteContext deletes a context from every file in the chain which defines
+// it, so a context of the same name in a later file isn't revealed. Its
+// cluster and user are kept since other contexts may use them.
+func (e *Editor) DeleteContext(contextName string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	configs, err := e.loadAll()
+	if err != nil {
+		return err
+	}
+
+	changed := map[int]bool{}
+	for i, config := range configs {
+		if _, ok := config.Contexts[contextName]; ok {
+			delete(config.Contexts, contextName)
+			changed[i] = true
+		}
+		if config.CurrentContext == contextName {
+			config.CurrentContext = ""
+			changed[i] = true
+		}
+	}
+
+	if len(changed) == 0 {
+		return errors.Errorf("context %s does not exist", contextName)
+	}
+
+	return e.writeChanged(configs, changed)
+}
+
+// loadAll loads each file in the chain. Missing files are empty.
+func (e *Editor) loadAll() ([]*clientcmdapi.Config, error) {
+	if len(e.files) == 0 {
+		return nil, errors.New("no kubeconfig files")
+	}
+
+	var configs []*clientcmdapi.Config
+	for _, file := range e.files {
+		config, err := clientcmd.LoadFromFile(file)
+		if os.IsNotExist(err) {
+			config = clientcmdapi.NewConfig()
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "load kubeconfig %s", file)
+		}
+		configs = append(configs, config)
+	}
+
+	return configs, nil
+}
+
+// defaultFile returns the index of the file which new entries are written to.
+// Like kubectl, it is the first file which exists.
+func (e *Editor) defaultFile() int {
+	for i, file := range e.files {
+		if _, err := os.Stat(file); err == nil {
+			return i
+		}
+	}
+	return len(e.files) - 1
+}
+
+func (e *Editor) writeChanged(configs []*clientcmdapi.Config, changed map[int]bool) error {
+	for i, config := range configs {
+		if !changed[i] {
+			continue
+		}
+		if err := writeFileAtomic(e.files[i], config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeFileAtomic writes config to a temporary file next to path and renames
+// it over path. The file's permissions are kept.
+func writeFileAtomic(path string, config *clientcmdapi.Config) error {
+	data, err := clientcmd.Write(*config)
+	if err != nil {
+		return errors.Wrapf(err, "serialize kubeconfig %s", path)
+	}
+
+	mode := os.FileMode(0600)
+	if fi, err := os.Stat(path); err == nil {
+		mode = fi.Mode().Perm()
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return errors.Wrapf(err, "create kubeconfig directory %s", dir)
+	}
+
+	f, err := ioutil.TempFile(dir, "."+filepath.Base(path)+".*")
+	if err != nil {
+		return errors.Wrapf(err, "create temporary file for kubeconfig %s", path)
+	}
+	tmp := f.Name()
+	defer os.Remove(tmp)
+
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return errors.Wrapf(err, "write kubeconfig %s", path)
+	}
+	if err := f.Chmod(mode); err != nil {
+		_ = f.Close()
+		return errors.Wrapf(err, "set permissions of kubeconfig %s", path)
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return errors.Wrapf(err, "write kubeconfig %s", path)
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrapf(err, "write kubeconfig %s", path)
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		return errors.Wrapf(err, "replace kubeconfig %s", path)
+	}
+	return nil
+}
+
+// findContext returns the first context named name and the index of the
+// file which defines it.
+func findContext(configs []*clientcmdapi.Config, name string) (int, *clientcmdapi.Context) {
+	for i, config := range configs {
+		if context, ok := config.Contexts[name]; ok {
+			return i, context
+		}
+	}
+	return -1, nil
+}
+
+// describeAuth describes how a user authenticates without revealing any
+// credentials.
+func describeAuth(authInfo *clientcmdapi.AuthInfo) string {
+	switch {
+	case authInfo.Exec != nil:
+		return "exec: " + strings.Join(append([]string{authInfo.Exec.Command}, authInfo.Exec.Args...), " ")
+	case authInfo.AuthProvider != nil:
+		return "auth provider: " + authInfo.AuthProvider.Name
+	case authInfo.ClientCertificate != "" || len(authInfo.ClientCertificateData) > 0:
+		return "client certificate"
+	case authInfo.Token != "" || authInfo.TokenFile != "":
+		return "token"
+	case authInfo.Username != "":
+		return "basic"
+	default:
+		return "-"
+	}
+}
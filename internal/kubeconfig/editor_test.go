@@ -0,0 +1,170 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kubeconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// newTestEditor copies the test kubeconfigs to a temporary directory and
+// creates an editor for them.
+func newTestEditor(t *testing.T, names ...string) (*Editor, []string) {
+	dir := t.TempDir()
+
+	var files []string
+	for _, name := range names {
+		data, err := ioutil.ReadFile(filepath.Join("testdata", name))
+		require.NoError(t, err)
+
+		file := filepath.Join(dir, name)
+		require.NoError(t, ioutil.WriteFile(file, data, 0640))
+		files = append(files, file)
+	}
+
+	return NewEditor(strings.Join(files, string(filepath.ListSeparator))), files
+}
+
+func TestEditor_Load(t *testing.T) {
+	editor, files := newTestEditor(t, "kubeconfig-1.yaml", "kubeconfig-2.yaml")
+
+	chain, err := editor.Load()
+	require.NoError(t, err)
+
+	assert.Equal(t, files, chain.Files)
+	assert.Equal(t, "dev-frontend", chain.CurrentContext)
+	assert.Equal(t, []ContextEntry{
+		{Name: "dev-frontend", File: files[0], Cluster: "development", Current: true},
+		{Name: "dev-storage", File: files[0], Cluster: "development"},
+		{Name: "exp-scratch", File: files[1], Cluster: "scratch"},
+	}, chain.Contexts)
+	assert.Equal(t, []ClusterEntry{
+		{Name: "development", File: files[0], Server: "https://cluster:4443"},
+		{Name: "scratch", File: files[0], Server: "https://cluster:4443"},
+	}, chain.Clusters)
+	assert.Equal(t, []UserEntry{
+		{Name: "developer", File: files[0], Auth: "-"},
+		{Name: "experimenter", File: files[0], Auth: "-"},
+	}, chain.Users)
+}
+
+func TestEditor_Import(t *testing.T) {
+	editor, files := newTestEditor(t, "kubeconfig-1.yaml", "kubeconfig-2.yaml")
+	editor.files = append([]string{filepath.Join(filepath.Dir(files[0]), "missing.yaml")}, files...)
+
+	imported := `apiVersion: v1
+kind: Config
+clusters:
+- name: staging
+  cluster:
+    server: https://staging:6443
+users:
+- name: deployer
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: aws
+      args: ["eks", "get-token"]
+contexts:
+- name: staging
+  context:
+    cluster: staging
+    user: deployer
+`
+	require.NoError(t, editor.Import([]byte(imported), false))
+
+	config, err := clientcmd.LoadFromFile(files[0])
+	require.NoError(t, err)
+	assert.Contains(t, config.Contexts, "staging", "new entries are written to the first existing file")
+	assert.Equal(t, "https://staging:6443", config.Clusters["staging"].Server)
+	assert.Equal(t, "dev-frontend", config.CurrentContext)
+
+	chain, err := editor.Load()
+	require.NoError(t, err)
+	assert.Contains(t, chain.Users, UserEntry{Name: "deployer", File: files[0], Auth: "exec: aws eks get-token"})
+
+	fi, err := os.Stat(files[0])
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0640), fi.Mode().Perm(), "permissions are kept")
+
+	err = editor.Import([]byte(imported), false)
+	require.Error(t, err)
+	assert.Equal(t, "kubeconfig already has cluster staging, context staging, user deployer", err.Error())
+
+	imported = `apiVersion: v1
+kind: Config
+contexts:
+- name: exp-scratch
+  context:
+    cluster: staging
+`
+	require.NoError(t, editor.Import([]byte(imported), true))
+
+	config, err = clientcmd.LoadFromFile(files[1])
+	require.NoError(t, err)
+	assert.Equal(t, "staging", config.Contexts["exp-scratch"].Cluster, "overwritten entries stay in their file")
+
+	require.Error(t, editor.Import([]byte("kind: Config"), false))
+	assert.Equal(t, ErrInvalidKubeConfig, editor.Import([]byte("contexts: secret"), false), "parse errors don't quote the kubeconfig")
+}
+
+func TestEditor_SetNamespace(t *testing.T) {
+	editor, files := newTestEditor(t, "kubeconfig-1.yaml", "kubeconfig-2.yaml")
+
+	require.NoError(t, editor.SetNamespace("exp-scratch", "experiments"))
+
+	config, err := clientcmd.LoadFromFile(files[1])
+	require.NoError(t, err)
+	assert.Equal(t, "experiments", config.Contexts["exp-scratch"].Namespace)
+
+	require.Error(t, editor.SetNamespace("missing", "default"))
+}
+
+func TestEditor_RenameContext(t *testing.T) {
+	editor, files := newTestEditor(t, "kubeconfig-1.yaml", "kubeconfig-2.yaml")
+
+	require.NoError(t, editor.RenameContext("dev-frontend", "frontend"))
+
+	config, err := clientcmd.LoadFromFile(files[0])
+	require.NoError(t, err)
+	assert.NotContains(t, config.Contexts, "dev-frontend")
+	assert.Equal(t, "development", config.Contexts["frontend"].Cluster)
+	assert.Equal(t, "frontend", config.CurrentContext)
+
+	require.Error(t, editor.RenameContext("frontend", "exp-scratch"), "names must be unique")
+	require.Error(t, editor.RenameContext("missing", "other"))
+	require.Error(t, editor.RenameContext("frontend", ""))
+}
+
+func TestEditor_DeleteContext(t *testing.T) {
+	editor, files := newTestEditor(t, "kubeconfig-1.yaml", "kubeconfig-2.yaml")
+
+	require.NoError(t, editor.DeleteContext("dev-frontend"))
+
+	config, err := clientcmd.LoadFromFile(files[0])
+	require.NoError(t, err)
+	assert.NotContains(t, config.Contexts, "dev-frontend")
+	assert.Contains(t, config.Clusters, "development", "clusters are kept")
+	assert.Empty(t, config.CurrentContext)
+
+	entries, err := ioutil.ReadDir(filepath.Dir(files[0]))
+	require.NoError(t, err)
+	assert.Len(t, entries, 2, "temporary files are removed")
+
+	require.Error(t, editor.DeleteContext("dev-frontend"))
+}
+
+func TestNewEditor(t *testing.T) {
+	list := "a" + string(filepath.ListSeparator) + "b" + string(filepath.ListSeparator) + "a"
+	assert.Equal(t, []string{"a", "b"}, NewEditor(list).Files())
+}
@@ -12,6 +12,7 @@ import (
 	"sync/atomic"
 
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/pkg/errors"
 
@@ -86,16 +87,6 @@ func NewKubeConfigContextManager(ctx context.Context, opts ...KubeConfigOption)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to load kube config")
 	}
-	var contextList []Context
-
-	for name := range config.Contexts {
-		contextList = append(contextList, Context{Name: name})
-	}
-
-	sort.Slice(contextList, func(i, j int) bool {
-		return contextList[i].Name < contextList[j].Name
-	})
-
 	contextName := options.ContextName
 	if contextName == "" {
 		contextName = config.CurrentContext
@@ -106,7 +97,7 @@ func NewKubeConfigContextManager(ctx context.Context, opts ...KubeConfigOption)
 			Precedence: chain,
 		},
 		currentContext: contextName,
-		contexts:       contextList,
+		contexts:       contextsFromConfig(config),
 		clusterOptions: clusterOptions,
 	}
 	kubeConfigCtxMgr.clusterClient.Store(clusterClient)
@@ -156,18 +147,37 @@ func (k *KubeConfigContextManager) SwitchContext(ctx context.Context, contextNam
 	}
 	k.clusterClient.Store(clusterClient)
 
+	rawConfig, err := clientConfig.RawConfig()
+	if err != nil {
+		return errors.Wrap(err, "unable to load kube config")
+	}
+
 	if contextName == UseFSContext {
-		rawConfig, err := clientConfig.RawConfig()
-		if err != nil {
-			return errors.Wrap(err, "unable to infer context name from kube config")
-		}
 		contextName = rawConfig.CurrentContext
 	}
 
+	// Contexts may have been added, renamed or removed since the kube config
+	// was last loaded.
+	k.contexts = contextsFromConfig(rawConfig)
 	k.currentContext = contextName
 	return nil
 }
 
+// ClientForContext creates a cluster client for contextName. Unlike
+// SwitchContext, the current context and its client are left unchanged.
+func (k *KubeConfigContextManager) ClientForContext(ctx context.Context, contextName string) (cluster.ClientInterface, error) {
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		k.configLoadingRules,
+		&clientcmd.ConfigOverrides{CurrentContext: contextName},
+	)
+
+	clusterClient, err := cluster.FromClientConfig(ctx, clientConfig, k.clusterOptions...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create cluster client for context %s", contextName)
+	}
+	return clusterClient, nil
+}
+
 func (k *KubeConfigContextManager) ClusterClient() cluster.ClientInterface {
 	v := k.clusterClient.Load()
 	if v == nil {
@@ -175,3 +185,18 @@ func (k *KubeConfigContextManager) ClusterClient() cluster.ClientInterface {
 	}
 	return v.(cluster.ClientInterface)
 }
+
+// contextsFromConfig returns the contexts in config sorted by name.
+func contextsFromConfig(config clientcmdapi.Config) []Context {
+	var contextList []Context
+
+	for name := range config.Contexts {
+		contextList = append(contextList, Context{Name: name})
+	}
+
+	sort.Slice(contextList, func(i, j int) bool {
+		return contextList[i].Name < contextList[j].Name
+	})
+
+	return contextList
+}
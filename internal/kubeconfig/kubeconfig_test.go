@@ -67,6 +67,45 @@ func Test_SwitchContextUpdatesClientNamespace(t *testing.T) {
 	require.Equal(t, "non-default", kubeConfigs.ClusterClient().DefaultNamespace())
 }
 
+func Test_ClientForContextKeepsCurrentContext(t *testing.T) {
+	kubeConfigs, err := NewKubeConfigContextManager(
+		context.TODO(),
+		WithKubeConfigList(filepath.Join("testdata", "kubeconfig.yaml")),
+	)
+	require.NoError(t, err)
+
+	clusterClient, err := kubeConfigs.ClientForContext(context.TODO(), "other-context")
+	require.NoError(t, err)
+
+	assert.Equal(t, "non-default", clusterClient.DefaultNamespace())
+	assert.Equal(t, "my-cluster", kubeConfigs.CurrentContext())
+	assert.NotEqual(t, "non-default", kubeConfigs.ClusterClient().DefaultNamespace())
+
+	_, err = kubeConfigs.ClientForContext(context.TODO(), "missing-context")
+	assert.Error(t, err)
+}
+
+func Test_SwitchContextReloadsContexts(t *testing.T) {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "kubeconfig.yaml"))
+	require.NoError(t, err)
+
+	kubeConfigPath := filepath.Join(t.TempDir(), "kubeconfig.yaml")
+	require.NoError(t, ioutil.WriteFile(kubeConfigPath, data, 0600))
+
+	kubeConfigs, err := NewKubeConfigContextManager(
+		context.TODO(),
+		WithKubeConfigList(kubeConfigPath),
+	)
+	require.NoError(t, err)
+
+	data = []byte(strings.Replace(string(data), "name: other-context", "name: renamed-context", 1))
+	require.NoError(t, ioutil.WriteFile(kubeConfigPath, data, 0600))
+
+	require.NoError(t, kubeConfigs.SwitchContext(context.TODO(), ""))
+
+	assert.Equal(t, []Context{{Name: "my-cluster"}, {Name: "renamed-context"}}, kubeConfigs.Contexts())
+}
+
 func TestFSLoader_Load(t *testing.T) {
 	dir, err := ioutil.TempDir("", "loader-test")
 	require.NoError(t, err)
@@ -0,0 +1,146 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package metrics exposes metrics about Octant itself in the Prometheus format.
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	clientmetrics "k8s.io/client-go/tools/metrics"
+)
+
+// Path is the path metrics are served at.
+const Path = "/metrics"
+
+const namespace = "octant"
+
+var (
+	// ContentGenerationDuration observes how long generating content takes.
+	ContentGenerationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "content_generation_duration_seconds",
+		Help:      "Time taken to generate content by module and content path. Namespaces and object names in paths are replaced by placeholders.",
+	}, []string{"module", "path"})
+
+	// WebsocketClients is the number of connected websocket clients.
+	WebsocketClients = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "websocket_clients",
+		Help:      "Number of connected websocket clients.",
+	})
+
+	// WebsocketMessageSize observes the size of websocket messages.
+	WebsocketMessageSize = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "websocket_message_size_bytes",
+		Help:      "Size of websocket messages by direction.",
+		Buckets:   prometheus.ExponentialBuckets(256, 4, 8),
+	}, []string{"direction"})
+
+	// PluginRPCDuration observes how long plugin RPCs take.
+	PluginRPCDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "plugin_rpc_duration_seconds",
+		Help:      "Time taken by plugin RPCs by plugin and method.",
+	}, []string{"plugin", "method"})
+
+	// Streams is the number of open log and terminal streams.
+	Streams = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "streams",
+		Help:      "Number of open streams by type.",
+	}, []string{"type"})
+
+	kubernetesRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "kubernetes_request_duration_seconds",
+		Help:      "Time taken by Kubernetes API requests by verb and path.",
+	}, []string{"verb", "path"})
+
+	kubernetesRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "kubernetes_requests_total",
+		Help:      "Number of Kubernetes API requests by status code, method and host.",
+	}, []string{"code", "method", "host"})
+)
+
+// Websocket message directions.
+const (
+	DirectionReceived = "received"
+	DirectionSent     = "sent"
+)
+
+// Stream types.
+const (
+	StreamLogs     = "logs"
+	StreamTerminal = "terminal"
+)
+
+var registry = prometheus.NewRegistry()
+
+func init() {
+	registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		ContentGenerationDuration,
+		WebsocketClients,
+		WebsocketMessageSize,
+		PluginRPCDuration,
+		Streams,
+		kubernetesRequestDuration,
+		kubernetesRequests,
+	)
+
+	clientmetrics.Register(clientmetrics.RegisterOpts{
+		RequestLatency: requestLatency{},
+		RequestResult:  requestResult{},
+	})
+}
+
+// MustRegister registers collectors. It panics if a collector can't be registered.
+func MustRegister(collectors ...prometheus.Collector) {
+	registry.MustRegister(collectors...)
+}
+
+// Handler serves the registered metrics.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// ObserveDuration observes the time since start.
+func ObserveDuration(observer prometheus.Observer, start time.Time) {
+	observer.Observe(time.Since(start).Seconds())
+}
+
+// TrackStream counts a stream of streamType as open until ctx is done.
+func TrackStream(ctx context.Context, streamType string) {
+	gauge := Streams.WithLabelValues(streamType)
+	gauge.Inc()
+	go func() {
+		<-ctx.Done()
+		gauge.Dec()
+	}()
+}
+
+// requestLatency records the latency of client-go requests. Paths are templated
+// by client-go, so object names are not included.
+type requestLatency struct{}
+
+func (requestLatency) Observe(verb string, u url.URL, latency time.Duration) {
+	kubernetesRequestDuration.WithLabelValues(verb, u.Path).Observe(latency.Seconds())
+}
+
+// requestResult counts the results of client-go requests.
+type requestResult struct{}
+
+func (requestResult) Increment(code, method, host string) {
+	kubernetesRequests.WithLabelValues(code, method, host).Inc()
+}
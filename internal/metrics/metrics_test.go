@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientmetrics "k8s.io/client-go/tools/metrics"
+)
+
+func TestHandler(t *testing.T) {
+	ContentGenerationDuration.WithLabelValues("overview", "/namespace/{namespace}/workloads/pods/{name}").Observe(0.5)
+	clientmetrics.RequestLatency.Observe("GET", url.URL{Path: "/api/v1/namespaces/{namespace}/pods"}, time.Second)
+	clientmetrics.RequestResult.Increment("200", "GET", "cluster.example.com")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	TrackStream(ctx, StreamLogs)
+
+	body := scrape(t)
+	assert.Contains(t, body, `octant_content_generation_duration_seconds_count{module="overview",path="/namespace/{namespace}/workloads/pods/{name}"} 1`)
+	assert.Contains(t, body, `octant_kubernetes_request_duration_seconds_count{path="/api/v1/namespaces/{namespace}/pods",verb="GET"} 1`)
+	assert.Contains(t, body, `octant_kubernetes_requests_total{code="200",host="cluster.example.com",method="GET"} 1`)
+	assert.Contains(t, body, `octant_streams{type="logs"} 1`)
+	assert.Contains(t, body, "go_goroutines")
+
+	cancel()
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual(0.0, gaugeValue(t, StreamLogs))
+	}, time.Second, 10*time.Millisecond, "streams are closed with their context")
+}
+
+func scrape(t *testing.T) string {
+	recorder := httptest.NewRecorder()
+	Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, Path, nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	return recorder.Body.String()
+}
+
+func gaugeValue(t *testing.T, streamType string) float64 {
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != "octant_streams" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "type" && label.GetValue() == streamType {
+					return metric.GetGauge().GetValue()
+				}
+			}
+		}
+	}
+	return -1
+}
@@ -22,6 +22,11 @@ import (
 // ContentOptions are additional options for content generation
 type ContentOptions struct {
 	LabelSet *labels.Set
+	// ListContinue is the continue token of the page of a paged list being
+	// viewed. It is empty for the first page.
+	ListContinue string
+	// Namespaces are the namespaces of the selected view.
+	Namespaces []string
 }
 
 // Module is an octant plugin.
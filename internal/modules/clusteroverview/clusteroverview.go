@@ -186,8 +186,11 @@ func (co *ClusterOverview) Content(ctx context.Context, contentPath string, opts
 		Dash:     co.DashConfig,
 		Link:     linkGenerator,
 
+		ListContinue: opts.ListContinue,
+
 		LoadObjects: loaderFactory.LoadObjects,
 		LoadObject:  loaderFactory.LoadObject,
+		LoadHealth:  loaderFactory.LoadHealth,
 	}
 
 	cResponse, err := pf.Describer.Describe(ctx, "", options)
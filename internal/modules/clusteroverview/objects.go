@@ -42,6 +42,7 @@ var (
 		Titles:         describer.ResourceTitle{List: "Cluster Roles", Object: "Cluster Roles"},
 		ClusterWide:    true,
 		IconName:       icon.ClusterOverviewClusterRole,
+		MetadataOnly:   true,
 	})
 
 	rbacClusterRoleBindings = describer.NewResource(describer.ResourceOptions{
@@ -0,0 +1,237 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package compare compares an object with the object of the same name in
+// another namespace or context. Comparisons are linked to from object pages and
+// are not shown in navigation.
+package compare
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/module"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// Name is the name of the module. It is also the root of its content paths.
+const Name = "compare"
+
+// Options are options for Compare.
+type Options struct {
+	Sources *Sources
+}
+
+// Compare is a module for comparing objects.
+type Compare struct {
+	Options
+}
+
+var _ module.Module = (*Compare)(nil)
+
+// New creates an instance of Compare.
+func New(options Options) *Compare {
+	return &Compare{Options: options}
+}
+
+// Name returns the name of the module.
+func (c *Compare) Name() string {
+	return Name
+}
+
+// Description returns the description of the module.
+func (c *Compare) Description() string {
+	return "Compare module is used to compare objects across namespaces and contexts"
+}
+
+// ClientRequestHandlers returns nil.
+func (c *Compare) ClientRequestHandlers() []octant.ClientRequestHandler {
+	return nil
+}
+
+// Content generates a comparison of an object in two targets, or a list of the
+// targets an object can be compared with.
+func (c *Compare) Content(ctx context.Context, contentPath string, _ module.ContentOptions) (component.ContentResponse, error) {
+	if tr, err := ParseTargetsPath(contentPath); err == nil {
+		return c.targetsContent(ctx, tr), nil
+	}
+
+	r, err := ParsePath(contentPath)
+	if err != nil {
+		return component.EmptyContentResponse, api.NewNotFoundError(contentPath)
+	}
+
+	title := component.Title(component.NewText(fmt.Sprintf("Compare %s %s", r.GroupVersionKind.Kind, r.Name)))
+	cr := component.NewContentResponse(title)
+
+	left, leftErr := c.load(ctx, r, r.Left)
+	right, rightErr := c.load(ctx, r, r.Right)
+	if leftErr != nil || rightErr != nil {
+		for _, err := range []error{leftErr, rightErr} {
+			if err != nil {
+				cr.Add(component.NewError(component.TitleFromString("Unable to compare"), err))
+			}
+		}
+		return *cr, nil
+	}
+
+	sections := Diff(left, right)
+
+	count := 0
+	for _, section := range sections {
+		count += len(section.Differences)
+	}
+	noun := "differences"
+	if count == 1 {
+		noun = "difference"
+	}
+	cr.Add(component.NewText(fmt.Sprintf("%d %s between %s and %s", count, noun, r.Left, r.Right)))
+
+	cols := component.NewTableCols("Field", r.Left.String(), r.Right.String())
+	for _, section := range sections {
+		table := component.NewTable(section.Name, "No differences", cols)
+		for _, difference := range section.Differences {
+			table.Add(component.TableRow{
+				"Field":          component.NewText(difference.Field),
+				r.Left.String():  component.NewText(difference.Left),
+				r.Right.String(): component.NewText(difference.Right),
+			})
+		}
+		cr.Add(table)
+	}
+
+	return *cr, nil
+}
+
+// targetsContent lists the objects with the same name as the requested object
+// in other namespaces and contexts. Objects are only searched for when this
+// page is shown, since searching lists the kind in every context.
+func (c *Compare) targetsContent(ctx context.Context, tr TargetsRequest) component.ContentResponse {
+	title := component.Title(component.NewText(fmt.Sprintf("Compare %s %s with", tr.GroupVersionKind.Kind, tr.Name)))
+	cr := component.NewContentResponse(title)
+
+	targets, failed := Targets(ctx, c.Sources, tr.Key(), tr.Current)
+
+	cols := component.NewTableCols("Context", "Namespace", "Comparison")
+	table := component.NewTable("Compare", "No objects with the same name were found in other namespaces or contexts", cols)
+	if len(failed) > 0 {
+		table.SetAlert(component.NewAlert(component.AlertTypeWarning,
+			fmt.Sprintf("Objects in these contexts couldn't be listed: %s", strings.Join(failed, ", "))))
+	}
+	for _, target := range targets {
+		namespace := target.Namespace
+		if namespace == "" {
+			namespace = "-"
+		}
+
+		r := Request{
+			GroupVersionKind: tr.GroupVersionKind,
+			Name:             tr.Name,
+			Left:             tr.Current,
+			Right:            target,
+		}
+		table.Add(component.TableRow{
+			"Context":    component.NewText(target.Context),
+			"Namespace":  component.NewText(namespace),
+			"Comparison": component.NewLink("", fmt.Sprintf("Compare with %s", target), Path(r)),
+		})
+	}
+	cr.Add(table)
+
+	return *cr
+}
+
+func (c *Compare) load(ctx context.Context, r Request, target Target) (*unstructured.Unstructured, error) {
+	objectStore, ok := c.Sources.ObjectStore(target.Context)
+	if !ok {
+		return nil, errors.Errorf("context %s is not connected", target.Context)
+	}
+
+	object, err := objectStore.Get(ctx, r.Key(target))
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, errors.Errorf("%s %s was not found in %s", r.GroupVersionKind.Kind, r.Name, target)
+		}
+		return nil, errors.Wrapf(err, "get %s %s in %s", r.GroupVersionKind.Kind, r.Name, target)
+	}
+	if object == nil {
+		return nil, errors.Errorf("%s %s was not found in %s", r.GroupVersionKind.Kind, r.Name, target)
+	}
+	return object, nil
+}
+
+// ContentPath returns the root content path of the module.
+func (c *Compare) ContentPath() string {
+	return Name
+}
+
+// Navigation returns no entries since comparisons are linked to from object
+// pages.
+func (c *Compare) Navigation(ctx context.Context, namespace, root string) ([]navigation.Navigation, error) {
+	return nil, nil
+}
+
+// SetNamespace does nothing since comparisons name their namespaces.
+func (c *Compare) SetNamespace(namespace string) error {
+	return nil
+}
+
+// Start does nothing.
+func (c *Compare) Start() error {
+	return nil
+}
+
+// Stop does nothing.
+func (c *Compare) Stop() {
+}
+
+// SetContext does nothing since comparisons name their contexts.
+func (c *Compare) SetContext(ctx context.Context, contextName string) error {
+	return nil
+}
+
+// Generators allow modules to send events to the frontend.
+func (c *Compare) Generators() []octant.Generator {
+	return []octant.Generator{}
+}
+
+// SupportedGroupVersionKind returns nil since the module doesn't own objects.
+func (c *Compare) SupportedGroupVersionKind() []schema.GroupVersionKind {
+	return nil
+}
+
+// GroupVersionKindPath returns an error since the module doesn't own objects.
+func (c *Compare) GroupVersionKindPath(namespace, apiVersion, kind, name string) (string, error) {
+	return "", errors.Errorf("compare module does not own %s %s", apiVersion, kind)
+}
+
+// GvkFromPath returns an error since the module doesn't own objects.
+func (c *Compare) GvkFromPath(contentPath, namespace string) (schema.GroupVersionKind, error) {
+	return schema.GroupVersionKind{}, errors.Errorf("compare module can't get GVK from path %s", contentPath)
+}
+
+// AddCRD does nothing since the module doesn't own objects.
+func (c *Compare) AddCRD(ctx context.Context, crd *unstructured.Unstructured) error {
+	return nil
+}
+
+// RemoveCRD does nothing since the module doesn't own objects.
+func (c *Compare) RemoveCRD(ctx context.Context, crd *unstructured.Unstructured) error {
+	return nil
+}
+
+// ResetCRDs does nothing since the module doesn't own objects.
+func (c *Compare) ResetCRDs(ctx context.Context) error {
+	return nil
+}
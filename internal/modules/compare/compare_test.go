@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package compare
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/module"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestCompare_Content(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+	sources := NewSources(&fakeSource{stores: map[string]store.Store{"staging": objectStore}})
+
+	left := podIn("web", "default")
+	left.Labels = map[string]string{"app": "web"}
+	right := podIn("web", "team-a")
+
+	objectStore.EXPECT().Get(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "web"}).
+		Return(testutil.ToUnstructured(t, left), nil)
+	objectStore.EXPECT().Get(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "team-a", Name: "web"}).
+		Return(testutil.ToUnstructured(t, right), nil)
+
+	c := New(Options{Sources: sources})
+
+	got, err := c.Content(context.Background(), "/Pod.v1/web/staging/default/staging/team-a", module.ContentOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, component.Title(component.NewText("Compare Pod web")), got.Title)
+	require.Len(t, got.Components, 6)
+	assert.Equal(t, component.NewText("1 difference between staging/default and staging/team-a"), got.Components[0])
+
+	labels, ok := got.Components[2].(*component.Table)
+	require.True(t, ok)
+	expected := []component.TableRow{
+		{
+			"Field":           component.NewText("app"),
+			"staging/default": component.NewText("web"),
+			"staging/team-a":  component.NewText(missing),
+		},
+	}
+	assert.Equal(t, expected, labels.Rows())
+}
+
+func TestCompare_Content_targets(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+	sources := NewSources(&fakeSource{stores: map[string]store.Store{"staging": objectStore}})
+
+	objectStore.EXPECT().List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod"}).
+		Return(testutil.ToUnstructuredList(t, podIn("web", "default"), podIn("web", "team-a")), false, nil)
+
+	c := New(Options{Sources: sources})
+
+	got, err := c.Content(context.Background(), "/Pod.v1/web/staging/default", module.ContentOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, component.Title(component.NewText("Compare Pod web with")), got.Title)
+	require.Len(t, got.Components, 1)
+	table, ok := got.Components[0].(*component.Table)
+	require.True(t, ok)
+
+	expected := []component.TableRow{
+		{
+			"Context":    component.NewText("staging"),
+			"Namespace":  component.NewText("team-a"),
+			"Comparison": component.NewLink("", "Compare with staging/team-a", "/compare/Pod.v1/web/staging/default/staging/team-a"),
+		},
+	}
+	assert.Equal(t, expected, table.Rows())
+}
+
+func TestCompare_Content_notConnected(t *testing.T) {
+	c := New(Options{Sources: NewSources()})
+
+	got, err := c.Content(context.Background(), "/Pod.v1/web/staging/default/production/default", module.ContentOptions{})
+	require.NoError(t, err)
+	require.Len(t, got.Components, 2)
+	_, ok := got.Components[0].(*component.Error)
+	assert.True(t, ok)
+
+	_, err = c.Content(context.Background(), "/Pod.v1/web", module.ContentOptions{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,236 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package compare
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// missing is shown for fields which only one object has.
+const missing = "<none>"
+
+// serverManagedFields are maintained by the API server, so they are ignored
+// wherever they appear.
+var serverManagedFields = map[string]bool{
+	"resourceVersion":    true,
+	"uid":                true,
+	"managedFields":      true,
+	"selfLink":           true,
+	"generation":         true,
+	"observedGeneration": true,
+	"creationTimestamp":  true,
+	"deletionTimestamp":  true,
+}
+
+// ignoredAnnotations are written by clients and duplicate the rest of the
+// object.
+var ignoredAnnotations = map[string]bool{
+	"kubectl.kubernetes.io/last-applied-configuration": true,
+	"deployment.kubernetes.io/revision":                true,
+}
+
+// Difference is a field whose value differs between two objects.
+type Difference struct {
+	Field string
+	Left  string
+	Right string
+}
+
+// Section is a group of differences.
+type Section struct {
+	Name        string
+	Differences []Difference
+}
+
+// Diff compares two objects section by section. Server managed fields and
+// timestamps are ignored.
+func Diff(left, right *unstructured.Unstructured) []Section {
+	return []Section{
+		{Name: "Spec", Differences: diffFields(spec(left), spec(right))},
+		{Name: "Labels", Differences: diffFields(stringMap(left.GetLabels(), nil), stringMap(right.GetLabels(), nil))},
+		{Name: "Annotations", Differences: diffFields(stringMap(left.GetAnnotations(), ignoredAnnotations), stringMap(right.GetAnnotations(), ignoredAnnotations))},
+		{Name: "Images", Differences: diffFields(images(left), images(right))},
+		{Name: "Status", Differences: diffFields(status(left), status(right))},
+	}
+}
+
+// spec flattens the fields of an object other than its type, metadata and
+// status. For most objects this is the spec, but objects such as config maps
+// keep their data in other top level fields.
+func spec(object *unstructured.Unstructured) map[string]string {
+	fields := map[string]string{}
+	for name, value := range object.Object {
+		switch name {
+		case "apiVersion", "kind", "metadata", "status":
+			continue
+		}
+		flatten(name, value, fields)
+	}
+	return fields
+}
+
+func status(object *unstructured.Unstructured) map[string]string {
+	fields := map[string]string{}
+	if value, ok := object.Object["status"]; ok {
+		flatten("status", value, fields)
+	}
+	return fields
+}
+
+func stringMap(m map[string]string, ignored map[string]bool) map[string]string {
+	fields := map[string]string{}
+	for k, v := range m {
+		if !ignored[k] {
+			fields[k] = v
+		}
+	}
+	return fields
+}
+
+// images finds the images of the containers anywhere in an object, so pods and
+// the pod templates of workloads are both supported.
+func images(object *unstructured.Unstructured) map[string]string {
+	fields := map[string]string{}
+	findImages(object.Object, fields)
+	return fields
+}
+
+func findImages(value interface{}, fields map[string]string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if key == "containers" || key == "initContainers" || key == "ephemeralContainers" {
+				for _, container := range asList(child) {
+					name, _, _ := unstructured.NestedString(container, "name")
+					image, found, _ := unstructured.NestedString(container, "image")
+					if found {
+						fields[name] = image
+					}
+				}
+				continue
+			}
+			findImages(child, fields)
+		}
+	case []interface{}:
+		for _, child := range v {
+			findImages(child, fields)
+		}
+	}
+}
+
+func asList(value interface{}) []map[string]interface{} {
+	list, _ := value.([]interface{})
+	var out []map[string]interface{}
+	for _, item := range list {
+		if m, ok := item.(map[string]interface{}); ok {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// flatten flattens value into fields keyed by their path. Items of lists are
+// keyed by name when they have one so reordered lists compare equal.
+func flatten(prefix string, value interface{}, fields map[string]string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			fields[prefix] = "{}"
+		}
+		for key, child := range v {
+			if isIgnored(key, child) {
+				continue
+			}
+			flatten(prefix+"."+key, child, fields)
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			fields[prefix] = "[]"
+		}
+		names, named := itemNames(v)
+		for i, child := range v {
+			index := fmt.Sprintf("%d", i)
+			if named {
+				index = names[i]
+			}
+			flatten(fmt.Sprintf("%s[%s]", prefix, index), child, fields)
+		}
+	case string:
+		fields[prefix] = v
+	case nil:
+		fields[prefix] = "null"
+	default:
+		fields[prefix] = fmt.Sprintf("%v", v)
+	}
+}
+
+// itemNames returns the names of the items in list. False is returned unless
+// every item has a name.
+func itemNames(list []interface{}) ([]string, bool) {
+	var names []string
+	for _, item := range list {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		name, _, _ := unstructured.NestedString(m, "name")
+		if name == "" {
+			return nil, false
+		}
+		names = append(names, name)
+	}
+	return names, true
+}
+
+// isIgnored returns true for server managed fields and timestamps.
+func isIgnored(key string, value interface{}) bool {
+	if serverManagedFields[key] {
+		return true
+	}
+	s, ok := value.(string)
+	if !ok || !(strings.HasSuffix(key, "Time") || strings.HasSuffix(key, "Timestamp")) {
+		return false
+	}
+	_, err := time.Parse(time.RFC3339, s)
+	return err == nil
+}
+
+// diffFields returns the fields whose values differ, sorted by field.
+func diffFields(left, right map[string]string) []Difference {
+	keys := map[string]bool{}
+	for k := range left {
+		keys[k] = true
+	}
+	for k := range right {
+		keys[k] = true
+	}
+
+	var differences []Difference
+	for k := range keys {
+		l, inLeft := left[k]
+		r, inRight := right[k]
+		if inLeft && inRight && l == r {
+			continue
+		}
+		if !inLeft {
+			l = missing
+		}
+		if !inRight {
+			r = missing
+		}
+		differences = append(differences, Difference{Field: k, Left: l, Right: r})
+	}
+
+	sort.Slice(differences, func(i, j int) bool {
+		return differences[i].Field < differences[j].Field
+	})
+	return differences
+}
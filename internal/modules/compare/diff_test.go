@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package compare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDiff(t *testing.T) {
+	left := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":              "web",
+			"namespace":         "default",
+			"uid":               "1",
+			"resourceVersion":   "10",
+			"creationTimestamp": "2021-01-01T00:00:00Z",
+			"labels":            map[string]interface{}{"app": "web", "tier": "frontend"},
+			"annotations": map[string]interface{}{
+				"deployment.kubernetes.io/revision": "3",
+				"owner":                             "team-a",
+			},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(2),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "web", "image": "web:1.0"},
+						map[string]interface{}{"name": "proxy", "image": "envoy:1.17"},
+					},
+				},
+			},
+		},
+		"status": map[string]interface{}{
+			"observedGeneration": int64(3),
+			"readyReplicas":      int64(2),
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Available", "status": "True", "lastUpdateTime": "2021-01-01T00:00:00Z"},
+			},
+		},
+	}}
+
+	right := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":              "web",
+			"namespace":         "default",
+			"uid":               "2",
+			"resourceVersion":   "20",
+			"creationTimestamp": "2021-02-01T00:00:00Z",
+			"labels":            map[string]interface{}{"app": "web"},
+			"annotations": map[string]interface{}{
+				"deployment.kubernetes.io/revision": "7",
+				"owner":                             "team-a",
+			},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "proxy", "image": "envoy:1.17"},
+						map[string]interface{}{"name": "web", "image": "web:1.1"},
+					},
+				},
+			},
+		},
+		"status": map[string]interface{}{
+			"observedGeneration": int64(7),
+			"readyReplicas":      int64(3),
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Available", "status": "True", "lastUpdateTime": "2021-02-01T00:00:00Z"},
+			},
+		},
+	}}
+
+	expected := []Section{
+		{
+			Name: "Spec",
+			Differences: []Difference{
+				{Field: "spec.replicas", Left: "2", Right: "3"},
+				{Field: "spec.template.spec.containers[web].image", Left: "web:1.0", Right: "web:1.1"},
+			},
+		},
+		{
+			Name: "Labels",
+			Differences: []Difference{
+				{Field: "tier", Left: "frontend", Right: missing},
+			},
+		},
+		{Name: "Annotations"},
+		{
+			Name: "Images",
+			Differences: []Difference{
+				{Field: "web", Left: "web:1.0", Right: "web:1.1"},
+			},
+		},
+		{
+			Name: "Status",
+			Differences: []Difference{
+				{Field: "status.readyReplicas", Left: "2", Right: "3"},
+			},
+		},
+	}
+
+	assert.Equal(t, expected, Diff(left, right))
+}
@@ -0,0 +1,204 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package compare
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// empty stands in for an empty context or namespace in content paths.
+const empty = "_"
+
+// Target is a context and namespace an object is compared in. Namespace is
+// empty for cluster scoped objects.
+type Target struct {
+	Context   string
+	Namespace string
+}
+
+// String describes the target.
+func (t Target) String() string {
+	if t.Namespace == "" {
+		return t.Context
+	}
+	return fmt.Sprintf("%s/%s", t.Context, t.Namespace)
+}
+
+// Request is a request to compare an object in two targets.
+type Request struct {
+	GroupVersionKind schema.GroupVersionKind
+	Name             string
+	Left             Target
+	Right            Target
+}
+
+// Key returns the store key of the object in target.
+func (r Request) Key(target Target) store.Key {
+	apiVersion, kind := r.GroupVersionKind.ToAPIVersionAndKind()
+	return store.Key{
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Namespace:  target.Namespace,
+		Name:       r.Name,
+	}
+}
+
+// Path returns the content path of the comparison. Paths look like
+// /compare/Deployment.v1.apps/web/staging/default/production/default.
+func Path(r Request) string {
+	return path.Join("/", Name, resourceSegment(r.GroupVersionKind), r.Name,
+		segment(r.Left.Context), segment(r.Left.Namespace),
+		segment(r.Right.Context), segment(r.Right.Namespace))
+}
+
+// ParsePath parses a content path relative to the module.
+func ParsePath(contentPath string) (Request, error) {
+	parts := strings.Split(strings.Trim(contentPath, "/"), "/")
+	if len(parts) != 6 {
+		return Request{}, errors.Errorf("invalid comparison path %q", contentPath)
+	}
+
+	gvk, err := parseResourceSegment(parts[0])
+	if err != nil {
+		return Request{}, err
+	}
+
+	r := Request{
+		GroupVersionKind: gvk,
+		Name:             parts[1],
+		Left:             Target{Context: unsegment(parts[2]), Namespace: unsegment(parts[3])},
+		Right:            Target{Context: unsegment(parts[4]), Namespace: unsegment(parts[5])},
+	}
+	if r.Left == r.Right {
+		return Request{}, errors.Errorf("can't compare %s with itself", r.Left)
+	}
+	return r, nil
+}
+
+// TargetsRequest is a request for the targets an object can be compared with.
+type TargetsRequest struct {
+	GroupVersionKind schema.GroupVersionKind
+	Name             string
+	Current          Target
+}
+
+// Key returns the store key of the object in its current target.
+func (r TargetsRequest) Key() store.Key {
+	return Request{GroupVersionKind: r.GroupVersionKind, Name: r.Name}.Key(r.Current)
+}
+
+// TargetsPath returns the content path which lists the targets an object can
+// be compared with. Paths look like /compare/Deployment.v1.apps/web/staging/default.
+func TargetsPath(r TargetsRequest) string {
+	return path.Join("/", Name, resourceSegment(r.GroupVersionKind), r.Name,
+		segment(r.Current.Context), segment(r.Current.Namespace))
+}
+
+// ParseTargetsPath parses a targets content path relative to the module.
+func ParseTargetsPath(contentPath string) (TargetsRequest, error) {
+	parts := strings.Split(strings.Trim(contentPath, "/"), "/")
+	if len(parts) != 4 {
+		return TargetsRequest{}, errors.Errorf("invalid targets path %q", contentPath)
+	}
+
+	gvk, err := parseResourceSegment(parts[0])
+	if err != nil {
+		return TargetsRequest{}, err
+	}
+
+	return TargetsRequest{
+		GroupVersionKind: gvk,
+		Name:             parts[1],
+		Current:          Target{Context: unsegment(parts[2]), Namespace: unsegment(parts[3])},
+	}, nil
+}
+
+func resourceSegment(gvk schema.GroupVersionKind) string {
+	return strings.TrimSuffix(strings.Join([]string{gvk.Kind, gvk.Version, gvk.Group}, "."), ".")
+}
+
+func parseResourceSegment(s string) (schema.GroupVersionKind, error) {
+	resource := strings.SplitN(s, ".", 3)
+	if len(resource) < 2 {
+		return schema.GroupVersionKind{}, errors.Errorf("invalid resource %q", s)
+	}
+	gvk := schema.GroupVersionKind{Kind: resource[0], Version: resource[1]}
+	if len(resource) == 3 {
+		gvk.Group = resource[2]
+	}
+	return gvk, nil
+}
+
+// Targets returns the targets other than exclude with an object matching key.
+// Contexts which are still loading are skipped. If objects can't be listed in
+// all namespaces of a context, only key's namespace is searched. Contexts where
+// neither can be listed are skipped and their names are returned.
+func Targets(ctx context.Context, sources *Sources, key store.Key, exclude Target) ([]Target, []string) {
+	listKey := store.Key{APIVersion: key.APIVersion, Kind: key.Kind}
+	// Only names are matched, so listing metadata is enough.
+	ctx = store.WithMetadataOnly(ctx)
+
+	var targets []Target
+	var failed []string
+	for _, contextName := range sources.ContextNames() {
+		objectStore, _ := sources.ObjectStore(contextName)
+		list, _, err := objectStore.List(ctx, listKey)
+		if err != nil && key.Namespace != "" {
+			namespaceKey := listKey
+			namespaceKey.Namespace = key.Namespace
+			list, _, err = objectStore.List(ctx, namespaceKey)
+		}
+		if err != nil {
+			log.From(ctx).WithErr(err).With("context", contextName).Errorf("list %s to compare", key.Kind)
+			failed = append(failed, contextName)
+			continue
+		}
+
+		for i := range list.Items {
+			object := &list.Items[i]
+			if object.GetName() != key.Name {
+				continue
+			}
+			target := Target{Context: contextName, Namespace: object.GetNamespace()}
+			if target != exclude {
+				targets = append(targets, target)
+			}
+		}
+	}
+
+	sort.Slice(targets, func(i, j int) bool {
+		if targets[i].Context != targets[j].Context {
+			return targets[i].Context < targets[j].Context
+		}
+		return targets[i].Namespace < targets[j].Namespace
+	})
+
+	return targets, failed
+}
+
+func segment(s string) string {
+	if s == "" {
+		return empty
+	}
+	return s
+}
+
+func unsegment(s string) string {
+	if s == empty {
+		return ""
+	}
+	return s
+}
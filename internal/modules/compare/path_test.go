@@ -0,0 +1,161 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package compare
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  Request
+		expected string
+	}{
+		{
+			name: "namespaced",
+			request: Request{
+				GroupVersionKind: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+				Name:             "web",
+				Left:             Target{Context: "staging", Namespace: "default"},
+				Right:            Target{Context: "production", Namespace: "default"},
+			},
+			expected: "/compare/Deployment.v1.apps/web/staging/default/production/default",
+		},
+		{
+			name: "cluster scoped in the core group",
+			request: Request{
+				GroupVersionKind: schema.GroupVersionKind{Version: "v1", Kind: "Namespace"},
+				Name:             "default",
+				Left:             Target{Context: "staging"},
+				Right:            Target{Context: "production"},
+			},
+			expected: "/compare/Namespace.v1/default/staging/_/production/_",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Path(test.request)
+			assert.Equal(t, test.expected, got)
+
+			r, err := ParsePath(got[len("/"+Name):])
+			require.NoError(t, err)
+			assert.Equal(t, test.request, r)
+		})
+	}
+}
+
+func TestTargetsPath(t *testing.T) {
+	r := TargetsRequest{
+		GroupVersionKind: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+		Name:             "web",
+		Current:          Target{Context: "staging", Namespace: "default"},
+	}
+
+	got := TargetsPath(r)
+	assert.Equal(t, "/compare/Deployment.v1.apps/web/staging/default", got)
+
+	parsed, err := ParseTargetsPath(got[len("/"+Name):])
+	require.NoError(t, err)
+	assert.Equal(t, r, parsed)
+
+	_, err = ParseTargetsPath("/Deployment.v1.apps/web/staging/default/production/default")
+	assert.Error(t, err)
+}
+
+func TestParsePath_invalid(t *testing.T) {
+	paths := []string{
+		"",
+		"/Deployment.v1.apps/web",
+		"/Deployment/web/staging/default/production/default",
+		"/Deployment.v1.apps/web/staging/default/staging/default",
+	}
+
+	for _, contentPath := range paths {
+		_, err := ParsePath(contentPath)
+		assert.Error(t, err, contentPath)
+	}
+}
+
+func TestTargets(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	staging := storeFake.NewMockStore(controller)
+	production := storeFake.NewMockStore(controller)
+	limited := storeFake.NewMockStore(controller)
+	broken := storeFake.NewMockStore(controller)
+	sources := NewSources(
+		&fakeSource{stores: map[string]store.Store{"staging": staging}},
+		&fakeSource{stores: map[string]store.Store{"production": production}},
+		&fakeSource{stores: map[string]store.Store{"limited": limited}},
+		&fakeSource{stores: map[string]store.Store{"broken": broken}},
+	)
+
+	listKey := store.Key{APIVersion: "v1", Kind: "Pod"}
+	staging.EXPECT().List(gomock.Any(), listKey).Return(testutil.ToUnstructuredList(t,
+		podIn("web", "default"),
+		podIn("web", "team-a"),
+		podIn("db", "default"),
+	), false, nil)
+	production.EXPECT().List(gomock.Any(), listKey).Return(testutil.ToUnstructuredList(t,
+		podIn("web", "default"),
+	), false, nil)
+	namespaceKey := store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default"}
+	limited.EXPECT().List(gomock.Any(), listKey).Return(nil, false, fmt.Errorf("forbidden"))
+	limited.EXPECT().List(gomock.Any(), namespaceKey).Return(testutil.ToUnstructuredList(t,
+		podIn("web", "default"),
+	), false, nil)
+	broken.EXPECT().List(gomock.Any(), listKey).Return(nil, false, fmt.Errorf("forbidden"))
+	broken.EXPECT().List(gomock.Any(), namespaceKey).Return(nil, false, fmt.Errorf("forbidden"))
+
+	key := store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "web"}
+	got, failed := Targets(context.Background(), sources, key, Target{Context: "staging", Namespace: "default"})
+	assert.Equal(t, []string{"broken"}, failed, "contexts which can't be listed are skipped")
+
+	expected := []Target{
+		{Context: "limited", Namespace: "default"},
+		{Context: "production", Namespace: "default"},
+		{Context: "staging", Namespace: "team-a"},
+	}
+	assert.Equal(t, expected, got, "contexts which can't be listed in all namespaces are searched in the object's namespace")
+}
+
+func podIn(name, namespace string) *corev1.Pod {
+	pod := testutil.CreatePod(name)
+	pod.Namespace = namespace
+	return pod
+}
+
+type fakeSource struct {
+	stores map[string]store.Store
+}
+
+func (s *fakeSource) ContextNames() []string {
+	var names []string
+	for name := range s.stores {
+		names = append(names, name)
+	}
+	return names
+}
+
+func (s *fakeSource) ObjectStore(contextName string) (store.Store, bool) {
+	objectStore, ok := s.stores[contextName]
+	return objectStore, ok
+}
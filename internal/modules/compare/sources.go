@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package compare
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// Source looks up the object stores of kube config contexts.
+type Source interface {
+	// ContextNames returns the names of the contexts in the source.
+	ContextNames() []string
+	// ObjectStore returns the object store of a context.
+	ObjectStore(contextName string) (store.Store, bool)
+}
+
+// Sources are the sources objects can be compared across. Earlier sources take
+// precedence when several have a context with the same name.
+type Sources struct {
+	mu      sync.RWMutex
+	sources []Source
+}
+
+// NewSources creates an instance of Sources.
+func NewSources(sources ...Source) *Sources {
+	return &Sources{sources: sources}
+}
+
+// Set replaces the sources.
+func (s *Sources) Set(sources ...Source) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sources = sources
+}
+
+// ContextNames returns the sorted names of the contexts in all sources.
+func (s *Sources) ContextNames() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	seen := map[string]bool{}
+	var names []string
+	for _, source := range s.sources {
+		for _, name := range source.ContextNames() {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ObjectStore returns the object store of a context.
+func (s *Sources) ObjectStore(contextName string) (store.Store, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, source := range s.sources {
+		if objectStore, ok := source.ObjectStore(contextName); ok {
+			return objectStore, true
+		}
+	}
+	return nil, false
+}
+
+// CurrentContext returns a source for the current context of dashConfig.
+func CurrentContext(dashConfig config.Dash) Source {
+	return &currentContext{dashConfig: dashConfig}
+}
+
+type currentContext struct {
+	dashConfig config.Dash
+}
+
+func (c *currentContext) ContextNames() []string {
+	return []string{c.dashConfig.CurrentContext()}
+}
+
+func (c *currentContext) ObjectStore(contextName string) (store.Store, bool) {
+	if contextName != c.dashConfig.CurrentContext() {
+		return nil, false
+	}
+	return c.dashConfig.ObjectStore(), true
+}
+
+type sourcesKey struct{}
+
+// WithSources returns a context where objects can be compared across sources.
+func WithSources(ctx context.Context, sources *Sources) context.Context {
+	return context.WithValue(ctx, sourcesKey{}, sources)
+}
+
+// SourcesFrom returns the sources objects can be compared across. Nil is
+// returned if objects can't be compared.
+func SourcesFrom(ctx context.Context) *Sources {
+	sources, _ := ctx.Value(sourcesKey{}).(*Sources)
+	return sources
+}
@@ -0,0 +1,134 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/vmware-tanzu/octant/internal/audit"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// AuditDescriber describes the audit log.
+type AuditDescriber struct {
+	log *audit.Log
+}
+
+var _ describer.Describer = (*AuditDescriber)(nil)
+
+// NewAuditDescriber creates an instance of AuditDescriber.
+func NewAuditDescriber(log *audit.Log) *AuditDescriber {
+	return &AuditDescriber{log: log}
+}
+
+// Describe describes the audit log. Entries can be filtered by user, action,
+// namespace and result.
+func (d *AuditDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	title := append([]component.TitleComponent{}, component.NewText("Audit"))
+	list := component.NewList(title, nil)
+
+	tableCols := component.NewTableCols("Time", "User", "Action", "Context", "Namespace", "Object", "Result", "Client", "Payload Digest")
+	tbl := component.NewTable("Audit", "No actions have been recorded", tableCols)
+
+	filters := map[string]map[string]bool{
+		"User":      {},
+		"Action":    {},
+		"Namespace": {},
+		"Result":    {},
+	}
+
+	for _, entry := range d.log.Entries() {
+		result := "Succeeded"
+		if entry.Error != "" {
+			result = "Failed"
+		}
+
+		row := component.TableRow{
+			"Time":           component.NewTimestamp(entry.Time),
+			"User":           component.NewText(valueOrDash(entry.User)),
+			"Action":         component.NewText(entry.Action),
+			"Context":        component.NewText(valueOrDash(entry.Context)),
+			"Namespace":      component.NewText(valueOrDash(entry.Namespace)),
+			"Object":         objectComponent(options, entry),
+			"Result":         component.NewText(result),
+			"Client":         component.NewText(valueOrDash(entry.ClientID)),
+			"Payload Digest": component.NewText(entry.PayloadDigest),
+		}
+		if entry.Error != "" {
+			row["Result"] = component.NewText(fmt.Sprintf("%s: %s", result, entry.Error))
+		}
+		tbl.Add(row)
+
+		filters["User"][valueOrDash(entry.User)] = true
+		filters["Action"][entry.Action] = true
+		filters["Namespace"][valueOrDash(entry.Namespace)] = true
+		filters["Result"][result] = true
+	}
+
+	for name, values := range filters {
+		if len(values) < 2 {
+			continue
+		}
+		tbl.AddFilter(name, component.TableFilter{Values: sortedKeys(values), Selected: []string{}})
+	}
+
+	list.Add(tbl)
+
+	return component.ContentResponse{
+		Components: []component.Component{list},
+	}, nil
+}
+
+// PathFilters returns the path filters for the audit log.
+func (d *AuditDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/audit", d)
+	return []describer.PathFilter{*filter}
+}
+
+// Reset does nothing since the audit log has no cached state.
+func (d *AuditDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+// objectComponent links to the entry's object if it still has a page.
+func objectComponent(options describer.Options, entry audit.Entry) component.Component {
+	object := entry.Object
+	if object == nil {
+		return component.NewText("-")
+	}
+
+	name := object.Kind
+	if object.Name != "" {
+		name = fmt.Sprintf("%s/%s", object.Kind, object.Name)
+	}
+
+	if options.Dash != nil && object.Name != "" {
+		if ref, err := options.Dash.ObjectPath(entry.Namespace, object.APIVersion, object.Kind, object.Name); err == nil {
+			return component.NewLink("", name, ref)
+		}
+	}
+
+	return component.NewText(name)
+}
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func sortedKeys(m map[string]bool) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/audit"
+	"github.com/vmware-tanzu/octant/internal/auth"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestAuditDescriber(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	auditLog := audit.NewLog(log.NopLogger(), 10)
+	alice := auth.WithUser(context.Background(), auth.User{Name: "alice"})
+	auditLog.Record(alice, "action.octant.dev/deleteObject", action.Payload{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"name":       "web",
+		"namespace":  "default",
+	}, nil)
+	auditLog.Record(context.Background(), "action.octant.dev/cordon", action.Payload{}, fmt.Errorf("forbidden"))
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectPath("default", "v1", "Pod", "web").Return("/overview/namespace/default/workloads/pods/web", nil)
+
+	d := NewAuditDescriber(auditLog)
+	got, err := d.Describe(context.Background(), "", describer.Options{Dash: dashConfig})
+	require.NoError(t, err)
+
+	require.Len(t, got.Components, 1)
+	list, ok := got.Components[0].(*component.List)
+	require.True(t, ok)
+	require.Len(t, list.Config.Items, 1)
+	table, ok := list.Config.Items[0].(*component.Table)
+	require.True(t, ok)
+
+	rows := table.Rows()
+	require.Len(t, rows, 2)
+	assert.Equal(t, component.NewText("Failed: forbidden"), rows[0]["Result"], "newest entries are first")
+	assert.Equal(t, component.NewText("-"), rows[0]["User"])
+	assert.Equal(t, component.NewText("alice"), rows[1]["User"])
+	assert.Equal(t, component.NewLink("", "Pod/web", "/overview/namespace/default/workloads/pods/web"), rows[1]["Object"])
+
+	assert.Equal(t, []string{"Failed", "Succeeded"}, table.Config.Filters["Result"].Values)
+}
@@ -14,9 +14,11 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/audit"
 	"github.com/vmware-tanzu/octant/internal/config"
 	"github.com/vmware-tanzu/octant/internal/describer"
 	"github.com/vmware-tanzu/octant/internal/event"
+	"github.com/vmware-tanzu/octant/internal/kubeconfig"
 	"github.com/vmware-tanzu/octant/internal/module"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/pkg/action"
@@ -26,8 +28,12 @@ import (
 )
 
 type Options struct {
-	DashConfig     config.Dash
+	DashConfig config.Dash
+	// KubeConfigPath is the kubeconfig chain shown on the kubeconfig page.
+	// The page is hidden if it is blank.
 	KubeConfigPath string
+	// AuditLog is shown on the audit page. The page is hidden if it is nil.
+	AuditLog *audit.Log
 }
 
 type Configuration struct {
@@ -35,6 +41,7 @@ type Configuration struct {
 
 	pathMatcher          *describer.PathMatcher
 	kubeContextGenerator *event.ContextsGenerator
+	kubeConfigEditor     *kubeconfig.Editor
 }
 
 var _ module.Module = (*Configuration)(nil)
@@ -45,11 +52,25 @@ func New(ctx context.Context, options Options) *Configuration {
 	for _, pf := range rootDescriber.PathFilters() {
 		pm.Register(ctx, pf)
 	}
+	if options.AuditLog != nil {
+		for _, pf := range NewAuditDescriber(options.AuditLog).PathFilters() {
+			pm.Register(ctx, pf)
+		}
+	}
+
+	var kubeConfigEditor *kubeconfig.Editor
+	if options.KubeConfigPath != "" {
+		kubeConfigEditor = kubeconfig.NewEditor(options.KubeConfigPath)
+		for _, pf := range NewKubeConfigDescriber(kubeConfigEditor).PathFilters() {
+			pm.Register(ctx, pf)
+		}
+	}
 
 	return &Configuration{
 		Options:              options,
 		pathMatcher:          pm,
 		kubeContextGenerator: event.NewContextsGenerator(options.DashConfig),
+		kubeConfigEditor:     kubeConfigEditor,
 	}
 }
 
@@ -98,14 +119,34 @@ func (c *Configuration) ContentPath() string {
 }
 
 func (c *Configuration) Navigation(ctx context.Context, namespace, root string) ([]navigation.Navigation, error) {
-	return []navigation.Navigation{
+	navigations := []navigation.Navigation{
 		{
 			Module:   "Configuration",
 			Title:    "Plugins",
 			Path:     path.Join(c.ContentPath(), "plugins"),
 			IconName: icon.ConfigurationPlugin,
 		},
-	}, nil
+	}
+
+	if c.kubeConfigEditor != nil {
+		navigations = append(navigations, navigation.Navigation{
+			Module:   "Configuration",
+			Title:    "Kubeconfig",
+			Path:     path.Join(c.ContentPath(), "kubeconfig"),
+			IconName: icon.ConfigurationKubeConfig,
+		})
+	}
+
+	if c.AuditLog != nil {
+		navigations = append(navigations, navigation.Navigation{
+			Module:   "Configuration",
+			Title:    "Audit",
+			Path:     path.Join(c.ContentPath(), "audit"),
+			IconName: icon.ConfigurationAudit,
+		})
+	}
+
+	return navigations, nil
 }
 
 func (Configuration) SetNamespace(namespace string) error {
@@ -147,11 +188,22 @@ func (c Configuration) Generators() []octant.Generator {
 }
 
 func (c *Configuration) ActionPaths() map[string]action.DispatcherFunc {
-	objectDeleter := NewObjectDeleter(c.DashConfig.Logger(), c.DashConfig.ObjectStore())
+	dispatchers := action.Dispatchers{
+		NewObjectDeleter(c.DashConfig.Logger(), c.DashConfig.ObjectStore()),
+		NewObjectDeletePreviewer(c.DashConfig.Logger(), c.DashConfig.ObjectStore(), c.DashConfig.ClusterClient()),
+		NewObjectPinner(c.DashConfig.Logger(), c.DashConfig),
+		NewObjectUnpinner(c.DashConfig.Logger(), c.DashConfig),
+	}
 
-	return map[string]action.DispatcherFunc{
-		objectDeleter.ActionName(): objectDeleter.Handle,
+	if c.kubeConfigEditor != nil {
+		dispatchers = append(dispatchers,
+			NewKubeConfigImporter(c.DashConfig.Logger(), c.kubeConfigEditor),
+			NewKubeContextEditor(c.DashConfig.Logger(), c.kubeConfigEditor, c.DashConfig),
+			NewKubeContextDeleter(c.DashConfig.Logger(), c.kubeConfigEditor, c.DashConfig),
+		)
 	}
+
+	return dispatchers.ToActionPaths()
 }
 
 func (c *Configuration) GvkFromPath(contentPath, namespace string) (schema.GroupVersionKind, error) {
@@ -4,12 +4,26 @@ import (
 	"context"
 	"fmt"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
 	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/queryer"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/log"
 	"github.com/vmware-tanzu/octant/pkg/store"
 )
 
+// propagationPolicies are the policies users can choose from when deleting. The
+// first is the default.
+var propagationPolicies = []string{
+	string(metav1.DeletePropagationForeground),
+	string(metav1.DeletePropagationBackground),
+	string(metav1.DeletePropagationOrphan),
+}
+
 type ObjectDeleter struct {
 	logger log.Logger
 	store  store.Store
@@ -34,9 +48,14 @@ func (d *ObjectDeleter) Handle(ctx context.Context, alerter action.Alerter, payl
 		return err
 	}
 
+	policy, err := propagationPolicyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
 	alertType := action.AlertTypeInfo
 	message := fmt.Sprintf("Deleted %s %q", key.Kind, key.Name)
-	if err := d.store.Delete(ctx, key); err != nil {
+	if err := d.store.Delete(store.WithPropagationPolicy(ctx, policy), key); err != nil {
 		alertType = action.AlertTypeWarning
 		message = fmt.Sprintf("Unable to deleted %s %q: %s", key.Kind, key.Name, err)
 	}
@@ -45,3 +64,140 @@ func (d *ObjectDeleter) Handle(ctx context.Context, alerter action.Alerter, payl
 
 	return nil
 }
+
+// ObjectDeletePreviewer previews deleting an object. The delete is sent to the
+// API server as a dry run, and the object's dependents are listed since they
+// are deleted as well unless they are orphaned.
+type ObjectDeletePreviewer struct {
+	logger     log.Logger
+	store      store.Store
+	newQueryer func() (queryer.Queryer, error)
+}
+
+// NewObjectDeletePreviewer creates an instance of ObjectDeletePreviewer.
+func NewObjectDeletePreviewer(logger log.Logger, objectStore store.Store, clusterClient cluster.ClientInterface) *ObjectDeletePreviewer {
+	return &ObjectDeletePreviewer{
+		logger: logger.With("action", octant.ActionPreviewDeleteObject),
+		store:  objectStore,
+		newQueryer: func() (queryer.Queryer, error) {
+			discoveryClient, err := clusterClient.DiscoveryClient()
+			if err != nil {
+				return nil, err
+			}
+			return queryer.New(objectStore, discoveryClient), nil
+		},
+	}
+}
+
+// ActionName returns the name of this action.
+func (p *ObjectDeletePreviewer) ActionName() string {
+	return octant.ActionPreviewDeleteObject
+}
+
+// Handle sends a preview of deleting the object in payload.
+func (p *ObjectDeletePreviewer) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	p.logger.With("payload", payload).Debugf("previewing object delete")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	preview := action.Preview{
+		Title:               fmt.Sprintf("Delete %s %s", key.Kind, key.Name),
+		Confirm:             action.CreatePayload(octant.ActionDeleteObject, key.ToActionPayload()),
+		PropagationPolicies: propagationPolicies,
+	}
+
+	changes, err := p.changes(ctx, key)
+	if err != nil {
+		preview.Error = err.Error()
+	}
+	preview.Changes = changes
+
+	return action.SendPreview(alerter, preview)
+}
+
+func (p *ObjectDeletePreviewer) changes(ctx context.Context, key store.Key) ([]action.PreviewChange, error) {
+	object, err := p.store.Get(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("get %s %q: %w", key.Kind, key.Name, err)
+	}
+	if object == nil {
+		return nil, fmt.Errorf("%s %q was not found", key.Kind, key.Name)
+	}
+
+	if err := p.store.Delete(store.WithDryRun(ctx), key); err != nil {
+		return nil, err
+	}
+
+	changes := []action.PreviewChange{{Description: describeObject(object)}}
+
+	q, err := p.newQueryer()
+	if err != nil {
+		return changes, fmt.Errorf("create queryer: %w", err)
+	}
+
+	seen := map[types.UID]bool{object.GetUID(): true}
+	dependents, err := p.dependents(ctx, q, object, seen)
+	if err != nil {
+		return changes, fmt.Errorf("find dependents: %w", err)
+	}
+
+	return append(changes, dependents...), nil
+}
+
+// dependents returns the changes for deleting owner's children and their
+// children in turn.
+func (p *ObjectDeletePreviewer) dependents(ctx context.Context, q queryer.Queryer, owner *unstructured.Unstructured, seen map[types.UID]bool) ([]action.PreviewChange, error) {
+	children, err := q.Children(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []action.PreviewChange
+	for i := range children.Items {
+		child := &children.Items[i]
+		if seen[child.GetUID()] {
+			continue
+		}
+		seen[child.GetUID()] = true
+
+		changes = append(changes, action.PreviewChange{Description: describeObject(child), Dependent: true})
+
+		grandchildren, err := p.dependents(ctx, q, child, seen)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, grandchildren...)
+	}
+
+	return changes, nil
+}
+
+func describeObject(object *unstructured.Unstructured) string {
+	description := fmt.Sprintf("%s (%s) %s", object.GetKind(), object.GetAPIVersion(), object.GetName())
+	if namespace := object.GetNamespace(); namespace != "" {
+		description = fmt.Sprintf("%s in %s", description, namespace)
+	}
+	return description
+}
+
+func propagationPolicyFromPayload(payload action.Payload) (metav1.DeletionPropagation, error) {
+	policy, err := payload.OptionalString("propagationPolicy")
+	if err != nil {
+		return "", err
+	}
+
+	if policy == "" {
+		return metav1.DeletePropagationForeground, nil
+	}
+
+	for _, allowed := range propagationPolicies {
+		if policy == allowed {
+			return metav1.DeletionPropagation(policy), nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown propagation policy %q", policy)
+}
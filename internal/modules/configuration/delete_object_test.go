@@ -2,14 +2,19 @@ package configuration
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/queryer"
+	queryerFake "github.com/vmware-tanzu/octant/internal/queryer/fake"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
@@ -42,7 +47,10 @@ func TestObjectDeleter_Handle(t *testing.T) {
 
 	objectStore.EXPECT().
 		Delete(gomock.Any(), key).
-		Return(nil)
+		DoAndReturn(func(ctx context.Context, key store.Key) error {
+			assert.Equal(t, metav1.DeletePropagationOrphan, store.PropagationPolicy(ctx))
+			return nil
+		})
 
 	alerter.EXPECT().
 		SendAlert(gomock.Any()).
@@ -58,6 +66,89 @@ func TestObjectDeleter_Handle(t *testing.T) {
 
 	ctx := context.Background()
 
-	err = d.Handle(ctx, alerter, key.ToActionPayload())
+	payload := key.ToActionPayload()
+	payload["propagationPolicy"] = "Orphan"
+
+	err = d.Handle(ctx, alerter, payload)
+	require.NoError(t, err)
+}
+
+type previewRecorder struct {
+	previews []action.Preview
+}
+
+func (r *previewRecorder) SendAlert(alert action.Alert) {}
+
+func (r *previewRecorder) SendPreview(preview action.Preview) {
+	r.previews = append(r.previews, preview)
+}
+
+func TestObjectDeletePreviewer_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	deployment := testutil.ToUnstructured(t, testutil.CreateDeployment("web"))
+	replicaSet := testutil.ToUnstructured(t, testutil.CreateAppReplicaSet("web-1"))
+	pod := testutil.ToUnstructured(t, testutil.CreatePod("web-1-a"))
+
+	key, err := store.KeyFromObject(deployment)
 	require.NoError(t, err)
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(deployment, nil)
+	objectStore.EXPECT().
+		Delete(gomock.Any(), key).
+		DoAndReturn(func(ctx context.Context, key store.Key) error {
+			assert.True(t, store.DryRun(ctx), "the object is not deleted")
+			return nil
+		})
+
+	q := queryerFake.NewMockQueryer(controller)
+	q.EXPECT().Children(gomock.Any(), deployment).Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{*replicaSet}}, nil)
+	q.EXPECT().Children(gomock.Any(), replicaSet).Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{*pod}}, nil)
+	q.EXPECT().Children(gomock.Any(), pod).Return(&unstructured.UnstructuredList{}, nil)
+
+	p := NewObjectDeletePreviewer(log.NopLogger(), objectStore, nil)
+	p.newQueryer = func() (queryer.Queryer, error) {
+		return q, nil
+	}
+
+	alerter := &previewRecorder{}
+	require.NoError(t, p.Handle(context.Background(), alerter, key.ToActionPayload()))
+
+	expected := []action.Preview{
+		{
+			Title: "Delete Deployment web",
+			Changes: []action.PreviewChange{
+				{Description: "Deployment (apps/v1) web in namespace"},
+				{Description: "ReplicaSet (apps/v1) web-1 in namespace", Dependent: true},
+				{Description: "Pod (v1) web-1-a in namespace", Dependent: true},
+			},
+			Confirm:             action.CreatePayload(octant.ActionDeleteObject, key.ToActionPayload()),
+			PropagationPolicies: []string{"Foreground", "Background", "Orphan"},
+		},
+	}
+	assert.Equal(t, expected, alerter.previews)
+}
+
+func TestObjectDeletePreviewer_Handle_error(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	deployment := testutil.ToUnstructured(t, testutil.CreateDeployment("web"))
+	key, err := store.KeyFromObject(deployment)
+	require.NoError(t, err)
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(deployment, nil)
+	objectStore.EXPECT().Delete(gomock.Any(), key).Return(fmt.Errorf("forbidden"))
+
+	p := NewObjectDeletePreviewer(log.NopLogger(), objectStore, nil)
+
+	alerter := &previewRecorder{}
+	require.NoError(t, p.Handle(context.Background(), alerter, key.ToActionPayload()))
+
+	require.Len(t, alerter.previews, 1)
+	assert.Equal(t, "forbidden", alerter.previews[0].Error)
+	assert.Empty(t, alerter.previews[0].Changes)
 }
@@ -0,0 +1,243 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/kubeconfig"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/log"
+)
+
+// ContextSwitcher switches the current context. It is used when the current
+// context is renamed.
+type ContextSwitcher interface {
+	CurrentContext() string
+	UseContext(ctx context.Context, contextName string) error
+}
+
+// KubeConfigImporter imports a kubeconfig pasted by the user or read from a
+// file into the kubeconfig chain.
+type KubeConfigImporter struct {
+	logger log.Logger
+	editor *kubeconfig.Editor
+	// readFile reads kubeconfig files. It is replaced in tests.
+	readFile func(string) ([]byte, error)
+}
+
+var _ action.Dispatcher = (*KubeConfigImporter)(nil)
+
+// NewKubeConfigImporter creates an instance of KubeConfigImporter.
+func NewKubeConfigImporter(logger log.Logger, editor *kubeconfig.Editor) *KubeConfigImporter {
+	return &KubeConfigImporter{
+		logger:   logger.With("action", octant.ActionImportKubeConfig),
+		editor:   editor,
+		readFile: ioutil.ReadFile,
+	}
+}
+
+// ActionName returns the name of this action.
+func (i *KubeConfigImporter) ActionName() string {
+	return octant.ActionImportKubeConfig
+}
+
+// Handle imports the kubeconfig in payload.
+func (i *KubeConfigImporter) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	if !kubeConfigEditsAllowed(ctx) {
+		sendKubeConfigAlert(alerter, action.AlertTypeWarning, kubeConfigEditsDenied)
+		return nil
+	}
+
+	data, err := payload.OptionalString("kubeconfig")
+	if err != nil {
+		return err
+	}
+
+	path, err := payload.OptionalString("path")
+	if err != nil {
+		return err
+	}
+
+	// Checkboxes are submitted as a list of the checked values.
+	overwrite, _ := payload.StringSlice("overwrite")
+
+	if strings.TrimSpace(data) == "" {
+		if path == "" {
+			sendKubeConfigAlert(alerter, action.AlertTypeWarning, "Paste a kubeconfig or enter the path of a kubeconfig file to import")
+			return nil
+		}
+
+		b, err := i.readFile(path)
+		if err != nil {
+			sendKubeConfigAlert(alerter, action.AlertTypeWarning, fmt.Sprintf("Unable to read kubeconfig %s: %s", path, err))
+			return nil
+		}
+		data = string(b)
+	}
+
+	if err := i.editor.Import([]byte(data), len(overwrite) > 0); err != nil {
+		i.logger.WithErr(err).Errorf("import kubeconfig")
+		sendKubeConfigAlert(alerter, action.AlertTypeWarning, fmt.Sprintf("Unable to import kubeconfig: %s", err))
+		return nil
+	}
+
+	sendKubeConfigAlert(alerter, action.AlertTypeInfo, "Imported kubeconfig")
+	return nil
+}
+
+// kubeConfigEditsDenied is the alert sent when a kubeconfig change is refused.
+const kubeConfigEditsDenied = "Only the user running Octant can change its kubeconfig"
+
+// kubeConfigEditsAllowed returns true if the kubeconfig chain can be changed.
+// The chain holds the credentials of the user running Octant, so users who
+// sign in to Octant can't change it. They could otherwise read files on
+// Octant's host, add contexts whose credentials run commands or read files
+// there, or rewrite the operator's contexts.
+func kubeConfigEditsAllowed(ctx context.Context) bool {
+	if _, ok := auth.UserFrom(ctx); ok {
+		return false
+	}
+	_, impersonating := cluster.IdentityFrom(ctx)
+	return !impersonating
+}
+
+// KubeContextEditor renames a context or sets its default namespace.
+type KubeContextEditor struct {
+	logger   log.Logger
+	editor   *kubeconfig.Editor
+	switcher ContextSwitcher
+}
+
+var _ action.Dispatcher = (*KubeContextEditor)(nil)
+
+// NewKubeContextEditor creates an instance of KubeContextEditor.
+func NewKubeContextEditor(logger log.Logger, editor *kubeconfig.Editor, switcher ContextSwitcher) *KubeContextEditor {
+	return &KubeContextEditor{
+		logger:   logger.With("action", octant.ActionEditKubeContext),
+		editor:   editor,
+		switcher: switcher,
+	}
+}
+
+// ActionName returns the name of this action.
+func (e *KubeContextEditor) ActionName() string {
+	return octant.ActionEditKubeContext
+}
+
+// Handle edits the context in payload. The namespace is set before the
+// context is renamed. Blank fields are left unchanged.
+func (e *KubeContextEditor) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	if !kubeConfigEditsAllowed(ctx) {
+		sendKubeConfigAlert(alerter, action.AlertTypeWarning, kubeConfigEditsDenied)
+		return nil
+	}
+
+	contextName, err := payload.String("context")
+	if err != nil {
+		return err
+	}
+
+	newName, err := payload.OptionalString("newName")
+	if err != nil {
+		return err
+	}
+
+	namespace, err := payload.OptionalString("namespace")
+	if err != nil {
+		return err
+	}
+
+	if namespace != "" {
+		if err := e.editor.SetNamespace(contextName, namespace); err != nil {
+			e.logger.WithErr(err).Errorf("set context namespace")
+			sendKubeConfigAlert(alerter, action.AlertTypeWarning, fmt.Sprintf("Unable to set namespace of context %s: %s", contextName, err))
+			return nil
+		}
+	}
+
+	if newName != "" && newName != contextName {
+		if err := e.editor.RenameContext(contextName, newName); err != nil {
+			e.logger.WithErr(err).Errorf("rename context")
+			sendKubeConfigAlert(alerter, action.AlertTypeWarning, fmt.Sprintf("Unable to rename context %s: %s", contextName, err))
+			return nil
+		}
+
+		// The config watcher would reload the old name if the current
+		// context was chosen in the UI.
+		if e.switcher.CurrentContext() == contextName {
+			if err := e.switcher.UseContext(ctx, newName); err != nil {
+				e.logger.WithErr(err).Errorf("use renamed context")
+			}
+		}
+
+		contextName = newName
+	}
+
+	sendKubeConfigAlert(alerter, action.AlertTypeInfo, fmt.Sprintf("Updated context %s", contextName))
+	return nil
+}
+
+// KubeContextDeleter deletes a context.
+type KubeContextDeleter struct {
+	logger   log.Logger
+	editor   *kubeconfig.Editor
+	switcher ContextSwitcher
+}
+
+var _ action.Dispatcher = (*KubeContextDeleter)(nil)
+
+// NewKubeContextDeleter creates an instance of KubeContextDeleter.
+func NewKubeContextDeleter(logger log.Logger, editor *kubeconfig.Editor, switcher ContextSwitcher) *KubeContextDeleter {
+	return &KubeContextDeleter{
+		logger:   logger.With("action", octant.ActionDeleteKubeContext),
+		editor:   editor,
+		switcher: switcher,
+	}
+}
+
+// ActionName returns the name of this action.
+func (d *KubeContextDeleter) ActionName() string {
+	return octant.ActionDeleteKubeContext
+}
+
+// Handle deletes the context in payload. The current context can't be
+// deleted.
+func (d *KubeContextDeleter) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	if !kubeConfigEditsAllowed(ctx) {
+		sendKubeConfigAlert(alerter, action.AlertTypeWarning, kubeConfigEditsDenied)
+		return nil
+	}
+
+	contextName, err := payload.String("context")
+	if err != nil {
+		return err
+	}
+
+	if d.switcher.CurrentContext() == contextName {
+		sendKubeConfigAlert(alerter, action.AlertTypeWarning, fmt.Sprintf("Switch to another context before deleting context %s", contextName))
+		return nil
+	}
+
+	if err := d.editor.DeleteContext(contextName); err != nil {
+		d.logger.WithErr(err).Errorf("delete context")
+		sendKubeConfigAlert(alerter, action.AlertTypeWarning, fmt.Sprintf("Unable to delete context %s: %s", contextName, err))
+		return nil
+	}
+
+	sendKubeConfigAlert(alerter, action.AlertTypeInfo, fmt.Sprintf("Deleted context %s", contextName))
+	return nil
+}
+
+func sendKubeConfigAlert(alerter action.Alerter, alertType action.AlertType, message string) {
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+}
@@ -0,0 +1,162 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/kubeconfig"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+)
+
+func TestKubeConfigImporter_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	path := writeTestKubeConfig(t)
+	importer := NewKubeConfigImporter(log.NopLogger(), kubeconfig.NewEditor(path))
+	importer.readFile = func(name string) ([]byte, error) {
+		assert.Equal(t, "/home/user/prod.yaml", name)
+		return []byte(`apiVersion: v1
+kind: Config
+contexts:
+- name: prod
+  context:
+    cluster: prod
+`), nil
+	}
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().SendAlert(gomock.Any()).Do(func(alert action.Alert) {
+		assert.Equal(t, action.AlertTypeInfo, alert.Type)
+	})
+
+	payload := action.Payload{"kubeconfig": "", "path": "/home/user/prod.yaml", "overwrite": []interface{}{}}
+	require.NoError(t, importer.Handle(context.Background(), alerter, payload))
+
+	config, err := clientcmd.LoadFromFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, config.Contexts, "prod")
+}
+
+func TestKubeConfigActions_signedIn(t *testing.T) {
+	contexts := map[string]context.Context{
+		"user":     auth.WithUser(context.Background(), auth.User{Name: "alice"}),
+		"identity": cluster.WithIdentity(context.Background(), cluster.Identity{User: "alice"}),
+	}
+
+	for name, ctx := range contexts {
+		t.Run(name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			path := writeTestKubeConfig(t)
+			editor := kubeconfig.NewEditor(path)
+			dashConfig := configFake.NewMockDash(controller)
+
+			importer := NewKubeConfigImporter(log.NopLogger(), editor)
+			importer.readFile = func(name string) ([]byte, error) {
+				t.Fatalf("read %s", name)
+				return nil, nil
+			}
+
+			var messages []string
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().SendAlert(gomock.Any()).Do(func(alert action.Alert) {
+				assert.Equal(t, action.AlertTypeWarning, alert.Type)
+				messages = append(messages, alert.Message)
+			}).Times(4)
+
+			pasted := `apiVersion: v1
+kind: Config
+users:
+- name: operator
+  user:
+    tokenFile: /var/run/secrets/token
+`
+			require.NoError(t, importer.Handle(ctx, alerter, action.Payload{"kubeconfig": "", "path": "/etc/secret.yaml"}))
+			require.NoError(t, importer.Handle(ctx, alerter, action.Payload{"kubeconfig": pasted}))
+			require.NoError(t, NewKubeContextEditor(log.NopLogger(), editor, dashConfig).
+				Handle(ctx, alerter, action.Payload{"context": "dev", "newName": "development"}))
+			require.NoError(t, NewKubeContextDeleter(log.NopLogger(), editor, dashConfig).
+				Handle(ctx, alerter, action.Payload{"context": "staging"}))
+
+			for _, message := range messages {
+				assert.Equal(t, kubeConfigEditsDenied, message)
+			}
+
+			data, err := ioutil.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, testKubeConfig, string(data), "the kubeconfig is unchanged")
+		})
+	}
+}
+
+func TestKubeContextEditor_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	path := writeTestKubeConfig(t)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().CurrentContext().Return("dev")
+	dashConfig.EXPECT().UseContext(gomock.Any(), "development").Return(nil)
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().SendAlert(gomock.Any()).Do(func(alert action.Alert) {
+		assert.Equal(t, "Updated context development", alert.Message)
+	})
+
+	editor := NewKubeContextEditor(log.NopLogger(), kubeconfig.NewEditor(path), dashConfig)
+	payload := action.Payload{"context": "dev", "newName": "development", "namespace": "api"}
+	require.NoError(t, editor.Handle(context.Background(), alerter, payload))
+
+	config, err := clientcmd.LoadFromFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "development", config.CurrentContext)
+	assert.Equal(t, "api", config.Contexts["development"].Namespace)
+}
+
+func TestKubeContextDeleter_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	path := writeTestKubeConfig(t)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().CurrentContext().Return("dev").Times(2)
+
+	var alerts []action.Alert
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().SendAlert(gomock.Any()).Do(func(alert action.Alert) {
+		alerts = append(alerts, alert)
+	}).Times(2)
+
+	deleter := NewKubeContextDeleter(log.NopLogger(), kubeconfig.NewEditor(path), dashConfig)
+	require.NoError(t, deleter.Handle(context.Background(), alerter, action.Payload{"context": "dev"}))
+	require.NoError(t, deleter.Handle(context.Background(), alerter, action.Payload{"context": "staging"}))
+
+	require.Len(t, alerts, 2)
+	assert.Equal(t, action.AlertTypeWarning, alerts[0].Type, "the current context can't be deleted")
+	assert.Equal(t, action.AlertTypeInfo, alerts[1].Type)
+
+	config, err := clientcmd.LoadFromFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, config.Contexts, "dev")
+	assert.NotContains(t, config.Contexts, "staging")
+}
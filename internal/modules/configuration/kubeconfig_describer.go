@@ -0,0 +1,171 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/kubeconfig"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// KubeConfigDescriber describes the clusters, users and contexts in the
+// kubeconfig chain.
+type KubeConfigDescriber struct {
+	editor *kubeconfig.Editor
+}
+
+var _ describer.Describer = (*KubeConfigDescriber)(nil)
+
+// NewKubeConfigDescriber creates an instance of KubeConfigDescriber.
+func NewKubeConfigDescriber(editor *kubeconfig.Editor) *KubeConfigDescriber {
+	return &KubeConfigDescriber{editor: editor}
+}
+
+// Describe describes the kubeconfig chain. Forms to import kubeconfigs and
+// edit contexts are attached to the summary unless the chain can't be changed
+// by the user.
+func (d *KubeConfigDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	chain, err := d.editor.Load()
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	title := append([]component.TitleComponent{}, component.NewText("Kubeconfig"))
+	list := component.NewList(title, nil)
+
+	summary := component.NewSummary("Files")
+	for i, file := range chain.Files {
+		summary.AddSection(fmt.Sprintf("%d", i+1), component.NewText(file))
+	}
+	editable := kubeConfigEditsAllowed(ctx)
+	if editable {
+		summary.AddAction(importKubeConfigAction())
+		if len(chain.Contexts) > 0 {
+			summary.AddAction(editKubeContextAction(chain.Contexts))
+		}
+	}
+	list.Add(summary)
+
+	contextCols := component.NewTableCols("Name", "Cluster", "User", "Namespace", "File")
+	contexts := component.NewTable("Contexts", "There are no contexts", contextCols)
+	for _, entry := range chain.Contexts {
+		name := entry.Name
+		if entry.Current {
+			name = fmt.Sprintf("%s (current)", name)
+		}
+
+		row := component.TableRow{
+			"Name":      component.NewText(name),
+			"Cluster":   component.NewText(valueOrDash(entry.Cluster)),
+			"User":      component.NewText(valueOrDash(entry.AuthInfo)),
+			"Namespace": component.NewText(valueOrDash(entry.Namespace)),
+			"File":      component.NewText(entry.File),
+		}
+		if editable && !entry.Current {
+			row.AddAction(deleteKubeContextAction(entry.Name))
+		}
+		contexts.Add(row)
+	}
+	list.Add(contexts)
+
+	clusterCols := component.NewTableCols("Name", "Server", "File")
+	clusters := component.NewTable("Clusters", "There are no clusters", clusterCols)
+	for _, entry := range chain.Clusters {
+		clusters.Add(component.TableRow{
+			"Name":   component.NewText(entry.Name),
+			"Server": component.NewText(valueOrDash(entry.Server)),
+			"File":   component.NewText(entry.File),
+		})
+	}
+	list.Add(clusters)
+
+	userCols := component.NewTableCols("Name", "Authentication", "File")
+	users := component.NewTable("Users", "There are no users", userCols)
+	for _, entry := range chain.Users {
+		users.Add(component.TableRow{
+			"Name":           component.NewText(entry.Name),
+			"Authentication": component.NewText(entry.Auth),
+			"File":           component.NewText(entry.File),
+		})
+	}
+	list.Add(users)
+
+	return component.ContentResponse{
+		Components: []component.Component{list},
+	}, nil
+}
+
+// PathFilters returns the path filters for the kubeconfig page.
+func (d *KubeConfigDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/kubeconfig", d)
+	return []describer.PathFilter{*filter}
+}
+
+// Reset does nothing since the kubeconfig is read for each describe.
+func (d *KubeConfigDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+func importKubeConfigAction() component.Action {
+	return component.Action{
+		Name:  "Import",
+		Title: "Import Kubeconfig",
+		Form: component.Form{
+			Fields: []component.FormField{
+				component.NewFormFieldTextarea("Kubeconfig YAML", "kubeconfig", ""),
+				component.NewFormFieldText("Or kubeconfig file", "path", ""),
+				component.NewFormFieldCheckBox("Existing entries", "overwrite", []component.InputChoice{
+					{Label: "Replace entries with the same name", Value: "true"},
+				}),
+				component.NewFormFieldHidden("action", octant.ActionImportKubeConfig),
+			},
+		},
+	}
+}
+
+func editKubeContextAction(contexts []kubeconfig.ContextEntry) component.Action {
+	var choices []component.InputChoice
+	for i, entry := range contexts {
+		choices = append(choices, component.InputChoice{
+			Label:   entry.Name,
+			Value:   entry.Name,
+			Checked: i == 0,
+		})
+	}
+
+	return component.Action{
+		Name:  "Edit Context",
+		Title: "Edit Context",
+		Form: component.Form{
+			Fields: []component.FormField{
+				component.NewFormFieldSelect("Context", "context", choices, false),
+				component.NewFormFieldText("New name", "newName", ""),
+				component.NewFormFieldText("Default namespace", "namespace", ""),
+				component.NewFormFieldHidden("action", octant.ActionEditKubeContext),
+			},
+		},
+	}
+}
+
+func deleteKubeContextAction(contextName string) component.GridAction {
+	return component.GridAction{
+		Name:       "Delete",
+		ActionPath: octant.ActionDeleteKubeContext,
+		Payload:    action.Payload{"context": contextName},
+		Confirmation: &component.Confirmation{
+			Title: "Delete Context",
+			Body: fmt.Sprintf("Are you sure you want to delete context **%s**? Its cluster and user are kept.",
+				strings.ReplaceAll(contextName, "*", "\\*")),
+		},
+		Type: component.GridActionDanger,
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/kubeconfig"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const testKubeConfig = `apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: dev
+  cluster:
+    server: https://dev:6443
+users:
+- name: developer
+  user:
+    token: secret
+contexts:
+- name: dev
+  context:
+    cluster: dev
+    user: developer
+    namespace: web
+- name: staging
+  context:
+    cluster: dev
+    user: developer
+`
+
+func writeTestKubeConfig(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, ioutil.WriteFile(path, []byte(testKubeConfig), 0600))
+	return path
+}
+
+func TestKubeConfigDescriber(t *testing.T) {
+	path := writeTestKubeConfig(t)
+
+	d := NewKubeConfigDescriber(kubeconfig.NewEditor(path))
+	got, err := d.Describe(context.Background(), "", describer.Options{})
+	require.NoError(t, err)
+
+	require.Len(t, got.Components, 1)
+	list, ok := got.Components[0].(*component.List)
+	require.True(t, ok)
+	require.Len(t, list.Config.Items, 4)
+
+	summary, ok := list.Config.Items[0].(*component.Summary)
+	require.True(t, ok)
+	assert.Equal(t, component.NewText(path), summary.Config.Sections[0].Content)
+	require.Len(t, summary.Config.Actions, 2)
+	assert.Equal(t, "Import", summary.Config.Actions[0].Name)
+	assert.Equal(t, "Edit Context", summary.Config.Actions[1].Name)
+
+	contexts, ok := list.Config.Items[1].(*component.Table)
+	require.True(t, ok)
+	rows := contexts.Rows()
+	require.Len(t, rows, 2)
+	assert.Equal(t, component.NewText("dev (current)"), rows[0]["Name"])
+	assert.Equal(t, component.NewText("web"), rows[0]["Namespace"])
+	assert.NotContains(t, rows[0], component.GridActionKey, "the current context can't be deleted")
+
+	actions, ok := rows[1][component.GridActionKey].(*component.GridActions)
+	require.True(t, ok)
+	require.Len(t, actions.Config.Actions, 1)
+	assert.Equal(t, octant.ActionDeleteKubeContext, actions.Config.Actions[0].ActionPath)
+	assert.Equal(t, "staging", actions.Config.Actions[0].Payload["context"])
+
+	users, ok := list.Config.Items[3].(*component.Table)
+	require.True(t, ok)
+	require.Len(t, users.Rows(), 1)
+	assert.Equal(t, component.NewText("token"), users.Rows()[0]["Authentication"], "credentials aren't shown")
+}
+
+func TestKubeConfigDescriber_signedIn(t *testing.T) {
+	d := NewKubeConfigDescriber(kubeconfig.NewEditor(writeTestKubeConfig(t)))
+	ctx := auth.WithUser(context.Background(), auth.User{Name: "alice"})
+	got, err := d.Describe(ctx, "", describer.Options{})
+	require.NoError(t, err)
+
+	list := got.Components[0].(*component.List)
+	summary := list.Config.Items[0].(*component.Summary)
+	assert.Empty(t, summary.Config.Actions, "signed-in users can't change the kubeconfig")
+
+	contexts := list.Config.Items[1].(*component.Table)
+	for _, row := range contexts.Rows() {
+		assert.NotContains(t, row, component.GridActionKey)
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// PreferenceStorage stores preferences for the current context.
+type PreferenceStorage interface {
+	octant.Storage
+	CurrentContext() string
+}
+
+// ObjectPinner pins or unpins an object in the current context's
+// preferences.
+type ObjectPinner struct {
+	logger  log.Logger
+	storage PreferenceStorage
+	pin     bool
+}
+
+var _ action.Dispatcher = (*ObjectPinner)(nil)
+
+// NewObjectPinner creates an instance of ObjectPinner which pins objects.
+func NewObjectPinner(logger log.Logger, storage PreferenceStorage) *ObjectPinner {
+	return &ObjectPinner{
+		logger:  logger.With("action", octant.ActionPinObject),
+		storage: storage,
+		pin:     true,
+	}
+}
+
+// NewObjectUnpinner creates an instance of ObjectPinner which unpins objects.
+func NewObjectUnpinner(logger log.Logger, storage PreferenceStorage) *ObjectPinner {
+	return &ObjectPinner{
+		logger:  logger.With("action", octant.ActionUnpinObject),
+		storage: storage,
+	}
+}
+
+// ActionName returns the name of this action.
+func (p *ObjectPinner) ActionName() string {
+	if p.pin {
+		return octant.ActionPinObject
+	}
+	return octant.ActionUnpinObject
+}
+
+// Handle pins or unpins the object in payload.
+func (p *ObjectPinner) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	ref := preferences.ObjectReferenceFromKey(key)
+
+	err = p.storage.Preferences().Update(p.storage.CurrentContext(), func(prefs *preferences.Context) {
+		if p.pin {
+			prefs.Pin(ref)
+		} else {
+			prefs.Unpin(ref)
+		}
+	})
+	if err != nil {
+		p.logger.WithErr(err).Errorf("save pinned objects")
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning,
+			fmt.Sprintf("Unable to save pinned objects: %s", err), action.DefaultAlertExpiration))
+		return nil
+	}
+
+	message := fmt.Sprintf("Pinned %s %q", key.Kind, key.Name)
+	if !p.pin {
+		message = fmt.Sprintf("Unpinned %s %q", key.Kind, key.Name)
+	}
+	alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+	return nil
+}
@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+)
+
+func TestObjectPinner_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	prefs, err := preferences.NewFileStore("")
+	require.NoError(t, err)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Preferences().Return(prefs).AnyTimes()
+	dashConfig.EXPECT().CurrentContext().Return("dev").AnyTimes()
+
+	var messages []string
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().SendAlert(gomock.Any()).Do(func(alert action.Alert) {
+		messages = append(messages, alert.Message)
+	}).Times(2)
+
+	payload := action.Payload{"apiVersion": "apps/v1", "kind": "Deployment", "namespace": "default", "name": "web"}
+	ref := preferences.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "web"}
+
+	pinner := NewObjectPinner(log.NopLogger(), dashConfig)
+	assert.Equal(t, octant.ActionPinObject, pinner.ActionName())
+	require.NoError(t, pinner.Handle(context.Background(), alerter, payload))
+	assert.Equal(t, []preferences.ObjectReference{ref}, prefs.Get("dev").PinnedObjects)
+
+	unpinner := NewObjectUnpinner(log.NopLogger(), dashConfig)
+	assert.Equal(t, octant.ActionUnpinObject, unpinner.ActionName())
+	require.NoError(t, unpinner.Handle(context.Background(), alerter, payload))
+	assert.Empty(t, prefs.Get("dev").PinnedObjects)
+
+	assert.Equal(t, []string{`Pinned Deployment "web"`, `Unpinned Deployment "web"`}, messages)
+}
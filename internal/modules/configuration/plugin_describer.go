@@ -14,11 +14,13 @@ import (
 
 	"github.com/vmware-tanzu/octant/internal/describer"
 	"github.com/vmware-tanzu/octant/pkg/plugin"
+	"github.com/vmware-tanzu/octant/pkg/plugin/install"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
 // PluginListDescriber describes a list of plugins
 type PluginListDescriber struct {
+	installedVersions func() (map[string]string, error)
 }
 
 var _ describer.Describer = (*PluginListDescriber)(nil)
@@ -28,12 +30,18 @@ func (d *PluginListDescriber) Describe(ctx context.Context, namespace string, op
 	pluginStore := options.PluginManager().Store()
 	title := append([]component.TitleComponent{}, component.NewText("Plugins"))
 	list := component.NewList(title, nil)
-	tableCols := component.NewTableCols("Name", "Description", "Capabilities")
+	tableCols := component.NewTableCols("Name", "Version", "Description", "Capabilities")
 	tbl := component.NewTable("Plugins", "There are no plugins!", tableCols)
 	list.Add(tbl)
 
+	versions, err := d.installedVersions()
+	if err != nil {
+		return component.EmptyContentResponse, fmt.Errorf("get installed plugin versions: %w", err)
+	}
+
 	for _, n := range pluginStore.ClientNames() {
 		var metadata *plugin.Metadata
+		pluginPath := n
 		if plugin.IsJavaScriptPlugin(n) {
 			jsPlugin, ok := pluginStore.GetJS(n)
 			if !ok {
@@ -46,6 +54,15 @@ func (d *PluginListDescriber) Describe(ctx context.Context, namespace string, op
 			if err != nil {
 				return component.EmptyContentResponse, fmt.Errorf("metadata is nil")
 			}
+			if cmd, err := pluginStore.GetCommand(n); err == nil {
+				pluginPath = cmd
+			}
+		}
+
+		// Plugins which weren't installed from a plugin index have no known version.
+		version, ok := versions[pluginPath]
+		if !ok {
+			version = "-"
 		}
 
 		var summaryItems []string
@@ -66,6 +83,7 @@ func (d *PluginListDescriber) Describe(ctx context.Context, namespace string, op
 			{name: "Printer Config", list: metadata.Capabilities.SupportsPrinterConfig},
 			{name: "Printer Items", list: metadata.Capabilities.SupportsPrinterItems},
 			{name: "Printer Status", list: metadata.Capabilities.SupportsPrinterStatus},
+			{name: "Related Objects", list: metadata.Capabilities.SupportsRelatedObjects},
 			{name: "Tab", list: metadata.Capabilities.SupportsTab},
 		}
 
@@ -86,6 +104,7 @@ func (d *PluginListDescriber) Describe(ctx context.Context, namespace string, op
 
 		row := component.TableRow{
 			"Name":         component.NewText(metadata.Name),
+			"Version":      component.NewText(version),
 			"Description":  component.NewText(metadata.Description),
 			"Capabilities": component.NewText(sb.String()),
 		}
@@ -109,7 +128,11 @@ func (d *PluginListDescriber) Reset(ctx context.Context) error {
 }
 
 func NewPluginListDescriber() *PluginListDescriber {
-	return &PluginListDescriber{}
+	return &PluginListDescriber{
+		installedVersions: func() (map[string]string, error) {
+			return install.InstalledVersions(plugin.DefaultConfig)
+		},
+	}
 }
 
 func summarizeSupports(name string, list []schema.GroupVersionKind) (string, bool) {
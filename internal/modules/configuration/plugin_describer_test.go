@@ -54,6 +54,9 @@ func TestPluginDescriber(t *testing.T) {
 	dashConfig.EXPECT().PluginManager().Return(pluginManager)
 
 	p := NewPluginListDescriber()
+	p.installedVersions = func() (map[string]string, error) {
+		return map[string]string{"cmd": "1.0.0"}, nil
+	}
 
 	options := describer.Options{
 		Dash: dashConfig,
@@ -66,10 +69,11 @@ func TestPluginDescriber(t *testing.T) {
 	capabilitiesData := "[Module], [Actions: action], [Object Status: v1 Pod], [Printer Config: v1 Pod], [Printer Items: v1 Pod], [Printer Status: v1 Pod], [Tab: v1 Pod]"
 
 	list := component.NewList(append([]component.TitleComponent{}, component.NewText("Plugins")), nil)
-	tableCols := component.NewTableCols("Name", "Description", "Capabilities")
+	tableCols := component.NewTableCols("Name", "Version", "Description", "Capabilities")
 	table := component.NewTable("Plugins", "There are no plugins!", tableCols)
 	table.Add(component.TableRow{
 		"Name":         component.NewText(name),
+		"Version":      component.NewText("1.0.0"),
 		"Description":  component.NewText("this is a test"),
 		"Capabilities": component.NewText(capabilitiesData),
 	})
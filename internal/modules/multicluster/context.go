@@ -0,0 +1,147 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package multicluster
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/module"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// kubeContext is a context with its own connection and modules. err is set if
+// the context could not be connected to.
+type kubeContext struct {
+	name          string
+	connection    *Connection
+	moduleManager *module.Manager
+	err           error
+}
+
+func connect(ctx context.Context, name string, options Options) (*kubeContext, error) {
+	connection, err := options.Connect(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleManager, err := module.NewManager(connection.ClusterClient, options.Namespace, discardActions{}, options.DashConfig.Logger().With("context", name))
+	if err != nil {
+		return nil, errors.Wrap(err, "create module manager")
+	}
+
+	kc := &kubeContext{
+		name:          name,
+		connection:    connection,
+		moduleManager: moduleManager,
+	}
+
+	modules, err := options.Modules(ctx, &contextConfig{Dash: options.DashConfig, kubeContext: kc})
+	if err != nil {
+		kc.close()
+		return nil, errors.Wrap(err, "initialize modules")
+	}
+
+	for _, m := range modules {
+		if err := moduleManager.Register(m); err != nil {
+			kc.close()
+			return nil, errors.Wrapf(err, "loading module %s", m.Name())
+		}
+	}
+
+	if connection.CRDWatcher != nil {
+		if err := connection.CRDWatcher.Watch(ctx); err != nil {
+			kc.close()
+			return nil, errors.Wrap(err, "unable to start CRD watcher")
+		}
+	}
+
+	return kc, nil
+}
+
+func (kc *kubeContext) close() {
+	if kc.moduleManager != nil {
+		kc.moduleManager.Unload()
+	}
+	if kc.connection != nil && kc.connection.ClusterClient != nil {
+		kc.connection.ClusterClient.Close()
+	}
+}
+
+// contextConfig is the dash config for the modules of a context. Objects are
+// loaded from the context's connection and linked to within the context.
+type contextConfig struct {
+	config.Dash
+	kubeContext *kubeContext
+}
+
+var _ config.Dash = (*contextConfig)(nil)
+
+// ClusterClient returns the cluster client of the context.
+func (c *contextConfig) ClusterClient() cluster.ClientInterface {
+	return c.kubeContext.connection.ClusterClient
+}
+
+// ObjectStore returns the object store of the context.
+func (c *contextConfig) ObjectStore() store.Store {
+	return c.kubeContext.connection.ObjectStore
+}
+
+// CRDWatcher returns the CRD watcher of the context.
+func (c *contextConfig) CRDWatcher() config.CRDWatcher {
+	return c.kubeContext.connection.CRDWatcher
+}
+
+// ModuleManager returns the module manager of the context.
+func (c *contextConfig) ModuleManager() module.ManagerInterface {
+	return c.kubeContext.moduleManager
+}
+
+// ObjectPath returns the path of an object within the context.
+func (c *contextConfig) ObjectPath(namespace, apiVersion, kind, name string) (string, error) {
+	objectPath, err := c.kubeContext.moduleManager.ObjectPath(namespace, apiVersion, kind, name)
+	if err != nil || objectPath == "" {
+		return objectPath, err
+	}
+	return ContextPath(c.kubeContext.name, objectPath), nil
+}
+
+// CurrentContext returns the name of the context.
+func (c *contextConfig) CurrentContext() string {
+	return c.kubeContext.name
+}
+
+// DefaultNamespace returns the default namespace of the context.
+func (c *contextConfig) DefaultNamespace() string {
+	return c.ClusterClient().DefaultNamespace()
+}
+
+// UseFSContext returns an error since the context can't be changed.
+func (c *contextConfig) UseFSContext(ctx context.Context) error {
+	return c.UseContext(ctx, config.UseFSContext)
+}
+
+// UseContext returns an error since the context can't be changed.
+func (c *contextConfig) UseContext(ctx context.Context, contextName string) error {
+	return errors.Errorf("context %s can't be changed in multi-cluster mode", c.kubeContext.name)
+}
+
+// discardActions discards the actions of a context's modules. Actions are
+// dispatched to the current context only.
+type discardActions struct{}
+
+var _ module.ActionRegistrar = discardActions{}
+
+func (discardActions) Register(actionPath, pluginPath string, actionFunc action.DispatcherFunc) error {
+	return nil
+}
+
+func (discardActions) Unregister(actionPath, pluginPath string) {
+}
@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package multicluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+var podPhases = []corev1.PodPhase{
+	corev1.PodRunning,
+	corev1.PodPending,
+	corev1.PodSucceeded,
+	corev1.PodFailed,
+	corev1.PodUnknown,
+}
+
+// health summarises the objects cached for a context.
+type health struct {
+	loading    bool
+	nodes      int
+	readyNodes int
+	namespaces int
+	pods       map[corev1.PodPhase]int
+}
+
+// fleet summarises the health of contexts.
+func (mc *MultiCluster) fleet(ctx context.Context, contexts []*kubeContext) component.ContentResponse {
+	title := component.Title(component.NewText("Fleet"))
+	if len(contexts) == 1 {
+		title = component.Title(component.NewLink("", "Fleet", ContextPath("", "")), component.NewText(contexts[0].name))
+	}
+
+	cols := component.NewTableCols("Context", "Status", "Nodes", "Namespaces", "Pods")
+	table := component.NewTable("Contexts", "No contexts are connected", cols)
+
+	for _, kc := range contexts {
+		row := component.TableRow{
+			"Context":    component.NewLink("", kc.name, ContextPath(kc.name, "")),
+			"Nodes":      component.NewText("-"),
+			"Namespaces": component.NewText("-"),
+			"Pods":       component.NewText("-"),
+		}
+
+		err := kc.err
+		var h health
+		if err == nil {
+			h, err = summarize(ctx, kc.connection.ObjectStore)
+		}
+
+		switch {
+		case err != nil:
+			row["Status"] = component.NewText(fmt.Sprintf("Error: %v", err))
+		case h.loading:
+			row["Status"] = component.NewText("Loading")
+		default:
+			row["Status"] = component.NewText("Connected")
+			row["Nodes"] = component.NewText(fmt.Sprintf("%d/%d ready", h.readyNodes, h.nodes))
+			row["Namespaces"] = component.NewText(fmt.Sprintf("%d", h.namespaces))
+			row["Pods"] = component.NewText(describePods(h.pods))
+		}
+
+		table.Add(row)
+	}
+
+	return component.ContentResponse{
+		Title:      title,
+		Components: []component.Component{table},
+	}
+}
+
+// summarize summarises the nodes, namespaces and pods cached in objectStore.
+func summarize(ctx context.Context, objectStore store.Store) (health, error) {
+	h := health{pods: map[corev1.PodPhase]int{}}
+
+	nodes, loading, err := objectStore.List(ctx, store.Key{APIVersion: "v1", Kind: "Node"})
+	if err != nil {
+		return h, errors.Wrap(err, "list nodes")
+	}
+	h.loading = h.loading || loading
+	for i := range nodes.Items {
+		node := &corev1.Node{}
+		if err := kubernetes.FromUnstructured(&nodes.Items[i], node); err != nil {
+			return h, err
+		}
+		h.nodes++
+		if isNodeReady(node) {
+			h.readyNodes++
+		}
+	}
+
+	// Namespaces are only counted, so their metadata is enough.
+	namespaces, loading, err := objectStore.List(store.WithMetadataOnly(ctx), store.Key{APIVersion: "v1", Kind: "Namespace"})
+	if err != nil {
+		return h, errors.Wrap(err, "list namespaces")
+	}
+	h.loading = h.loading || loading
+	h.namespaces = len(namespaces.Items)
+
+	pods, loading, err := objectStore.List(ctx, store.Key{APIVersion: "v1", Kind: "Pod"})
+	if err != nil {
+		return h, errors.Wrap(err, "list pods")
+	}
+	h.loading = h.loading || loading
+	for i := range pods.Items {
+		pod := &corev1.Pod{}
+		if err := kubernetes.FromUnstructured(&pods.Items[i], pod); err != nil {
+			return h, err
+		}
+		h.pods[pod.Status.Phase]++
+	}
+
+	return h, nil
+}
+
+func isNodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// describePods describes the number of pods in each phase.
+func describePods(pods map[corev1.PodPhase]int) string {
+	var counts []string
+	for _, phase := range podPhases {
+		if pods[phase] > 0 {
+			counts = append(counts, fmt.Sprintf("%d %s", pods[phase], phase))
+		}
+	}
+	if len(counts) == 0 {
+		return "-"
+	}
+	return strings.Join(counts, ", ")
+}
@@ -0,0 +1,283 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package multicluster browses several kube config contexts at once. Each
+// context has its own cluster client, object store and modules, and its content
+// is served under /ctx/<context>/.
+package multicluster
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/module"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/readonly"
+	dashStrings "github.com/vmware-tanzu/octant/internal/util/strings"
+	"github.com/vmware-tanzu/octant/pkg/icon"
+	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// Name is the name of the module. It is also the root of its content paths.
+const Name = "ctx"
+
+// Connection is a connection to a kube config context.
+type Connection struct {
+	ClusterClient cluster.ClientInterface
+	ObjectStore   store.Store
+	CRDWatcher    config.CRDWatcher
+}
+
+// ConnectFunc connects to a kube config context.
+type ConnectFunc func(ctx context.Context, contextName string) (*Connection, error)
+
+// ModulesFunc creates the modules which are served for a context.
+type ModulesFunc func(ctx context.Context, dashConfig config.Dash) ([]module.Module, error)
+
+// Options are options for MultiCluster.
+type Options struct {
+	DashConfig config.Dash
+	Namespace  string
+	Contexts   []string
+	Connect    ConnectFunc
+	Modules    ModulesFunc
+}
+
+// MultiCluster is a module for browsing several contexts at once. Contexts are
+// browsed read-only since actions are dispatched to the current context.
+type MultiCluster struct {
+	Options
+
+	contexts []*kubeContext
+}
+
+var _ module.Module = (*MultiCluster)(nil)
+
+// New creates an instance of MultiCluster. Contexts which can't be connected to
+// are reported on the fleet page rather than failing the module.
+func New(ctx context.Context, options Options) (*MultiCluster, error) {
+	if options.Connect == nil || options.Modules == nil {
+		return nil, errors.New("connect and modules functions are required")
+	}
+
+	mc := &MultiCluster{Options: options}
+
+	names := dashStrings.Deduplicate(options.Contexts)
+	sort.Strings(names)
+
+	for _, name := range names {
+		if name == "" || strings.Contains(name, "/") {
+			return nil, errors.Errorf("invalid context name %q", name)
+		}
+
+		kc, err := connect(ctx, name, options)
+		if err != nil {
+			options.DashConfig.Logger().
+				With("context", name, "err", err).
+				Errorf("unable to connect to context")
+			kc = &kubeContext{name: name, err: err}
+		}
+		mc.contexts = append(mc.contexts, kc)
+	}
+
+	return mc, nil
+}
+
+// Name returns the name of the module.
+func (mc *MultiCluster) Name() string {
+	return Name
+}
+
+// Description returns the description of the module.
+func (mc *MultiCluster) Description() string {
+	return "Multi-cluster module is used to browse several contexts at once"
+}
+
+// ClientRequestHandlers returns nil.
+func (mc *MultiCluster) ClientRequestHandlers() []octant.ClientRequestHandler {
+	return nil
+}
+
+// Content generates content for a content path. The root path is the fleet
+// page. Other paths are served by the modules of the context they are scoped to.
+func (mc *MultiCluster) Content(ctx context.Context, contentPath string, opts module.ContentOptions) (component.ContentResponse, error) {
+	contextName, rest := splitContentPath(contentPath)
+	if contextName == "" {
+		return mc.fleet(ctx, mc.contexts), nil
+	}
+
+	kc, ok := mc.context(contextName)
+	if !ok {
+		return component.EmptyContentResponse, api.NewNotFoundError(contentPath)
+	}
+
+	if rest == "" || kc.err != nil {
+		return mc.fleet(ctx, []*kubeContext{kc}), nil
+	}
+
+	m, ok := kc.moduleManager.ModuleForContentPath(rest)
+	if !ok {
+		return component.EmptyContentResponse, api.NewNotFoundError(contentPath)
+	}
+
+	return m.Content(readonly.WithReadOnly(ctx), strings.TrimPrefix(rest, m.Name()), opts)
+}
+
+// ContentPath returns the root content path of the module.
+func (mc *MultiCluster) ContentPath() string {
+	return Name
+}
+
+// Navigation returns the fleet entry with an entry for each context. The
+// entries of a context's modules are nested below it.
+func (mc *MultiCluster) Navigation(ctx context.Context, namespace, root string) ([]navigation.Navigation, error) {
+	fleet := navigation.Navigation{
+		Title:    "Fleet",
+		Path:     root,
+		IconName: icon.Fleet,
+	}
+
+	for _, kc := range mc.contexts {
+		contextRoot := path.Join(root, kc.name)
+		entry := navigation.Navigation{
+			Title:    kc.name,
+			Path:     contextRoot,
+			IconName: icon.Cluster,
+		}
+
+		if kc.err == nil {
+			for _, m := range kc.moduleManager.Modules() {
+				children, err := m.Navigation(ctx, namespace, path.Join(contextRoot, m.ContentPath()))
+				if err != nil {
+					return nil, errors.Wrapf(err, "generate navigation for %s in context %s", m.Name(), kc.name)
+				}
+				entry.Children = append(entry.Children, children...)
+			}
+		}
+
+		fleet.Children = append(fleet.Children, entry)
+	}
+
+	return []navigation.Navigation{fleet}, nil
+}
+
+// SetNamespace sets the namespace of each context's modules.
+func (mc *MultiCluster) SetNamespace(namespace string) error {
+	for _, kc := range mc.contexts {
+		if kc.err == nil {
+			kc.moduleManager.SetNamespace(namespace)
+		}
+	}
+	return nil
+}
+
+// Start does nothing since contexts are connected when the module is created.
+func (mc *MultiCluster) Start() error {
+	return nil
+}
+
+// Stop stops the modules of each context and closes their cluster clients.
+func (mc *MultiCluster) Stop() {
+	for _, kc := range mc.contexts {
+		kc.close()
+	}
+}
+
+// SetContext does nothing since contexts are fixed when the module is created.
+func (mc *MultiCluster) SetContext(ctx context.Context, contextName string) error {
+	return nil
+}
+
+// Generators allow modules to send events to the frontend.
+func (mc *MultiCluster) Generators() []octant.Generator {
+	return []octant.Generator{}
+}
+
+// SupportedGroupVersionKind returns nil since objects are owned by the modules
+// of the current context.
+func (mc *MultiCluster) SupportedGroupVersionKind() []schema.GroupVersionKind {
+	return nil
+}
+
+// GroupVersionKindPath returns an error since objects are owned by the modules
+// of the current context.
+func (mc *MultiCluster) GroupVersionKindPath(namespace, apiVersion, kind, name string) (string, error) {
+	return "", errors.Errorf("multi-cluster module does not own %s %s", apiVersion, kind)
+}
+
+// GvkFromPath returns an error since paths are owned by each context's modules.
+func (mc *MultiCluster) GvkFromPath(contentPath, namespace string) (schema.GroupVersionKind, error) {
+	return schema.GroupVersionKind{}, errors.Errorf("multi-cluster module can't get GVK from path %s", contentPath)
+}
+
+// AddCRD does nothing since each context watches its own CRDs.
+func (mc *MultiCluster) AddCRD(ctx context.Context, crd *unstructured.Unstructured) error {
+	return nil
+}
+
+// RemoveCRD does nothing since each context watches its own CRDs.
+func (mc *MultiCluster) RemoveCRD(ctx context.Context, crd *unstructured.Unstructured) error {
+	return nil
+}
+
+// ResetCRDs does nothing since each context watches its own CRDs.
+func (mc *MultiCluster) ResetCRDs(ctx context.Context) error {
+	return nil
+}
+
+// ContextNames returns the names of the connected contexts.
+func (mc *MultiCluster) ContextNames() []string {
+	var names []string
+	for _, kc := range mc.contexts {
+		if kc.err == nil {
+			names = append(names, kc.name)
+		}
+	}
+	return names
+}
+
+// ObjectStore returns the object store of a connected context.
+func (mc *MultiCluster) ObjectStore(contextName string) (store.Store, bool) {
+	kc, ok := mc.context(contextName)
+	if !ok || kc.err != nil {
+		return nil, false
+	}
+	return kc.connection.ObjectStore, true
+}
+
+func (mc *MultiCluster) context(name string) (*kubeContext, bool) {
+	for _, kc := range mc.contexts {
+		if kc.name == name {
+			return kc, true
+		}
+	}
+	return nil, false
+}
+
+// ContextPath returns contentPath scoped to contextName.
+func ContextPath(contextName, contentPath string) string {
+	return path.Join("/", Name, contextName, contentPath)
+}
+
+// splitContentPath splits a module content path into a context name and the
+// content path within the context.
+func splitContentPath(contentPath string) (string, string) {
+	parts := strings.SplitN(strings.Trim(contentPath, "/"), "/", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
@@ -0,0 +1,226 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package multicluster
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/internal/config"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/module"
+	moduleFake "github.com/vmware-tanzu/octant/internal/module/fake"
+	"github.com/vmware-tanzu/octant/internal/readonly"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+type multiClusterMocks struct {
+	objectStore *storeFake.MockStore
+	module      *moduleFake.MockModule
+	dashConfigs []config.Dash
+}
+
+func newMultiCluster(t *testing.T, controller *gomock.Controller, contexts ...string) (*MultiCluster, *multiClusterMocks) {
+	mocks := &multiClusterMocks{
+		objectStore: storeFake.NewMockStore(controller),
+		module:      moduleFake.NewMockModule(controller),
+	}
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Logger().Return(log.NopLogger()).AnyTimes()
+
+	mocks.module.EXPECT().Name().Return("overview").AnyTimes()
+	mocks.module.EXPECT().ContentPath().Return("overview").AnyTimes()
+	mocks.module.EXPECT().Start().Return(nil).AnyTimes()
+
+	connect := func(ctx context.Context, contextName string) (*Connection, error) {
+		if contextName == "unreachable" {
+			return nil, fmt.Errorf("no route to host")
+		}
+		clusterClient := clusterFake.NewMockClientInterface(controller)
+		clusterClient.EXPECT().DefaultNamespace().Return("default").AnyTimes()
+		return &Connection{ClusterClient: clusterClient, ObjectStore: mocks.objectStore}, nil
+	}
+
+	modules := func(ctx context.Context, contextConfig config.Dash) ([]module.Module, error) {
+		mocks.dashConfigs = append(mocks.dashConfigs, contextConfig)
+		return []module.Module{mocks.module}, nil
+	}
+
+	mc, err := New(context.Background(), Options{
+		DashConfig: dashConfig,
+		Namespace:  "default",
+		Contexts:   contexts,
+		Connect:    connect,
+		Modules:    modules,
+	})
+	require.NoError(t, err)
+
+	return mc, mocks
+}
+
+func TestMultiCluster_Content(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mc, mocks := newMultiCluster(t, controller, "staging")
+
+	expected := component.ContentResponse{Title: component.TitleFromString("Pods")}
+	mocks.module.EXPECT().
+		Content(gomock.Any(), "/namespace/default/workloads/pods", gomock.Any()).
+		DoAndReturn(func(ctx context.Context, contentPath string, opts module.ContentOptions) (component.ContentResponse, error) {
+			assert.True(t, readonly.Enabled(ctx), "contexts are browsed read-only")
+			return expected, nil
+		})
+
+	got, err := mc.Content(context.Background(), "/staging/overview/namespace/default/workloads/pods", module.ContentOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, expected, got)
+
+	_, err = mc.Content(context.Background(), "/production/overview", module.ContentOptions{})
+	assert.Error(t, err)
+
+	_, err = mc.Content(context.Background(), "/staging/workloads", module.ContentOptions{})
+	assert.Error(t, err)
+}
+
+func TestMultiCluster_Content_fleet(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mc, mocks := newMultiCluster(t, controller, "unreachable", "staging")
+
+	ready := testutil.CreateNode("ready")
+	ready.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}
+	notReady := testutil.CreateNode("not-ready")
+	running := testutil.CreatePod("running")
+	running.Status.Phase = corev1.PodRunning
+	pending := testutil.CreatePod("pending")
+	pending.Status.Phase = corev1.PodPending
+
+	lists := map[string]store.Key{
+		"Node":      {APIVersion: "v1", Kind: "Node"},
+		"Namespace": {APIVersion: "v1", Kind: "Namespace"},
+		"Pod":       {APIVersion: "v1", Kind: "Pod"},
+	}
+	mocks.objectStore.EXPECT().List(gomock.Any(), lists["Node"]).
+		Return(testutil.ToUnstructuredList(t, ready, notReady), false, nil)
+	mocks.objectStore.EXPECT().List(gomock.Any(), lists["Namespace"]).
+		Return(testutil.ToUnstructuredList(t, testutil.CreateNamespace("default")), false, nil)
+	mocks.objectStore.EXPECT().List(gomock.Any(), lists["Pod"]).
+		Return(testutil.ToUnstructuredList(t, running, pending), false, nil)
+
+	got, err := mc.Content(context.Background(), "", module.ContentOptions{})
+	require.NoError(t, err)
+
+	require.Len(t, got.Components, 1)
+	table, ok := got.Components[0].(*component.Table)
+	require.True(t, ok)
+
+	rows := table.Rows()
+	require.Len(t, rows, 2)
+
+	assert.Equal(t, component.NewLink("", "staging", "/ctx/staging"), rows[0]["Context"])
+	assert.Equal(t, component.NewText("Connected"), rows[0]["Status"])
+	assert.Equal(t, component.NewText("1/2 ready"), rows[0]["Nodes"])
+	assert.Equal(t, component.NewText("1"), rows[0]["Namespaces"])
+	assert.Equal(t, component.NewText("1 Running, 1 Pending"), rows[0]["Pods"])
+
+	assert.Equal(t, component.NewLink("", "unreachable", "/ctx/unreachable"), rows[1]["Context"])
+	assert.Equal(t, component.NewText("Error: no route to host"), rows[1]["Status"])
+	assert.Equal(t, component.NewText("-"), rows[1]["Nodes"])
+}
+
+func TestMultiCluster_Navigation(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mc, mocks := newMultiCluster(t, controller, "staging", "unreachable")
+
+	overview := navigation.Navigation{Title: "Overview", Path: "ctx/staging/overview/namespace/default"}
+	mocks.module.EXPECT().
+		Navigation(gomock.Any(), "default", "ctx/staging/overview").
+		Return([]navigation.Navigation{overview}, nil)
+
+	got, err := mc.Navigation(context.Background(), "default", "ctx")
+	require.NoError(t, err)
+
+	expected := []navigation.Navigation{
+		{
+			Title:    "Fleet",
+			Path:     "ctx",
+			IconName: "world",
+			Children: []navigation.Navigation{
+				{
+					Title:    "staging",
+					Path:     "ctx/staging",
+					IconName: "cluster",
+					Children: []navigation.Navigation{overview},
+				},
+				{
+					Title:    "unreachable",
+					Path:     "ctx/unreachable",
+					IconName: "cluster",
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestContextConfig(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	_, mocks := newMultiCluster(t, controller, "staging")
+	require.Len(t, mocks.dashConfigs, 1)
+	contextConfig := mocks.dashConfigs[0]
+
+	gvk := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	mocks.module.EXPECT().SupportedGroupVersionKind().Return([]schema.GroupVersionKind{gvk}).AnyTimes()
+	mocks.module.EXPECT().
+		GroupVersionKindPath("default", "v1", "Pod", "web").
+		Return("/overview/namespace/default/workloads/pods/web", nil)
+
+	got, err := contextConfig.ObjectPath("default", "v1", "Pod", "web")
+	require.NoError(t, err)
+	assert.Equal(t, "/ctx/staging/overview/namespace/default/workloads/pods/web", got)
+
+	got, err = contextConfig.ObjectPath("default", "v1", "ConfigMap", "settings")
+	require.NoError(t, err)
+	assert.Empty(t, got, "objects without a page are not linked")
+
+	assert.Equal(t, "staging", contextConfig.CurrentContext())
+	assert.Equal(t, "default", contextConfig.DefaultNamespace())
+	assert.Equal(t, mocks.objectStore, contextConfig.ObjectStore())
+	assert.Error(t, contextConfig.UseContext(context.Background(), "production"))
+}
+
+func TestNew_invalidContext(t *testing.T) {
+	_, err := New(context.Background(), Options{
+		Contexts: []string{"a/b"},
+		Connect: func(ctx context.Context, contextName string) (*Connection, error) {
+			return nil, fmt.Errorf("unexpected")
+		},
+		Modules: func(ctx context.Context, dashConfig config.Dash) ([]module.Module, error) {
+			return nil, nil
+		},
+	})
+	assert.Error(t, err)
+}
@@ -15,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/vmware-tanzu/octant/internal/cluster"
 	"github.com/vmware-tanzu/octant/internal/config"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/store"
@@ -158,7 +159,12 @@ func (s *logStreamer) Close(logCh chan<- LogEntry) {
 }
 
 func (s *logStreamer) containerStream(container string) (io.ReadCloser, error) {
-	client, err := s.config.ClusterClient().KubernetesClient()
+	clusterClient, err := cluster.ClientFor(s.ctx, s.config.ClusterClient())
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clusterClient.KubernetesClient()
 	if err != nil {
 		return nil, err
 	}
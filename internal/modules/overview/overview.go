@@ -283,7 +283,9 @@ func (co *Overview) Stop() {
 func (co *Overview) Content(ctx context.Context, contentPath string, opts module.ContentOptions) (component.ContentResponse, error) {
 	ctx = internalLog.WithLoggerContext(ctx, co.dashConfig.Logger())
 	genOpts := generator.Options{
-		LabelSet: opts.LabelSet,
+		LabelSet:     opts.LabelSet,
+		ListContinue: opts.ListContinue,
+		Namespaces:   opts.Namespaces,
 	}
 	return co.generator.Generate(ctx, contentPath, genOpts)
 }
@@ -303,6 +305,7 @@ func (co *Overview) ActionPaths() map[string]action.DispatcherFunc {
 		octant.NewCronJobResume(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
 		octant.NewObjectUpdaterDispatcher(co.dashConfig.ObjectStore()),
 		octant.NewApplyYaml(co.logger, co.dashConfig.ObjectStore()),
+		octant.NewApplyYamlPreviewer(co.logger, co.dashConfig.ObjectStore()),
 	}
 
 	return dispatchers.ToActionPaths()
@@ -8,7 +8,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/cluster"
 	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/readonly"
 	"github.com/vmware-tanzu/octant/pkg/log"
 )
 
@@ -37,7 +39,16 @@ func (e *EphemeralContainerGenerator) UpdateObject(ctx context.Context, object r
 		return errors.New("object is nil")
 	}
 
-	client, err := e.dashConfig.ClusterClient().KubernetesClient()
+	if readonly.Enabled(ctx) {
+		return readonly.ErrReadOnly
+	}
+
+	clusterClient, err := cluster.ClientFor(ctx, e.dashConfig.ClusterClient())
+	if err != nil {
+		return err
+	}
+
+	client, err := clusterClient.KubernetesClient()
 	if err != nil {
 		return err
 	}
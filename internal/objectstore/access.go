@@ -0,0 +1,138 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	oerrors "github.com/vmware-tanzu/octant/internal/errors"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// accessTTL is how long an access review result is reused.
+const accessTTL = 30 * time.Second
+
+type accessKey struct {
+	identity  string
+	verb      string
+	gvr       schema.GroupVersionResource
+	namespace string
+	name      string
+}
+
+type accessResult struct {
+	allowed bool
+	reason  string
+	expires time.Time
+}
+
+// accessCache caches access reviews for identities. Informers are shared by all
+// identities, so reads are checked against the identity before objects are returned.
+type accessCache struct {
+	mu      sync.Mutex
+	results map[accessKey]accessResult
+	now     func() time.Time
+}
+
+func newAccessCache() *accessCache {
+	return &accessCache{
+		results: map[accessKey]accessResult{},
+		now:     time.Now,
+	}
+}
+
+// check returns an access error if the identity in ctx can't perform verb on the
+// objects for key. Contexts without an identity are not checked.
+func (c *accessCache) check(ctx context.Context, client cluster.ClientInterface, key store.Key, gvr schema.GroupVersionResource, verb string) error {
+	identity, ok := cluster.IdentityFrom(ctx)
+	if !ok {
+		return nil
+	}
+
+	ak := accessKey{
+		identity:  identity.String(),
+		verb:      verb,
+		gvr:       gvr,
+		namespace: key.Namespace,
+	}
+	if verb == "get" {
+		ak.name = key.Name
+	}
+
+	c.mu.Lock()
+	result, ok := c.results[ak]
+	c.mu.Unlock()
+
+	if !ok || c.now().After(result.expires) {
+		var err error
+		result, err = c.review(ctx, client, ak)
+		if err != nil {
+			return oerrors.NewAccessError(key, verb, err)
+		}
+
+		c.mu.Lock()
+		c.results[ak] = result
+		c.mu.Unlock()
+	}
+
+	if !result.allowed {
+		return oerrors.NewAccessError(key, verb, fmt.Errorf("%s is not allowed to %s %s: %s",
+			identity.User, verb, gvr.GroupResource(), result.reason))
+	}
+
+	return nil
+}
+
+func (c *accessCache) review(ctx context.Context, client cluster.ClientInterface, ak accessKey) (accessResult, error) {
+	impersonated, err := cluster.ClientFor(ctx, client)
+	if err != nil {
+		return accessResult{}, err
+	}
+
+	kubernetesClient, err := impersonated.KubernetesClient()
+	if err != nil {
+		return accessResult{}, err
+	}
+
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: ak.namespace,
+				Verb:      ak.verb,
+				Group:     ak.gvr.Group,
+				Resource:  ak.gvr.Resource,
+				Name:      ak.name,
+			},
+		},
+	}
+
+	response, err := kubernetesClient.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return accessResult{}, fmt.Errorf("review access: %w", err)
+	}
+
+	return accessResult{
+		allowed: response.Status.Allowed,
+		reason:  response.Status.Reason,
+		expires: c.now().Add(accessTTL),
+	}, nil
+}
+
+// reset removes all results. It is called when the cluster changes.
+func (c *accessCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.results = map[accessKey]accessResult{}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	oerrors "github.com/vmware-tanzu/octant/internal/errors"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// impersonatingClient is a cluster client whose impersonated clients use a
+// fake Kubernetes client.
+type impersonatingClient struct {
+	cluster.ClientInterface
+
+	kubernetesClient kubernetes.Interface
+}
+
+func (c *impersonatingClient) Impersonate(identity cluster.Identity) (cluster.ClientInterface, error) {
+	return c, nil
+}
+
+func (c *impersonatingClient) KubernetesClient() (kubernetes.Interface, error) {
+	return c.kubernetesClient, nil
+}
+
+func TestAccessCache_check(t *testing.T) {
+	kubernetesClient := kubefake.NewSimpleClientset()
+
+	reviews := 0
+	kubernetesClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		reviews++
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		review.Status.Allowed = review.Spec.ResourceAttributes.Namespace == "default"
+		review.Status.Reason = "namespace policy"
+		return true, review, nil
+	})
+
+	client := &impersonatingClient{kubernetesClient: kubernetesClient}
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	allowed := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+	denied := store.Key{Namespace: "kube-system", APIVersion: "v1", Kind: "Pod"}
+
+	now := time.Now()
+	c := newAccessCache()
+	c.now = func() time.Time { return now }
+
+	require.NoError(t, c.check(context.Background(), client, denied, gvr, "list"), "contexts without an identity are not checked")
+	assert.Equal(t, 0, reviews)
+
+	ctx := cluster.WithIdentity(context.Background(), cluster.Identity{User: "alice"})
+
+	require.NoError(t, c.check(ctx, client, allowed, gvr, "list"))
+	require.NoError(t, c.check(ctx, client, allowed, gvr, "list"))
+	assert.Equal(t, 1, reviews, "results are cached")
+
+	err := c.check(ctx, client, denied, gvr, "list")
+	require.Error(t, err)
+	_, isAccessError := err.(*oerrors.AccessError)
+	assert.True(t, isAccessError)
+
+	now = now.Add(accessTTL + time.Second)
+	require.NoError(t, c.check(ctx, client, allowed, gvr, "list"))
+	assert.Equal(t, 3, reviews, "results expire")
+
+	c.reset()
+	require.NoError(t, c.check(ctx, client, allowed, gvr, "list"))
+	assert.Equal(t, 4, reviews, "reset removes results")
+}
+
+func TestDynamicCache_CanList(t *testing.T) {
+	kubernetesClient := kubefake.NewSimpleClientset()
+	kubernetesClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		attributes := review.Spec.ResourceAttributes
+		review.Status.Allowed = attributes.Verb == "list" && attributes.Namespace == "default"
+		return true, review, nil
+	})
+
+	d := &DynamicCache{
+		client: &impersonatingClient{kubernetesClient: kubernetesClient},
+		access: newAccessCache(),
+	}
+	d.gvrCache.Store(schema.GroupKind{Kind: "Pod"}, schema.GroupVersionResource{Version: "v1", Resource: "pods"})
+
+	ctx := cluster.WithIdentity(context.Background(), cluster.Identity{User: "alice"})
+	assert.True(t, d.CanList(ctx, store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}))
+	assert.False(t, d.CanList(ctx, store.Key{Namespace: "kube-system", APIVersion: "v1", Kind: "Pod"}))
+}
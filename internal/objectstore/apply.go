@@ -0,0 +1,229 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	sigyaml "sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// fieldManager is the field manager Octant applies resources as.
+const fieldManager = "octant"
+
+var conflictManagerRE = regexp.MustCompile(`conflict with "([^"]*)"`)
+
+// applier applies a resource from a YAML document.
+type applier struct {
+	doc           map[string]interface{}
+	key           store.Key
+	gvr           schema.GroupVersionResource
+	namespaced    bool
+	clusterClient cluster.ClientInterface
+}
+
+func newApplier(ctx context.Context, namespace string, doc map[string]interface{}, clusterClient cluster.ClientInterface) (*applier, error) {
+	unstructuredObj := &unstructured.Unstructured{Object: doc}
+	key, err := store.KeyFromObject(unstructuredObj)
+	if err != nil {
+		return nil, err
+	}
+	gvr, namespaced, err := clusterClient.Resource(key.GroupVersionKind().GroupKind())
+	if err != nil {
+		return nil, fmt.Errorf("unable to discover resource: %w", err)
+	}
+	if namespaced && key.Namespace == "" {
+		unstructuredObj.SetNamespace(namespace)
+		key.Namespace = namespace
+	}
+
+	return &applier{
+		doc:           doc,
+		key:           key,
+		gvr:           gvr,
+		namespaced:    namespaced,
+		clusterClient: clusterClient,
+	}, nil
+}
+
+// String describes the resource.
+func (a *applier) String() string {
+	s := fmt.Sprintf("%s (%s) %s", a.key.Kind, a.key.APIVersion, a.key.Name)
+	if a.namespaced {
+		s = fmt.Sprintf("%s in %s", s, a.key.Namespace)
+	}
+	return s
+}
+
+// createOrUpdate creates the resource if it does not exist. Otherwise, it is
+// applied server-side, taking ownership of fields owned by other managers.
+func (a *applier) createOrUpdate(
+	ctx context.Context,
+	get func(context.Context, store.Key) (*unstructured.Unstructured, error),
+	create func(context.Context, *unstructured.Unstructured) error,
+) (string, error) {
+	if _, err := get(ctx, a.key); err != nil {
+		if !kerrors.IsNotFound(err) {
+			// unexpected error
+			return "", fmt.Errorf("unable to get resource: %w", err)
+		}
+
+		if err := create(ctx, &unstructured.Unstructured{Object: a.doc}); err != nil {
+			return "", fmt.Errorf("unable to create resource: %w", err)
+		}
+		return fmt.Sprintf("%s %s", verb(ctx, "Created", "Create"), a), nil
+	}
+
+	if _, err := a.patch(ctx, true); err != nil {
+		return "", fmt.Errorf("unable to patch resource: %w", err)
+	}
+	return fmt.Sprintf("%s %s", verb(ctx, "Updated", "Update"), a), nil
+}
+
+// serverSideApply applies the resource server-side. Unless force is set, fields
+// owned by other managers are reported as conflicts and the resource is left
+// unchanged.
+func (a *applier) serverSideApply(
+	ctx context.Context,
+	get func(context.Context, store.Key) (*unstructured.Unstructured, error),
+	force bool,
+) (string, error) {
+	existing, err := get(ctx, a.key)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			// unexpected error
+			return "", fmt.Errorf("unable to get resource: %w", err)
+		}
+		existing = nil
+	}
+
+	applied, err := a.patch(ctx, force)
+	if err != nil {
+		if conflicts := applyConflicts(err); conflicts != "" {
+			return fmt.Sprintf("Conflict %s: %s", a, conflicts), fmt.Errorf("unable to apply %s: %w", a, err)
+		}
+		return "", fmt.Errorf("unable to apply resource: %w", err)
+	}
+
+	switch {
+	case existing == nil:
+		return fmt.Sprintf("%s %s", verb(ctx, "Created", "Create"), a), nil
+	case changed(existing, applied):
+		return fmt.Sprintf("%s %s", verb(ctx, "Configured", "Configure"), a), nil
+	default:
+		return fmt.Sprintf("Unchanged %s", a), nil
+	}
+}
+
+// patch sends the resource to the API server as an apply patch.
+func (a *applier) patch(ctx context.Context, force bool) (*unstructured.Unstructured, error) {
+	unstructuredYaml, err := sigyaml.Marshal(a.doc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal resource as yaml: %w", err)
+	}
+	identityClient, err := cluster.ClientFor(ctx, a.clusterClient)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get cluster client: %w", err)
+	}
+	client, err := identityClient.DynamicClient()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get dynamic client: %w", err)
+	}
+
+	namespaceableClient := client.Resource(a.gvr)
+	var resourceClient dynamic.ResourceInterface = namespaceableClient
+	if a.namespaced {
+		resourceClient = namespaceableClient.Namespace(a.key.Namespace)
+	}
+
+	return resourceClient.Patch(
+		ctx,
+		a.key.Name,
+		types.ApplyPatchType,
+		unstructuredYaml,
+		metav1.PatchOptions{FieldManager: fieldManager, Force: &force, DryRun: dryRun(ctx)},
+	)
+}
+
+// verb returns past, or present for dry runs since they describe what would be
+// done.
+func verb(ctx context.Context, past, present string) string {
+	if store.DryRun(ctx) {
+		return present
+	}
+	return past
+}
+
+// changed returns true if applying changed the object. Fields the API server
+// maintains are ignored.
+func changed(before, after *unstructured.Unstructured) bool {
+	if after == nil {
+		return false
+	}
+	return !equality.Semantic.DeepEqual(userFields(before), userFields(after))
+}
+
+func userFields(object *unstructured.Unstructured) map[string]interface{} {
+	fields := object.DeepCopy().Object
+	unstructured.RemoveNestedField(fields, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(fields, "metadata", "generation")
+	unstructured.RemoveNestedField(fields, "metadata", "managedFields")
+	unstructured.RemoveNestedField(fields, "status")
+	return fields
+}
+
+// applyConflicts describes the fields owned by other managers which caused err,
+// grouped by manager. An empty string is returned if err is not an apply
+// conflict.
+func applyConflicts(err error) string {
+	var apiStatus kerrors.APIStatus
+	if !errors.As(err, &apiStatus) {
+		return ""
+	}
+	status := apiStatus.Status()
+	if status.Reason != metav1.StatusReasonConflict || status.Details == nil {
+		return ""
+	}
+
+	fields := map[string][]string{}
+	for _, cause := range status.Details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		manager := cause.Message
+		if match := conflictManagerRE.FindStringSubmatch(cause.Message); match != nil {
+			manager = match[1]
+		}
+		fields[manager] = append(fields[manager], cause.Field)
+	}
+
+	var managers []string
+	for manager := range fields {
+		managers = append(managers, manager)
+	}
+	sort.Strings(managers)
+
+	var descriptions []string
+	for _, manager := range managers {
+		descriptions = append(descriptions, fmt.Sprintf("%q manages %s", manager, strings.Join(fields[manager], ", ")))
+	}
+	return strings.Join(descriptions, "; ")
+}
@@ -0,0 +1,160 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func Test_applyConflicts(t *testing.T) {
+	conflict := kerrors.NewApplyConflict([]metav1.StatusCause{
+		{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "kubectl" using apps/v1`, Field: ".spec.replicas"},
+		{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "helm" using apps/v1`, Field: ".spec.template"},
+		{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "kubectl" using apps/v1`, Field: ".metadata.labels.app"},
+	}, "Apply failed with 3 conflicts")
+
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{
+			name:     "conflicts grouped by manager",
+			err:      fmt.Errorf("apply: %w", conflict),
+			expected: `"helm" manages .spec.template; "kubectl" manages .spec.replicas, .metadata.labels.app`,
+		},
+		{
+			name: "other conflict",
+			err:  kerrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "greeting", fmt.Errorf("modified")),
+		},
+		{
+			name: "not an API error",
+			err:  fmt.Errorf("failed"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, applyConflicts(test.err))
+		})
+	}
+}
+
+func TestCreateOrUpdateFromHandler_serverSide(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	dynamicClient := clusterFake.NewMockDynamicInterface(controller)
+	resourceClient := clusterFake.NewMockNamespaceableResourceInterface(controller)
+
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	clusterClient.EXPECT().Resource(gomock.Any()).Return(gvr, true, nil).AnyTimes()
+	clusterClient.EXPECT().DynamicClient().Return(dynamicClient, nil).AnyTimes()
+	dynamicClient.EXPECT().Resource(gvr).Return(resourceClient).AnyTimes()
+	resourceClient.EXPECT().Namespace("default").Return(resourceClient).AnyTimes()
+
+	existing := func(name, value string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":            name,
+				"namespace":       "default",
+				"resourceVersion": "1",
+			},
+			"data": map[string]interface{}{"hello": value},
+		}}
+	}
+	objects := map[string]*unstructured.Unstructured{
+		"unchanged":   existing("unchanged", "world"),
+		"configured":  existing("configured", "there"),
+		"conflicting": existing("conflicting", "there"),
+	}
+	get := func(ctx context.Context, key store.Key) (*unstructured.Unstructured, error) {
+		object, ok := objects[key.Name]
+		if !ok {
+			return nil, kerrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, key.Name)
+		}
+		return object, nil
+	}
+	create := func(ctx context.Context, object *unstructured.Unstructured) error {
+		return fmt.Errorf("resources are applied server-side")
+	}
+
+	resourceClient.EXPECT().
+		Patch(gomock.Any(), gomock.Any(), types.ApplyPatchType, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, name string, pt types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+			assert.Equal(t, "octant", options.FieldManager)
+			assert.False(t, *options.Force)
+			if name == "conflicting" {
+				return nil, kerrors.NewApplyConflict([]metav1.StatusCause{
+					{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "kubectl" using v1`, Field: ".data.hello"},
+				}, "Apply failed with 1 conflict")
+			}
+			applied := existing(name, "world")
+			applied.SetResourceVersion("2")
+			return applied, nil
+		}).
+		Times(4)
+
+	input := `---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: created
+data:
+  hello: world
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: conflicting
+data:
+  hello: world
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unchanged
+data:
+  hello: world
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: configured
+data:
+  hello: world
+`
+
+	ctx := store.WithApplyOptions(context.Background(), store.ApplyOptions{ServerSide: true, ContinueOnError: true})
+	results, err := CreateOrUpdateFromHandler(ctx, "default", input, get, create, clusterClient)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to apply ConfigMap (v1) conflicting in default")
+
+	expected := []string{
+		"Created ConfigMap (v1) created in default",
+		`Conflict ConfigMap (v1) conflicting in default: "kubectl" manages .data.hello`,
+		"Unchanged ConfigMap (v1) unchanged in default",
+		"Configured ConfigMap (v1) configured in default",
+	}
+	assert.Equal(t, expected, results)
+}
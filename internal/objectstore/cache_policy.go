@@ -0,0 +1,176 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+)
+
+// CachePolicy bounds the memory used by the informers of a DynamicCache.
+type CachePolicy struct {
+	// MaxInformers is the number of informers kept running. When it is
+	// exceeded, the least recently read informer is stopped. Zero means there
+	// is no limit.
+	MaxInformers int
+	// IdleTimeout is how long an informer runs without being read before it is
+	// stopped. Zero means idle informers keep running.
+	IdleTimeout time.Duration
+	// MaxAnnotationSize is the size in bytes of the largest annotation value
+	// cached. Larger values are removed before objects are cached. Zero means
+	// there is no limit.
+	MaxAnnotationSize int
+	// ListPageThreshold is the number of objects in the largest collection
+	// listed from an informer when a page is requested with
+	// store.WithListPage. Larger collections are listed a page at a time from
+	// the API server, so the first page is shown without waiting for the whole
+	// collection to be cached. Zero means collections are never paged.
+	ListPageThreshold int
+}
+
+// WithCachePolicy sets the policy bounding the memory used by informers.
+// Informers with event handlers added by Watch are never stopped.
+func WithCachePolicy(policy CachePolicy) Option {
+	return func(d *DynamicCache) {
+		d.policy = policy
+	}
+}
+
+// transform strips fields which are rarely shown but take up a lot of memory.
+// managedFields are always removed.
+func (p CachePolicy) transform(object *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(object.Object, "metadata", "managedFields")
+
+	if p.MaxAnnotationSize <= 0 {
+		return
+	}
+	annotations := object.GetAnnotations()
+	trimmed := false
+	for k, v := range annotations {
+		if len(v) > p.MaxAnnotationSize {
+			delete(annotations, k)
+			trimmed = true
+		}
+	}
+	if trimmed {
+		object.SetAnnotations(annotations)
+	}
+}
+
+func (p CachePolicy) transformList(list *unstructured.UnstructuredList) *unstructured.UnstructuredList {
+	for i := range list.Items {
+		p.transform(&list.Items[i])
+	}
+	return list
+}
+
+func (p CachePolicy) transformWatch(w watch.Interface) watch.Interface {
+	return watch.Filter(w, func(event watch.Event) (watch.Event, bool) {
+		if object, ok := event.Object.(*unstructured.Unstructured); ok {
+			p.transform(object)
+		}
+		return event, true
+	})
+}
+
+// evictable returns the informers to stop so at most max informers are
+// running and none have been idle longer than the policy allows. Informers
+// are given from least to most recently read.
+func (p CachePolicy) evictable(informers []*interuptibleInformer, now time.Time) []*interuptibleInformer {
+	var candidates []*interuptibleInformer
+	for _, ii := range informers {
+		if !ii.isPinned() {
+			candidates = append(candidates, ii)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].lastRead().Before(candidates[j].lastRead())
+	})
+
+	over := 0
+	if p.MaxInformers > 0 && len(informers) > p.MaxInformers {
+		over = len(informers) - p.MaxInformers
+	}
+
+	var evict []*interuptibleInformer
+	for i, ii := range candidates {
+		idle := p.IdleTimeout > 0 && now.Sub(ii.lastRead()) > p.IdleTimeout
+		if i < over || idle {
+			evict = append(evict, ii)
+		}
+	}
+	return evict
+}
+
+// transformingClient is a dynamic client which applies a cache policy to the
+// objects it lists and watches, so informers only cache transformed objects.
+type transformingClient struct {
+	dynamic.Interface
+	policy CachePolicy
+}
+
+func (c *transformingClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return &transformingNamespaceableResource{
+		NamespaceableResourceInterface: c.Interface.Resource(gvr),
+		policy:                         c.policy,
+	}
+}
+
+type transformingNamespaceableResource struct {
+	dynamic.NamespaceableResourceInterface
+	policy CachePolicy
+}
+
+func (r *transformingNamespaceableResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return &transformingResource{
+		ResourceInterface: r.NamespaceableResourceInterface.Namespace(namespace),
+		policy:            r.policy,
+	}
+}
+
+func (r *transformingNamespaceableResource) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	list, err := r.NamespaceableResourceInterface.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return r.policy.transformList(list), nil
+}
+
+func (r *transformingNamespaceableResource) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	w, err := r.NamespaceableResourceInterface.Watch(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return r.policy.transformWatch(w), nil
+}
+
+type transformingResource struct {
+	dynamic.ResourceInterface
+	policy CachePolicy
+}
+
+func (r *transformingResource) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	list, err := r.ResourceInterface.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return r.policy.transformList(list), nil
+}
+
+func (r *transformingResource) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	w, err := r.ResourceInterface.Watch(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return r.policy.transformWatch(w), nil
+}
@@ -0,0 +1,212 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	"k8s.io/client-go/rest"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+var podsGVR = schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+
+// cacheClient is a cluster client for pods backed by a fake dynamic client.
+type cacheClient struct {
+	cluster.ClientInterface
+
+	dynamicClient dynamic.Interface
+}
+
+func (c *cacheClient) DynamicClient() (dynamic.Interface, error) {
+	return c.dynamicClient, nil
+}
+
+func (c *cacheClient) Resource(gk schema.GroupKind) (schema.GroupVersionResource, bool, error) {
+	return podsGVR, true, nil
+}
+
+func (c *cacheClient) RESTConfig() *rest.Config {
+	return nil
+}
+
+func TestCachePolicy_transform(t *testing.T) {
+	policy := CachePolicy{MaxAnnotationSize: 10}
+
+	pod := testPod("web-1", "1", nil)
+	pod.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "kubectl"}})
+	pod.SetAnnotations(map[string]string{
+		"small": "value",
+		"large": strings.Repeat("x", 11),
+	})
+
+	policy.transform(pod)
+
+	assert.Nil(t, pod.GetManagedFields())
+	assert.Equal(t, map[string]string{"small": "value"}, pod.GetAnnotations())
+}
+
+func TestCachePolicy_evictable(t *testing.T) {
+	now := time.Now()
+
+	newInformer := func(resource string, lastRead time.Duration, pinned bool) *interuptibleInformer {
+		ii := &interuptibleInformer{gvr: schema.GroupVersionResource{Version: "v1", Resource: resource}}
+		ii.read(now.Add(-lastRead))
+		if pinned {
+			ii.pin()
+		}
+		return ii
+	}
+
+	secrets := newInformer("secrets", time.Hour, false)
+	events := newInformer("events", 2*time.Minute, false)
+	pods := newInformer("pods", time.Minute, false)
+	crds := newInformer("customresourcedefinitions", 2*time.Hour, true)
+	running := []*interuptibleInformer{pods, secrets, crds, events}
+
+	tests := []struct {
+		name     string
+		policy   CachePolicy
+		expected []*interuptibleInformer
+	}{
+		{
+			name:   "no limits",
+			policy: CachePolicy{},
+		},
+		{
+			name:     "max informers",
+			policy:   CachePolicy{MaxInformers: 2},
+			expected: []*interuptibleInformer{secrets, events},
+		},
+		{
+			name:     "idle timeout",
+			policy:   CachePolicy{IdleTimeout: 10 * time.Minute},
+			expected: []*interuptibleInformer{secrets},
+		},
+		{
+			name:     "max informers and idle timeout",
+			policy:   CachePolicy{MaxInformers: 3, IdleTimeout: 90 * time.Second},
+			expected: []*interuptibleInformer{secrets, events},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.policy.evictable(running, now)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestDynamicCache_List_transformed(t *testing.T) {
+	pod := testPod("web-1", "1", nil)
+	pod.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "kubectl"}})
+	pod.SetAnnotations(map[string]string{"large": strings.Repeat("x", 11)})
+
+	d := newTestDynamicCache(t, CachePolicy{MaxAnnotationSize: 10}, pod)
+
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+	list := waitForList(t, d, context.Background(), key)
+
+	got := list.Items[0]
+	assert.Equal(t, "web-1", got.GetName())
+	assert.Nil(t, got.GetManagedFields())
+	assert.Empty(t, got.GetAnnotations())
+}
+
+func TestDynamicCache_List_metadataOnly(t *testing.T) {
+	d := newTestDynamicCache(t, CachePolicy{}, testPod("web-1", "1", nil))
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, metav1.AddMetaToScheme(scheme))
+	d.metadataClient = metadatafake.NewSimpleMetadataClient(scheme, &metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web-1", Labels: map[string]string{"app": "web"}},
+	})
+
+	ctx := store.WithMetadataOnly(context.Background())
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+	list := waitForList(t, d, ctx, key)
+
+	got := list.Items[0]
+	assert.Equal(t, "v1", got.GetAPIVersion())
+	assert.Equal(t, "Pod", got.GetKind())
+	assert.Equal(t, map[string]string{"app": "web"}, got.GetLabels())
+
+	_, ok := d.knownInformers.Load(informerKey{gvr: podsGVR, metadataOnly: true})
+	assert.True(t, ok, "metadata-only informer is running")
+	_, ok = d.knownInformers.Load(informerKey{gvr: podsGVR})
+	assert.False(t, ok, "full informer isn't running")
+
+	waitForList(t, d, context.Background(), key)
+	_, metadataOnly, err := d.listerForResource(ctx, key, "list")
+	require.NoError(t, err)
+	assert.False(t, metadataOnly, "running full informers are used for metadata")
+}
+
+func TestDynamicCache_evictInformers(t *testing.T) {
+	d := newTestDynamicCache(t, CachePolicy{IdleTimeout: time.Minute})
+
+	now := time.Now()
+	d.now = func() time.Time { return now }
+
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+	_, _, err := d.List(context.Background(), key)
+	require.NoError(t, err)
+
+	d.informerMu.Lock()
+	d.evictInformers()
+	d.informerMu.Unlock()
+	_, ok := d.knownInformers.Load(informerKey{gvr: podsGVR})
+	require.True(t, ok)
+
+	now = now.Add(2 * time.Minute)
+	d.informerMu.Lock()
+	d.evictInformers()
+	d.informerMu.Unlock()
+	_, ok = d.knownInformers.Load(informerKey{gvr: podsGVR})
+	assert.False(t, ok, "idle informer was evicted")
+
+	_, _, err = d.List(context.Background(), key)
+	require.NoError(t, err)
+	_, ok = d.knownInformers.Load(informerKey{gvr: podsGVR})
+	assert.True(t, ok, "evicted informers are restarted when read")
+}
+
+func newTestDynamicCache(t *testing.T, policy CachePolicy, objects ...runtime.Object) *DynamicCache {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	client := &cacheClient{dynamicClient: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), objects...)}
+	d, err := NewDynamicCache(ctx, client, WithCachePolicy(policy))
+	require.NoError(t, err)
+	return d
+}
+
+func waitForList(t *testing.T, d *DynamicCache, ctx context.Context, key store.Key) *unstructured.UnstructuredList {
+	var list *unstructured.UnstructuredList
+	require.Eventually(t, func() bool {
+		var err error
+		list, _, err = d.List(ctx, key)
+		require.NoError(t, err)
+		return len(list.Items) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	return list
+}
@@ -9,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"go.opencensus.io/trace"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -17,15 +18,17 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/retry"
-	sigyaml "sigs.k8s.io/yaml"
 
 	"github.com/vmware-tanzu/octant/internal/cluster"
 	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/readonly"
 	"github.com/vmware-tanzu/octant/pkg/store"
 )
 
@@ -44,24 +47,61 @@ type DynamicCache struct {
 	client cluster.ClientInterface
 
 	informerFactory dynamicinformer.DynamicSharedInformerFactory
-	knownInformers  sync.Map // gvr:GenericInformer
+	informerClient  dynamic.Interface
+	metadataClient  metadata.Interface
+	knownInformers  sync.Map // informerKey:*interuptibleInformer
 	unwatched       sync.Map // gvr:bool
 	gvrCache        sync.Map // gk:gvr
 
-	removeCh chan schema.GroupVersionResource
+	policy     CachePolicy
+	informerMu sync.Mutex
+	now        func() time.Time
+
+	sizesMu sync.Mutex
+	sizes   map[collectionKey]collectionSize
+
+	healthMu sync.Mutex
+	health   map[schema.GroupVersionResource]*resourceHealth
+
+	removeCh chan informerKey
 	mu       sync.Mutex
+
+	notifyMu       sync.RWMutex
+	subscribers    map[int]subscriber
+	nextSubscriber int
+
+	access   *accessCache
+	readOnly bool
+	indexer  Indexer
 }
 
 var _ store.Store = (*DynamicCache)(nil)
+var _ store.Notifier = (*DynamicCache)(nil)
+
+// subscriber is notified when an object matching one of its keys changes.
+type subscriber struct {
+	keys []store.Key
+	fn   func()
+}
 
 type Option func(*DynamicCache)
 
+// WithDynamicSharedInformerFactory makes the cache get full informers from
+// factory. These informers are shared with other users of the factory, so the
+// cache policy doesn't transform their objects or stop them.
 func WithDynamicSharedInformerFactory(factory dynamicinformer.DynamicSharedInformerFactory) Option {
 	return func(d *DynamicCache) {
 		d.informerFactory = factory
 	}
 }
 
+// ReadOnly makes the cache refuse to change objects.
+func ReadOnly() Option {
+	return func(d *DynamicCache) {
+		d.readOnly = true
+	}
+}
+
 func NewDynamicCache(ctx context.Context, client cluster.ClientInterface, opts ...Option) (*DynamicCache, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	dynamicClient, err := client.DynamicClient()
@@ -77,18 +117,20 @@ func NewDynamicCache(ctx context.Context, client cluster.ClientInterface, opts .
 		knownInformers: sync.Map{},
 		unwatched:      sync.Map{},
 		gvrCache:       sync.Map{},
-		removeCh:       make(chan schema.GroupVersionResource),
+		removeCh:       make(chan informerKey),
+		subscribers:    map[int]subscriber{},
+		access:         newAccessCache(),
+		now:            time.Now,
 	}
 
 	for _, opt := range opts {
 		opt(dc)
 	}
 
-	if dc.informerFactory == nil {
-		dc.informerFactory = dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, resyncPeriod)
-	}
+	dc.informerClient = &transformingClient{Interface: dynamicClient, policy: dc.policy}
 
 	go dc.worker()
+	caches.add(dc)
 
 	return dc, nil
 }
@@ -97,7 +139,20 @@ func (d *DynamicCache) List(ctx context.Context, key store.Key) (list *unstructu
 	_, span := trace.StartSpan(ctx, "dynamicCache:List")
 	defer span.End()
 
-	resourceLister, err := d.listerForResource(ctx, key)
+	store.RecordNotifierKey(ctx, d, key)
+
+	if page, ok := store.ListPageFrom(ctx); ok {
+		gvr, err := d.gvrFromKey(ctx, key)
+		if err != nil {
+			return nil, false, err
+		}
+		if d.shouldPage(ctx, key, gvr) {
+			list, err := d.listPage(ctx, key, gvr, page)
+			return list, false, err
+		}
+	}
+
+	resourceLister, metadataOnly, err := d.listerForResource(ctx, key, "list")
 	if err != nil {
 		return nil, false, err
 	}
@@ -106,18 +161,9 @@ func (d *DynamicCache) List(ctx context.Context, key store.Key) (list *unstructu
 		return nil, false, fmt.Errorf("resourceLister is nil")
 	}
 
-	if key.Selector != nil && key.LabelSelector != nil {
-		return nil, false, fmt.Errorf("must provide only one of Key.Selector and Key.LabelSelector")
-	}
-
-	var selector = labels.Everything()
-	if key.Selector != nil {
-		selector = key.Selector.AsSelector()
-	} else if key.LabelSelector != nil {
-		selector, err = metav1.LabelSelectorAsSelector(key.LabelSelector)
-		if err != nil {
-			return nil, false, err
-		}
+	selector, err := keySelector(key)
+	if err != nil {
+		return nil, false, err
 	}
 
 	span.AddAttributes(
@@ -144,6 +190,9 @@ func (d *DynamicCache) List(ctx context.Context, key store.Key) (list *unstructu
 			return nil, false, err
 		}
 		ul.Items[i].Object = u
+		if metadataOnly {
+			ul.Items[i].SetGroupVersionKind(key.GroupVersionKind())
+		}
 	}
 
 	return ul, false, err
@@ -153,7 +202,9 @@ func (d *DynamicCache) Get(ctx context.Context, key store.Key) (object *unstruct
 	ctx, span := trace.StartSpan(ctx, "dynamicCache:Get")
 	defer span.End()
 
-	resourceLister, err := d.listerForResource(ctx, key)
+	store.RecordNotifierKey(ctx, d, key)
+
+	resourceLister, _, err := d.listerForResource(ctx, key, "get")
 	if err != nil {
 		return nil, err
 	}
@@ -166,14 +217,54 @@ func (d *DynamicCache) Get(ctx context.Context, key store.Key) (object *unstruct
 	if err != nil {
 		return nil, err
 	}
-	return obj.(*unstructured.Unstructured), nil
+	return objectToUnstructured(obj, key.GroupVersionKind())
+}
+
+// keySelector returns the label selector for key.
+func keySelector(key store.Key) (labels.Selector, error) {
+	if key.Selector != nil && key.LabelSelector != nil {
+		return nil, fmt.Errorf("must provide only one of Key.Selector and Key.LabelSelector")
+	}
+
+	if key.Selector != nil {
+		return key.Selector.AsSelector(), nil
+	}
+	if key.LabelSelector != nil {
+		return metav1.LabelSelectorAsSelector(key.LabelSelector)
+	}
+	return labels.Everything(), nil
+}
+
+// objectToUnstructured converts an object from an informer to an unstructured
+// object. Metadata-only informers cache partial objects without their type, so
+// it is set to gvk.
+func objectToUnstructured(obj interface{}, gvk schema.GroupVersionKind) (*unstructured.Unstructured, error) {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return u, nil
+	}
+
+	runtimeObject, ok := obj.(runtime.Object)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T", obj)
+	}
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(runtimeObject)
+	if err != nil {
+		return nil, err
+	}
+	u := &unstructured.Unstructured{Object: m}
+	u.SetGroupVersionKind(gvk)
+	return u, nil
 }
 
 func (d *DynamicCache) Delete(ctx context.Context, key store.Key) error {
 	_, span := trace.StartSpan(ctx, "dynamicCache:delete")
 	defer span.End()
 
-	dynamicClient, err := d.client.DynamicClient()
+	if d.readOnly {
+		return readonly.ErrReadOnly
+	}
+
+	dynamicClient, err := d.dynamicClient(ctx)
 	if err != nil {
 		return err
 	}
@@ -183,9 +274,10 @@ func (d *DynamicCache) Delete(ctx context.Context, key store.Key) error {
 		return err
 	}
 
-	deletePolicy := metav1.DeletePropagationForeground
+	deletePolicy := store.PropagationPolicy(ctx)
 	deleteOptions := metav1.DeleteOptions{
 		PropagationPolicy: &deletePolicy,
+		DryRun:            dryRun(ctx),
 	}
 
 	if key.Namespace == "" {
@@ -202,6 +294,11 @@ func (d *DynamicCache) UpdateClusterClient(ctx context.Context, client cluster.C
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
+	// Informers are started and evicted with informerMu held, so hold it while
+	// the informers and the clients they are created with are replaced.
+	d.informerMu.Lock()
+	defer d.informerMu.Unlock()
+
 	d.stopAllInformers()
 
 	d.client = client
@@ -210,9 +307,15 @@ func (d *DynamicCache) UpdateClusterClient(ctx context.Context, client cluster.C
 		return err
 	}
 
-	d.informerFactory = dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, resyncPeriod)
-	d.knownInformers = sync.Map{}
-	d.unwatched = sync.Map{}
+	d.informerFactory = nil
+	d.informerClient = &transformingClient{Interface: dynamicClient, policy: d.policy}
+	d.metadataClient = nil
+	d.unwatched.Range(func(k, v interface{}) bool {
+		d.unwatched.Delete(k)
+		return true
+	})
+	d.access.reset()
+	d.resetHealth()
 
 	return nil
 }
@@ -225,33 +328,39 @@ func (d *DynamicCache) Update(ctx context.Context, key store.Key, updater func(*
 		return fmt.Errorf("can't update object")
 	}
 
+	if d.readOnly {
+		return readonly.ErrReadOnly
+	}
+
 	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		object, err := d.Get(ctx, key)
+		gvr, err := d.gvrFromKey(ctx, key)
 		if err != nil {
 			return err
 		}
 
-		if object == nil {
-			return errors.New("object not found")
-		}
-
-		gvr, err := d.gvrFromKey(ctx, key)
+		dynamicClient, err := d.dynamicClient(ctx)
 		if err != nil {
 			return err
 		}
 
-		dynamicClient, err := d.client.DynamicClient()
+		client := dynamicClient.Resource(gvr).Namespace(key.Namespace)
+
+		// The object is read from the cluster rather than the cache since the
+		// cache policy may have removed fields which must be kept.
+		object, err := client.Get(ctx, key.Name, metav1.GetOptions{})
 		if err != nil {
 			return err
 		}
 
+		if object == nil {
+			return errors.New("object not found")
+		}
+
 		if err := updater(object); err != nil {
 			return fmt.Errorf("unable to update object: %w", err)
 		}
 
-		client := dynamicClient.Resource(gvr).Namespace(object.GetNamespace())
-
-		_, err = client.Update(ctx, object, metav1.UpdateOptions{})
+		_, err = client.Update(ctx, object, metav1.UpdateOptions{DryRun: dryRun(ctx)})
 		return err
 	})
 
@@ -269,6 +378,10 @@ func (d *DynamicCache) Create(ctx context.Context, object *unstructured.Unstruct
 	ctx, span := trace.StartSpan(ctx, "dynamicCache:Create")
 	defer span.End()
 
+	if d.readOnly {
+		return readonly.ErrReadOnly
+	}
+
 	key, err := store.KeyFromObject(object)
 	if err != nil {
 		return fmt.Errorf("key from object: %w", err)
@@ -279,9 +392,9 @@ func (d *DynamicCache) Create(ctx context.Context, object *unstructured.Unstruct
 		return err
 	}
 
-	createOptions := metav1.CreateOptions{}
+	createOptions := metav1.CreateOptions{DryRun: dryRun(ctx)}
 
-	dynamicClient, err := d.client.DynamicClient()
+	dynamicClient, err := d.dynamicClient(ctx)
 	if err != nil {
 		return err
 	}
@@ -301,7 +414,7 @@ func (d *DynamicCache) WaitForCacheSync(ctx context.Context) bool {
 
 	var ret bool
 	d.knownInformers.Range(func(k, v interface{}) bool {
-		ii := v.(interuptibleInformer)
+		ii := v.(*interuptibleInformer)
 		ret = cache.WaitForCacheSync(ii.stopCh, ii.informer.Informer().HasSynced)
 		return ret
 	})
@@ -321,14 +434,17 @@ func (d *DynamicCache) Watch(ctx context.Context, key store.Key, handler cache.R
 		return err
 	}
 
+	if err := d.access.check(ctx, d.clusterClient(), key, gvr, "watch"); err != nil {
+		return err
+	}
+
 	if d.isUnwatched(ctx, gvr) {
 		return fmt.Errorf("watcher was unable to start for %s", gvr)
 	}
 
 	span.AddAttributes(trace.StringAttribute("key", fmt.Sprintf("%s", key)))
 
-	d.forResource(ctx, gvr, handler)
-
+	_, err = d.forResource(ctx, informerKey{gvr: gvr}, key.GroupVersionKind(), handler)
 	return err
 }
 
@@ -336,82 +452,279 @@ func (d *DynamicCache) Unwatch(ctx context.Context, groupVersionKinds ...schema.
 	return nil
 }
 
+// Notify calls fn when an object matching any of keys changes. Informers are
+// only started by reads, so keys which haven't been read yet won't notify.
+func (d *DynamicCache) Notify(keys []store.Key, fn func()) func() {
+	d.notifyMu.Lock()
+	defer d.notifyMu.Unlock()
+
+	id := d.nextSubscriber
+	d.nextSubscriber++
+	d.subscribers[id] = subscriber{keys: keys, fn: fn}
+
+	return func() {
+		d.notifyMu.Lock()
+		defer d.notifyMu.Unlock()
+
+		delete(d.subscribers, id)
+	}
+}
+
+// notifyHandler is added to every informer to notify subscribers of changes.
+func (d *DynamicCache) notifyHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			d.notify(obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldAccessor, oldErr := meta.Accessor(oldObj)
+			newAccessor, newErr := meta.Accessor(newObj)
+			if oldErr == nil && newErr == nil && oldAccessor.GetResourceVersion() == newAccessor.GetResourceVersion() {
+				// Resyncs don't change anything.
+				return
+			}
+			// The old object is included in case the change means the object
+			// no longer matches a key.
+			d.notify(oldObj, newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			d.notify(obj)
+		},
+	}
+}
+
+// metadataNotifyHandler notifies subscribers of changes to the partial objects
+// cached by metadata-only informers. gvk is the type of the objects.
+func (d *DynamicCache) metadataNotifyHandler(gvk schema.GroupVersionKind) cache.ResourceEventHandler {
+	return convertingHandler(gvk, d.notifyHandler())
+}
+
+// convertingHandler converts the partial objects cached by metadata-only
+// informers to unstructured objects before passing them to handler. gvk is
+// the type of the objects.
+func convertingHandler(gvk schema.GroupVersionKind, handler cache.ResourceEventHandler) cache.ResourceEventHandler {
+	convert := func(obj interface{}) interface{} {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		u, err := objectToUnstructured(obj, gvk)
+		if err != nil {
+			return obj
+		}
+		return u
+	}
+
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			handler.OnAdd(convert(obj))
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			handler.OnUpdate(convert(oldObj), convert(newObj))
+		},
+		DeleteFunc: func(obj interface{}) {
+			handler.OnDelete(convert(obj))
+		},
+	}
+}
+
+func (d *DynamicCache) notify(objects ...interface{}) {
+	d.notifyMu.RLock()
+	defer d.notifyMu.RUnlock()
+
+	for _, s := range d.subscribers {
+		if subscriberMatches(s, objects) {
+			s.fn()
+		}
+	}
+}
+
+func subscriberMatches(s subscriber, objects []interface{}) bool {
+	for _, obj := range objects {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+
+		for _, key := range s.keys {
+			if key.Matches(u) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (d *DynamicCache) worker() {
 	for {
 		select {
 		case <-d.ctx.Done():
+			d.informerMu.Lock()
 			d.stopAllInformers()
+			d.informerMu.Unlock()
+			caches.remove(d)
 			return
-		case gvr := <-d.removeCh:
-			d.unwatched.Store(gvr, true)
-			v, ok := d.knownInformers.LoadAndDelete(gvr)
+		case key := <-d.removeCh:
+			d.informerMu.Lock()
+			d.unwatched.Store(key.gvr, true)
+			v, ok := d.knownInformers.LoadAndDelete(key)
 			if ok {
-				ii := v.(interuptibleInformer)
+				ii := v.(*interuptibleInformer)
 				ii.Stop()
 			}
+			d.informerMu.Unlock()
 		case <-time.After(time.Millisecond * 500):
-			continue
+			d.informerMu.Lock()
+			d.evictInformers()
+			d.informerMu.Unlock()
+			d.updateHealth()
 		}
 	}
 }
 
+// stopAllInformers stops and forgets every informer. It must be called with
+// informerMu held.
 func (d *DynamicCache) stopAllInformers() {
 	d.knownInformers.Range(func(k, v interface{}) bool {
-		ii := v.(interuptibleInformer)
+		d.knownInformers.Delete(k)
+		ii := v.(*interuptibleInformer)
 		ii.Stop()
 		return true
 	})
 }
 
+// evictInformers stops the informers the cache policy evicts. It must be
+// called with informerMu held.
+func (d *DynamicCache) evictInformers() {
+	var running []*interuptibleInformer
+	d.knownInformers.Range(func(k, v interface{}) bool {
+		running = append(running, v.(*interuptibleInformer))
+		return true
+	})
+
+	logger := log.From(d.ctx)
+	for _, ii := range d.policy.evictable(running, d.now()) {
+		if _, ok := d.knownInformers.LoadAndDelete(ii.key()); ok {
+			logger.Debugf("evicting informer for %s (metadata only: %t)", ii.gvr, ii.metadataOnly)
+			ii.Stop()
+		}
+	}
+}
+
 func (d *DynamicCache) isUnwatched(ctx context.Context, gvr schema.GroupVersionResource) bool {
 	_, ok := d.unwatched.Load(gvr)
 	return ok
 }
 
-func (d *DynamicCache) forResource(ctx context.Context, gvr schema.GroupVersionResource, handler cache.ResourceEventHandler) interuptibleInformer {
+// forResource returns the informer for key, starting it if it isn't running.
+// gvk is the type of the informer's objects. Informers with a handler are
+// pinned so they are never evicted. Metadata-only keys use the full informer
+// when it is already running or the cache has a shared informer factory.
+func (d *DynamicCache) forResource(ctx context.Context, key informerKey, gvk schema.GroupVersionKind, handler cache.ResourceEventHandler) (*interuptibleInformer, error) {
 	_, span := trace.StartSpan(ctx, "dynamicCache:forResource")
 	defer span.End()
 
 	logger := log.From(ctx)
 	logger = logger.With("dynamicCache", "forResource")
 
-	v, ok := d.knownInformers.Load(gvr)
+	d.informerMu.Lock()
+	defer d.informerMu.Unlock()
+
+	if key.metadataOnly {
+		full := informerKey{gvr: key.gvr}
+		if _, ok := d.knownInformers.Load(full); ok || d.informerFactory != nil {
+			key = full
+		}
+	}
+
+	v, ok := d.knownInformers.Load(key)
 	if !ok {
-		i := d.informerFactory.ForResource(gvr)
-		stopCh := make(chan struct{})
-		i.Informer().SetWatchErrorHandler(d.watchErrorHandler(ctx, gvr, stopCh))
+		ii, err := d.newInformer(key)
+		if err != nil {
+			return nil, err
+		}
+
+		i := ii.informer
+		i.Informer().SetWatchErrorHandler(d.watchErrorHandler(ctx, ii))
+		if key.metadataOnly {
+			i.Informer().AddEventHandler(d.metadataNotifyHandler(gvk))
+		} else {
+			i.Informer().AddEventHandler(d.notifyHandler())
+		}
+		d.addIndexer(ii, gvk)
 		if handler != nil {
 			i.Informer().AddEventHandlerWithResyncPeriod(handler, resyncPeriod)
+			ii.pin()
 		}
 
 		go func() {
-			logger.Debugf("starting informer for %s", gvr)
-			i.Informer().Run(stopCh)
-			logger.Debugf("stopping informer for %s", gvr)
+			logger.Debugf("starting informer for %s (metadata only: %t)", key.gvr, key.metadataOnly)
+			i.Informer().Run(ii.stopCh)
+			logger.Debugf("stopping informer for %s (metadata only: %t)", key.gvr, key.metadataOnly)
 		}()
 
-		ii := interuptibleInformer{
-			stopCh,
-			i,
-			gvr,
-		}
-		d.knownInformers.Store(gvr, ii)
-		return ii
+		ii.read(d.now())
+		d.knownInformers.Store(key, ii)
+		d.evictInformers()
+		return ii, nil
 	}
-	ii := v.(interuptibleInformer)
+	ii := v.(*interuptibleInformer)
 	if handler != nil {
 		ii.informer.Informer().AddEventHandlerWithResyncPeriod(handler, resyncPeriod)
+		ii.pin()
 	}
-	return ii
+	return ii, nil
 }
 
-func (d *DynamicCache) listerForResource(ctx context.Context, key store.Key) (lister, error) {
+// newInformer creates an informer for key. Full informers are taken from the
+// shared informer factory when the cache has one.
+func (d *DynamicCache) newInformer(key informerKey) (*interuptibleInformer, error) {
+	ii := &interuptibleInformer{
+		stopCh:       make(chan struct{}),
+		gvr:          key.gvr,
+		metadataOnly: key.metadataOnly,
+	}
+
+	indexers := cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}
+
+	switch {
+	case key.metadataOnly:
+		if d.metadataClient == nil {
+			restConfig := d.client.RESTConfig()
+			if restConfig == nil {
+				return nil, fmt.Errorf("unable to create metadata client: no REST config")
+			}
+			metadataClient, err := metadata.NewForConfig(restConfig)
+			if err != nil {
+				return nil, fmt.Errorf("unable to create metadata client: %w", err)
+			}
+			d.metadataClient = metadataClient
+		}
+		ii.informer = metadatainformer.NewFilteredMetadataInformer(d.metadataClient, key.gvr, metav1.NamespaceAll, resyncPeriod, indexers, nil)
+	case d.informerFactory != nil:
+		ii.informer = d.informerFactory.ForResource(key.gvr)
+		ii.pin()
+	default:
+		ii.informer = dynamicinformer.NewFilteredDynamicInformer(d.informerClient, key.gvr, metav1.NamespaceAll, resyncPeriod, indexers, nil)
+	}
+
+	return ii, nil
+}
+
+// listerForResource returns a lister for key's resource. The identity in ctx must be
+// allowed to perform verb. Reads which only need metadata use a metadata-only
+// informer unless a full informer for the resource is already running. True is
+// returned if the lister holds partial objects.
+func (d *DynamicCache) listerForResource(ctx context.Context, key store.Key, verb string) (lister, bool, error) {
 	ctx, span := trace.StartSpan(ctx, "dynamicCache:ListerForResource")
 	defer span.End()
 
 	gvr, err := d.gvrFromKey(ctx, key)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	span.AddAttributes(
@@ -419,11 +732,20 @@ func (d *DynamicCache) listerForResource(ctx context.Context, key store.Key) (li
 		trace.StringAttribute("gvr", fmt.Sprintf("%s", gvr)),
 	)
 
+	if err := d.access.check(ctx, d.clusterClient(), key, gvr, verb); err != nil {
+		return nil, false, err
+	}
+
 	if d.isUnwatched(ctx, gvr) {
-		return nil, fmt.Errorf("unable to get Lister for %s, watcher was unable to start", gvr)
+		return nil, false, fmt.Errorf("unable to get Lister for %s, watcher was unable to start", gvr)
 	}
 
-	ii := d.forResource(ctx, gvr, nil)
+	ik := informerKey{gvr: gvr, metadataOnly: store.MetadataOnly(ctx)}
+	ii, err := d.forResource(ctx, ik, key.GroupVersionKind(), nil)
+	if err != nil {
+		return nil, false, err
+	}
+	ii.read(d.now())
 
 	var l lister
 	if key.Namespace == "" {
@@ -432,21 +754,72 @@ func (d *DynamicCache) listerForResource(ctx context.Context, key store.Key) (li
 		l = ii.informer.Lister().ByNamespace(key.Namespace)
 	}
 
-	return l, nil
+	return l, ii.metadataOnly, nil
+}
+
+// CanList returns true if the identity in ctx can list the objects of key's
+// type in key's namespace. It is used to filter search results, which come from
+// informers shared by all identities.
+func (d *DynamicCache) CanList(ctx context.Context, key store.Key) bool {
+	gvr, err := d.gvrFromKey(ctx, key)
+	if err != nil {
+		return false
+	}
+
+	return d.access.check(ctx, d.clusterClient(), key, gvr, "list") == nil
 }
 
-func (d *DynamicCache) watchErrorHandler(ctx context.Context, gvr schema.GroupVersionResource, stopCh chan struct{}) func(*cache.Reflector, error) {
+// watchErrorHandler records the errors an informer's reflector hits while
+// listing and watching. Informers for forbidden or missing resources are
+// stopped. Other errors are retried by the reflector, and objects cached
+// before them are returned as stale until the resource is listed again.
+func (d *DynamicCache) watchErrorHandler(ctx context.Context, ii *interuptibleInformer) func(*cache.Reflector, error) {
 	return func(r *cache.Reflector, err error) {
 		_, span := trace.StartSpan(ctx, "dynamicCache:watchErrorHandler")
 		defer span.End()
 
-		span.AddAttributes(trace.StringAttribute("gvr", fmt.Sprintf("%s", gvr)))
+		span.AddAttributes(trace.StringAttribute("gvr", fmt.Sprintf("%s", ii.gvr)))
 
-		logger := log.From(ctx)
-		logger.Warnf("unable to start watcher ", err.Error())
+		if kerrors.IsResourceExpired(err) || kerrors.IsGone(err) {
+			// The reflector lists the resource again.
+			return
+		}
+
+		logger := log.From(ctx).With("gvr", ii.gvr.String())
+
+		forbidden := isForbidden(err)
+		d.recordWatchError(ii, err, forbidden)
+
+		if !forbidden && !kerrors.IsNotFound(err) {
+			logger.WithErr(err).Warnf("unable to watch resource; retrying")
+			return
+		}
+
+		logger.WithErr(err).Warnf("unable to start watcher")
+
+		select {
+		case d.removeCh <- ii.key():
+		case <-d.ctx.Done():
+		}
+	}
+}
 
-		d.removeCh <- gvr
+// clusterClient returns the cluster client. The client is replaced with both
+// mu and informerMu held, so code holding informerMu can use d.client directly.
+func (d *DynamicCache) clusterClient() cluster.ClientInterface {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.client
+}
+
+// dynamicClient returns a dynamic client which makes calls as the identity in ctx.
+func (d *DynamicCache) dynamicClient(ctx context.Context) (dynamic.Interface, error) {
+	client, err := cluster.ClientFor(ctx, d.clusterClient())
+	if err != nil {
+		return nil, err
 	}
+
+	return client.DynamicClient()
 }
 
 func (d *DynamicCache) gvrFromKey(ctx context.Context, key store.Key) (schema.GroupVersionResource, error) {
@@ -463,7 +836,8 @@ func (d *DynamicCache) gvrFromKey(ctx context.Context, key store.Key) (schema.Gr
 
 	v, ok := d.gvrCache.Load(gk)
 	if !ok {
-		gvr, _, err := d.client.Resource(gk)
+		var err error
+		gvr, _, err = d.clusterClient().Resource(gk)
 		if err != nil {
 			return schema.GroupVersionResource{}, err
 		}
@@ -492,6 +866,9 @@ func (d *DynamicCache) gvrFromKey(ctx context.Context, key store.Key) (schema.Gr
 	return gvr, nil
 }
 
+// CreateOrUpdateFromHandler applies the resources in input. How resources are
+// applied is configured with store.WithApplyOptions. Each document's result is
+// returned, even if applying it failed.
 func CreateOrUpdateFromHandler(
 	ctx context.Context, namespace, input string,
 	get func(context.Context, store.Key) (*unstructured.Unstructured, error),
@@ -518,96 +895,57 @@ func CreateOrUpdateFromHandler(
 		}
 	}
 
+	options := store.ApplyOptionsFrom(ctx)
+
 	logger := log.From(ctx)
 	var results []string
+	var applyErr error
 	err := withDoc(func(doc map[string]interface{}) error {
 		logger.Debugf("apply resource %#v", doc)
 
-		unstructuredObj := &unstructured.Unstructured{Object: doc}
-		key, err := store.KeyFromObject(unstructuredObj)
+		a, err := newApplier(ctx, namespace, doc, clusterClient)
 		if err != nil {
 			return err
 		}
-		gvr, namespaced, err := clusterClient.Resource(key.GroupVersionKind().GroupKind())
-		if err != nil {
-			return fmt.Errorf("unable to discover resource: %w", err)
-		}
-		if namespaced && key.Namespace == "" {
-			unstructuredObj.SetNamespace(namespace)
-			key.Namespace = namespace
-		}
 
-		if _, err := get(ctx, key); err != nil {
-			if !kerrors.IsNotFound(err) {
-				// unexpected error
-				return fmt.Errorf("unable to get resource: %w", err)
-			}
-
-			// create object
-			err := create(ctx, &unstructured.Unstructured{Object: doc})
-			if err != nil {
-				return fmt.Errorf("unable to create resource: %w", err)
-			}
-
-			result := fmt.Sprintf("Created %s (%s) %s", key.Kind, key.APIVersion, key.Name)
-			if namespaced {
-				result = fmt.Sprintf("%s in %s", result, key.Namespace)
-			}
-			results = append(results, result)
-
-			return nil
+		var result string
+		if options.ServerSide {
+			result, err = a.serverSideApply(ctx, get, options.Force)
+		} else {
+			result, err = a.createOrUpdate(ctx, get, create)
 		}
-
-		// update object
-		unstructuredYaml, err := sigyaml.Marshal(doc)
-		if err != nil {
-			return fmt.Errorf("unable to marshal resource as yaml: %w", err)
+		if result != "" {
+			results = append(results, result)
 		}
-		client, err := clusterClient.DynamicClient()
 		if err != nil {
-			return fmt.Errorf("unable to get dynamic client: %w", err)
-		}
-
-		withForce := true
-		if namespaced {
-			_, err = client.Resource(gvr).Namespace(key.Namespace).Patch(
-				ctx,
-				key.Name,
-				types.ApplyPatchType,
-				unstructuredYaml,
-				metav1.PatchOptions{FieldManager: "octant", Force: &withForce},
-			)
-			if err != nil {
-				return fmt.Errorf("unable to patch resource: %w", err)
-			}
-		} else {
-			_, err = client.Resource(gvr).Patch(
-				ctx,
-				key.Name,
-				types.ApplyPatchType,
-				unstructuredYaml,
-				metav1.PatchOptions{FieldManager: "octant", Force: &withForce},
-			)
-			if err != nil {
-				return fmt.Errorf("unable to patch resource: %w", err)
+			if !options.ContinueOnError {
+				return err
 			}
+			applyErr = multierror.Append(applyErr, err)
 		}
 
-		result := fmt.Sprintf("Updated %s (%s) %s", key.Kind, key.APIVersion, key.Name)
-		if namespaced {
-			result = fmt.Sprintf("%s in %s", result, key.Namespace)
-		}
-		results = append(results, result)
-
 		return nil
 	})
-	return results, err
+	if err != nil {
+		return results, err
+	}
+	return results, applyErr
 }
 
 // CreateOrUpdateFromYAML creates resources in the cluster from YAML input.
 // Resources are created in the order they are present in the YAML.
-// An error creating a resource halts resource creation.
-// A list of created resources is returned. You may have created resources AND a non-nil error.
+// An error creating a resource halts resource creation unless the apply options
+// continue on errors. A list of results is returned. You may have created resources AND a non-nil error.
 func (dc *DynamicCache) CreateOrUpdateFromYAML(ctx context.Context, namespace, input string) ([]string, error) {
+	if dc.readOnly {
+		return nil, readonly.ErrReadOnly
+	}
 	return CreateOrUpdateFromHandler(ctx, namespace, input, dc.Get, dc.Create, dc.client)
 }
+
+func dryRun(ctx context.Context) []string {
+	if store.DryRun(ctx) {
+		return []string{metav1.DryRunAll}
+	}
+	return nil
+}
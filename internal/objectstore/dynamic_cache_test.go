@@ -1 +1,100 @@
 package objectstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/internal/readonly"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func TestDynamicCache_Notify(t *testing.T) {
+	d := &DynamicCache{subscribers: map[int]subscriber{}}
+
+	notified := 0
+	cancel := d.Notify([]store.Key{
+		{Namespace: "default", APIVersion: "v1", Kind: "Pod", Selector: &labels.Set{"app": "web"}},
+	}, func() {
+		notified++
+	})
+
+	handler := d.notifyHandler()
+
+	web := testPod("web-1", "1", map[string]string{"app": "web"})
+	handler.OnAdd(web)
+	assert.Equal(t, 1, notified)
+
+	handler.OnAdd(testPod("db-1", "1", map[string]string{"app": "db"}))
+	assert.Equal(t, 1, notified, "object doesn't match")
+
+	handler.OnUpdate(web, web)
+	assert.Equal(t, 1, notified, "resync")
+
+	relabeled := testPod("web-1", "2", map[string]string{"app": "other"})
+	handler.OnUpdate(web, relabeled)
+	assert.Equal(t, 2, notified, "object no longer matches")
+
+	handler.OnDelete(cache.DeletedFinalStateUnknown{Key: "default/web-1", Obj: web})
+	assert.Equal(t, 3, notified)
+
+	cancel()
+	handler.OnDelete(web)
+	assert.Equal(t, 3, notified, "canceled")
+}
+
+func TestDynamicCache_readOnly(t *testing.T) {
+	d := &DynamicCache{}
+	ReadOnly()(d)
+
+	ctx := context.Background()
+	pod := testPod("web-1", "1", nil)
+	key, err := store.KeyFromObject(pod)
+	require.NoError(t, err)
+
+	assert.Equal(t, readonly.ErrReadOnly, d.Create(ctx, pod))
+	assert.Equal(t, readonly.ErrReadOnly, d.Update(ctx, key, func(*unstructured.Unstructured) error { return nil }))
+	assert.Equal(t, readonly.ErrReadOnly, d.Delete(ctx, key))
+
+	_, err = d.CreateOrUpdateFromYAML(ctx, "default", "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\n")
+	assert.Equal(t, readonly.ErrReadOnly, err)
+}
+
+func testPod(name, resourceVersion string, podLabels map[string]string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("v1")
+	u.SetKind("Pod")
+	u.SetNamespace("default")
+	u.SetName(name)
+	u.SetResourceVersion(resourceVersion)
+	u.SetLabels(podLabels)
+	return u
+}
+
+func TestDynamicCache_UpdateClusterClient_concurrent(t *testing.T) {
+	d := newTestDynamicCache(t, CachePolicy{}, testPod("web-1", "1", nil))
+
+	ctx := context.Background()
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+	waitForList(t, d, ctx, key)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 10; i++ {
+			_, _, _ = d.List(ctx, key)
+		}
+	}()
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, d.UpdateClusterClient(ctx, d.client))
+	}
+	<-done
+
+	waitForList(t, d, ctx, key)
+}
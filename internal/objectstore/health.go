@@ -0,0 +1,139 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"time"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const (
+	// watchBackoffInitial and watchBackoffMax mirror the backoff reflectors
+	// use between failed attempts to list and watch.
+	watchBackoffInitial = 800 * time.Millisecond
+	watchBackoffMax     = 30 * time.Second
+)
+
+// resourceHealth is the health of a resource and the informer which last
+// failed to list or watch it.
+type resourceHealth struct {
+	store.Health
+
+	informer *interuptibleInformer
+	// resourceVersion is the informer's resource version when it failed. The
+	// resource is healthy again once it changes.
+	resourceVersion string
+}
+
+// Health returns the health of the cache of key's resource.
+func (d *DynamicCache) Health(ctx context.Context, key store.Key) (store.Health, error) {
+	gvr, err := d.gvrFromKey(ctx, key)
+	if err != nil {
+		return store.Health{}, err
+	}
+
+	var health store.Health
+	for _, ik := range []informerKey{{gvr: gvr}, {gvr: gvr, metadataOnly: true}} {
+		if v, ok := d.knownInformers.Load(ik); ok && v.(*interuptibleInformer).informer.Informer().HasSynced() {
+			health.Synced = true
+		}
+	}
+
+	d.healthMu.Lock()
+	defer d.healthMu.Unlock()
+
+	if rh, ok := d.health[gvr]; ok {
+		synced := health.Synced && !rh.Forbidden
+		health = rh.Health
+		health.Synced = synced
+		if health.Stale && !health.Forbidden {
+			health.Backoff = watchBackoff(health.Failures)
+		}
+	}
+
+	return health, nil
+}
+
+// recordWatchError records that ii failed to list or watch its resource.
+func (d *DynamicCache) recordWatchError(ii *interuptibleInformer, err error, forbidden bool) {
+	d.healthMu.Lock()
+	defer d.healthMu.Unlock()
+
+	if d.health == nil {
+		d.health = map[schema.GroupVersionResource]*resourceHealth{}
+	}
+
+	rh, ok := d.health[ii.gvr]
+	if !ok {
+		rh = &resourceHealth{}
+		d.health[ii.gvr] = rh
+	}
+
+	rh.Forbidden = forbidden
+	rh.Stale = true
+	rh.LastError = err.Error()
+	rh.LastErrorTime = d.now()
+	rh.Failures++
+	rh.informer = ii
+	rh.resourceVersion = ii.informer.Informer().LastSyncResourceVersion()
+}
+
+// updateHealth marks stale resources healthy once their informer has listed
+// them again. Resources whose informer has stopped are forgotten unless they
+// are forbidden, since they will be listed again when they are next read.
+func (d *DynamicCache) updateHealth() {
+	d.healthMu.Lock()
+	defer d.healthMu.Unlock()
+
+	for gvr, rh := range d.health {
+		if rh.Forbidden {
+			continue
+		}
+
+		v, ok := d.knownInformers.Load(rh.informer.key())
+		if !ok || v.(*interuptibleInformer) != rh.informer {
+			delete(d.health, gvr)
+			continue
+		}
+
+		if rh.informer.informer.Informer().LastSyncResourceVersion() != rh.resourceVersion {
+			delete(d.health, gvr)
+		}
+	}
+}
+
+// resetHealth forgets the health of all resources. It is called when the
+// credentials change so forbidden resources are tried again.
+func (d *DynamicCache) resetHealth() {
+	d.healthMu.Lock()
+	defer d.healthMu.Unlock()
+
+	d.health = nil
+}
+
+// isForbidden returns true if err means the current credentials can't list or
+// watch a resource.
+func isForbidden(err error) bool {
+	return kerrors.IsForbidden(err) || kerrors.IsUnauthorized(err)
+}
+
+// watchBackoff returns about how long a reflector waits after failures
+// consecutive errors before listing again.
+func watchBackoff(failures int) time.Duration {
+	backoff := watchBackoffInitial
+	for i := 1; i < failures && backoff < watchBackoffMax; i++ {
+		backoff *= 2
+	}
+	if backoff > watchBackoffMax {
+		backoff = watchBackoffMax
+	}
+	return backoff
+}
@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func TestDynamicCache_Health_stale(t *testing.T) {
+	d := newTestDynamicCache(t, CachePolicy{}, testPod("web-1", "1", nil))
+
+	ctx := context.Background()
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+	waitForList(t, d, ctx, key)
+
+	health, err := d.Health(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, store.Health{Synced: true}, health)
+
+	v, ok := d.knownInformers.Load(informerKey{gvr: podsGVR})
+	require.True(t, ok)
+	ii := v.(*interuptibleInformer)
+
+	handler := d.watchErrorHandler(ctx, ii)
+	handler(nil, errors.New("connection refused"))
+	handler(nil, errors.New("connection refused"))
+
+	health, err = d.Health(ctx, key)
+	require.NoError(t, err)
+	assert.True(t, health.Synced)
+	assert.True(t, health.Stale)
+	assert.False(t, health.Forbidden)
+	assert.Equal(t, "connection refused", health.LastError)
+	assert.Equal(t, 2, health.Failures)
+	assert.Equal(t, 1600*time.Millisecond, health.Backoff)
+
+	_, ok = d.knownInformers.Load(informerKey{gvr: podsGVR})
+	assert.True(t, ok, "informers keep running after errors")
+
+	// The resource is healthy once the informer lists it again.
+	d.healthMu.Lock()
+	d.health[podsGVR].resourceVersion = "before-error"
+	d.healthMu.Unlock()
+	d.updateHealth()
+
+	health, err = d.Health(ctx, key)
+	require.NoError(t, err)
+	assert.True(t, health.Healthy())
+}
+
+func TestDynamicCache_Health_forbidden(t *testing.T) {
+	d := newTestDynamicCache(t, CachePolicy{}, testPod("web-1", "1", nil))
+
+	ctx := context.Background()
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+	waitForList(t, d, ctx, key)
+
+	v, ok := d.knownInformers.Load(informerKey{gvr: podsGVR})
+	require.True(t, ok)
+
+	forbidden := kerrors.NewForbidden(podsGVR.GroupResource(), "", errors.New("denied"))
+	d.watchErrorHandler(ctx, v.(*interuptibleInformer))(nil, forbidden)
+
+	health, err := d.Health(ctx, key)
+	require.NoError(t, err)
+	assert.True(t, health.Forbidden)
+	assert.False(t, health.Synced)
+	assert.Zero(t, health.Backoff)
+
+	require.Eventually(t, func() bool {
+		_, ok := d.knownInformers.Load(informerKey{gvr: podsGVR})
+		return !ok
+	}, 5*time.Second, 10*time.Millisecond, "forbidden informers are stopped")
+
+	d.updateHealth()
+	health, err = d.Health(ctx, key)
+	require.NoError(t, err)
+	assert.True(t, health.Forbidden, "forbidden resources wait for new credentials")
+
+	require.NoError(t, d.UpdateClusterClient(ctx, d.client))
+
+	health, err = d.Health(ctx, key)
+	require.NoError(t, err)
+	assert.True(t, health.Healthy())
+	waitForList(t, d, ctx, key)
+}
+
+func TestDynamicCache_Health_expired(t *testing.T) {
+	d := newTestDynamicCache(t, CachePolicy{}, testPod("web-1", "1", nil))
+
+	ctx := context.Background()
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+	waitForList(t, d, ctx, key)
+
+	v, ok := d.knownInformers.Load(informerKey{gvr: podsGVR})
+	require.True(t, ok)
+
+	d.watchErrorHandler(ctx, v.(*interuptibleInformer))(nil, kerrors.NewResourceExpired("too old"))
+
+	health, err := d.Health(ctx, key)
+	require.NoError(t, err)
+	assert.True(t, health.Healthy())
+}
+
+func Test_watchBackoff(t *testing.T) {
+	assert.Equal(t, 800*time.Millisecond, watchBackoff(1))
+	assert.Equal(t, 3200*time.Millisecond, watchBackoff(3))
+	assert.Equal(t, 30*time.Second, watchBackoff(10))
+}
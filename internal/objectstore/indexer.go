@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Indexer is told about the objects cached by informers, e.g. to search
+// them. Objects are *unstructured.Unstructured. Metadata-only informers only
+// provide object metadata.
+type Indexer interface {
+	// Handler returns the event handler for an informer. Informers are
+	// identified by id.
+	Handler(id string) cache.ResourceEventHandler
+	// Remove removes the objects of an informer which was stopped.
+	Remove(id string)
+}
+
+// WithIndexer adds indexer to every informer the cache starts.
+func WithIndexer(indexer Indexer) Option {
+	return func(d *DynamicCache) {
+		d.indexer = indexer
+	}
+}
+
+// addIndexer adds the cache's indexer to an informer. gvk is the type of the
+// informer's objects.
+func (d *DynamicCache) addIndexer(ii *interuptibleInformer, gvk schema.GroupVersionKind) {
+	if d.indexer == nil {
+		return
+	}
+
+	id := ii.key().String()
+	handler := d.indexer.Handler(id)
+	if ii.metadataOnly {
+		handler = convertingHandler(gvk, handler)
+	}
+	ii.informer.Informer().AddEventHandler(handler)
+	ii.onStop = func() {
+		d.indexer.Remove(id)
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// recordingIndexer records the names of the objects it is told about.
+type recordingIndexer struct {
+	mu      sync.Mutex
+	added   map[string][]string
+	removed []string
+}
+
+func (r *recordingIndexer) Handler(id string) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.added[id] = append(r.added[id], obj.(*unstructured.Unstructured).GetName())
+		},
+	}
+}
+
+func (r *recordingIndexer) Remove(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.removed = append(r.removed, id)
+}
+
+func (r *recordingIndexer) addedTo(id string) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.added[id]...)
+}
+
+func TestDynamicCache_indexer(t *testing.T) {
+	d := newTestDynamicCache(t, CachePolicy{IdleTimeout: time.Minute}, testPod("web-1", "1", nil))
+	indexer := &recordingIndexer{added: map[string][]string{}}
+	WithIndexer(indexer)(d)
+
+	now := time.Now()
+	d.now = func() time.Time { return now }
+
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+	waitForList(t, d, context.Background(), key)
+
+	id := informerKey{gvr: podsGVR}.String()
+	require.Eventually(t, func() bool {
+		return len(indexer.addedTo(id)) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"web-1"}, indexer.addedTo(id))
+
+	now = now.Add(2 * time.Minute)
+	d.informerMu.Lock()
+	d.evictInformers()
+	d.informerMu.Unlock()
+
+	indexer.mu.Lock()
+	defer indexer.mu.Unlock()
+	assert.Equal(t, []string{id}, indexer.removed, "objects of evicted informers are removed")
+}
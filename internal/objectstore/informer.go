@@ -1,16 +1,65 @@
 package objectstore
 
 import (
+	"sync/atomic"
+	"time"
+
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/informers"
 )
 
+// informerKey identifies an informer. Resources can have a full informer and
+// a metadata-only informer.
+type informerKey struct {
+	gvr          schema.GroupVersionResource
+	metadataOnly bool
+}
+
+// String returns a description of the informer.
+func (k informerKey) String() string {
+	if k.metadataOnly {
+		return k.gvr.String() + " (metadata only)"
+	}
+	return k.gvr.String()
+}
+
 type interuptibleInformer struct {
-	stopCh   chan struct{}
-	informer informers.GenericInformer
-	gvr      schema.GroupVersionResource
+	stopCh       chan struct{}
+	informer     informers.GenericInformer
+	gvr          schema.GroupVersionResource
+	metadataOnly bool
+
+	// pinned informers have event handlers and are never evicted.
+	pinned int32
+	// lastReadAt is when the informer was last read in unix nanoseconds.
+	lastReadAt int64
+	// onStop is called when the informer is stopped. It is optional.
+	onStop func()
 }
 
-func (i interuptibleInformer) Stop() {
+func (i *interuptibleInformer) Stop() {
 	close(i.stopCh)
+	if i.onStop != nil {
+		i.onStop()
+	}
+}
+
+func (i *interuptibleInformer) key() informerKey {
+	return informerKey{gvr: i.gvr, metadataOnly: i.metadataOnly}
+}
+
+func (i *interuptibleInformer) pin() {
+	atomic.StoreInt32(&i.pinned, 1)
+}
+
+func (i *interuptibleInformer) isPinned() bool {
+	return atomic.LoadInt32(&i.pinned) == 1
+}
+
+func (i *interuptibleInformer) read(now time.Time) {
+	atomic.StoreInt64(&i.lastReadAt, now.UnixNano())
+}
+
+func (i *interuptibleInformer) lastRead() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&i.lastReadAt))
 }
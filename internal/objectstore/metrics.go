@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/vmware-tanzu/octant/internal/metrics"
+)
+
+var (
+	informersDesc = prometheus.NewDesc(
+		"octant_informers",
+		"Number of running informers.",
+		nil, nil)
+	cachedObjectsDesc = prometheus.NewDesc(
+		"octant_informer_cached_objects",
+		"Number of objects cached by informers by resource.",
+		[]string{"resource"}, nil)
+)
+
+// caches are the running dynamic caches.
+var caches = &cacheCollector{caches: map[*DynamicCache]bool{}}
+
+func init() {
+	metrics.MustRegister(caches)
+}
+
+// cacheCollector collects the informers of running dynamic caches and the
+// number of objects they hold.
+type cacheCollector struct {
+	mu     sync.Mutex
+	caches map[*DynamicCache]bool
+}
+
+var _ prometheus.Collector = (*cacheCollector)(nil)
+
+func (c *cacheCollector) add(d *DynamicCache) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.caches[d] = true
+}
+
+func (c *cacheCollector) remove(d *DynamicCache) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.caches, d)
+}
+
+// Describe sends the descriptors of the cache metrics.
+func (c *cacheCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- informersDesc
+	ch <- cachedObjectsDesc
+}
+
+// Collect sends the cache metrics. Objects are summed across caches since
+// caches for different users watch the same resources.
+func (c *cacheCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	informers := 0
+	objects := map[string]int{}
+	for d := range c.caches {
+		d.mu.Lock()
+		d.knownInformers.Range(func(k, v interface{}) bool {
+			ii := v.(*interuptibleInformer)
+			informers++
+			objects[ii.gvr.GroupResource().String()] += len(ii.informer.Informer().GetStore().ListKeys())
+			return true
+		})
+		d.mu.Unlock()
+	}
+
+	ch <- prometheus.MustNewConstMetric(informersDesc, prometheus.GaugeValue, float64(informers))
+	for resource, count := range objects {
+		ch <- prometheus.MustNewConstMetric(cachedObjectsDesc, prometheus.GaugeValue, float64(count), resource)
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// collectionSizeTTL is how long the size of a collection is reused when
+// choosing between informers and paged lists.
+const collectionSizeTTL = time.Minute
+
+type collectionKey struct {
+	gvr       schema.GroupVersionResource
+	namespace string
+}
+
+type collectionSize struct {
+	size    int64
+	expires time.Time
+}
+
+// shouldPage returns true if key's collection should be listed a page at a
+// time from the API server. Collections are listed from their informer once it
+// has synced, however large they are.
+func (d *DynamicCache) shouldPage(ctx context.Context, key store.Key, gvr schema.GroupVersionResource) bool {
+	if d.policy.ListPageThreshold <= 0 {
+		return false
+	}
+
+	if v, ok := d.knownInformers.Load(informerKey{gvr: gvr}); ok {
+		if v.(*interuptibleInformer).informer.Informer().HasSynced() {
+			return false
+		}
+	}
+
+	size, err := d.collectionSize(ctx, gvr, key.Namespace)
+	if err != nil {
+		log.From(ctx).WithErr(err).Debugf("unable to get size of %s", gvr)
+		return false
+	}
+	return size > int64(d.policy.ListPageThreshold)
+}
+
+// collectionSize returns the number of objects in a collection. It is found
+// by listing a single object and reading the number of remaining objects. If
+// the API server doesn't count them, the collection is assumed to be large.
+func (d *DynamicCache) collectionSize(ctx context.Context, gvr schema.GroupVersionResource, namespace string) (int64, error) {
+	ck := collectionKey{gvr: gvr, namespace: namespace}
+
+	d.sizesMu.Lock()
+	cached, ok := d.sizes[ck]
+	d.sizesMu.Unlock()
+	if ok && d.now().Before(cached.expires) {
+		return cached.size, nil
+	}
+
+	client, err := d.resourceClient(ctx, gvr, namespace)
+	if err != nil {
+		return 0, err
+	}
+
+	list, err := client.List(ctx, metav1.ListOptions{Limit: 1})
+	if err != nil {
+		return 0, err
+	}
+
+	size := int64(len(list.Items))
+	if remaining := list.GetRemainingItemCount(); remaining != nil {
+		size += *remaining
+	} else if list.GetContinue() != "" {
+		size = math.MaxInt64
+	}
+
+	d.sizesMu.Lock()
+	if d.sizes == nil {
+		d.sizes = map[collectionKey]collectionSize{}
+	}
+	d.sizes[ck] = collectionSize{size: size, expires: d.now().Add(collectionSizeTTL)}
+	d.sizesMu.Unlock()
+
+	return size, nil
+}
+
+// listPage lists a page of key's collection from the API server. If the
+// continue token has expired or belongs to another collection, the first page
+// is listed instead. The last page has a remaining item count of zero, so it
+// can be told apart from a list of cached objects.
+func (d *DynamicCache) listPage(ctx context.Context, key store.Key, gvr schema.GroupVersionResource, page store.ListPage) (*unstructured.UnstructuredList, error) {
+	selector, err := keySelector(key)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := d.resourceClient(ctx, gvr, key.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	options := metav1.ListOptions{
+		LabelSelector: selector.String(),
+		Limit:         page.Limit,
+		Continue:      page.Continue,
+	}
+
+	list, err := client.List(ctx, options)
+	if (kerrors.IsResourceExpired(err) || kerrors.IsBadRequest(err)) && options.Continue != "" {
+		options.Continue = ""
+		list, err = client.List(ctx, options)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("list page of %s: %w", gvr, err)
+	}
+
+	if list.GetContinue() == "" && list.GetRemainingItemCount() == nil {
+		var remaining int64
+		list.SetRemainingItemCount(&remaining)
+	}
+
+	return d.policy.transformList(list), nil
+}
+
+// resourceClient returns a client for a collection which makes calls as the
+// identity in ctx.
+func (d *DynamicCache) resourceClient(ctx context.Context, gvr schema.GroupVersionResource, namespace string) (dynamic.ResourceInterface, error) {
+	dynamicClient, err := d.dynamicClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if namespace == "" {
+		return dynamicClient.Resource(gvr), nil
+	}
+	return dynamicClient.Resource(gvr).Namespace(namespace), nil
+}
@@ -0,0 +1,186 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// pagedClient is a dynamic client for pods which serves lists a page at a
+// time. The fake dynamic client ignores limits and continue tokens.
+type pagedClient struct {
+	dynamic.Interface
+
+	resource *pagedResource
+}
+
+func (c *pagedClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return c.resource
+}
+
+type pagedResource struct {
+	dynamic.NamespaceableResourceInterface
+
+	// pages are the pages of the collection keyed by their continue token.
+	pages map[string]*unstructured.UnstructuredList
+	// remaining is the remaining item count reported when one object is
+	// listed. If it is nil, only a continue token is returned.
+	remaining *int64
+	options   []metav1.ListOptions
+}
+
+func (r *pagedResource) Namespace(string) dynamic.ResourceInterface {
+	return r
+}
+
+func (r *pagedResource) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	r.options = append(r.options, opts)
+
+	if opts.Limit == 1 {
+		list := &unstructured.UnstructuredList{Items: r.pages[""].Items[:1]}
+		list.SetContinue("sized")
+		list.SetRemainingItemCount(r.remaining)
+		return list, nil
+	}
+
+	page, ok := r.pages[opts.Continue]
+	if !ok {
+		return nil, kerrors.NewResourceExpired("continue token expired")
+	}
+	return page.DeepCopy(), nil
+}
+
+func newPagedResource(remaining *int64) *pagedResource {
+	first := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+		*testPod("web-1", "1", nil),
+		*testPod("web-2", "1", nil),
+	}}
+	first.Items[0].SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "kubectl"}})
+	first.SetContinue("page-2")
+
+	second := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+		*testPod("web-3", "1", nil),
+	}}
+
+	return &pagedResource{
+		pages:     map[string]*unstructured.UnstructuredList{"": first, "page-2": second},
+		remaining: remaining,
+	}
+}
+
+func newPagedDynamicCache(t *testing.T, policy CachePolicy, resource *pagedResource) *DynamicCache {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	client := &cacheClient{dynamicClient: &pagedClient{resource: resource}}
+	d, err := NewDynamicCache(ctx, client, WithCachePolicy(policy))
+	require.NoError(t, err)
+	return d
+}
+
+func TestDynamicCache_List_paged(t *testing.T) {
+	remaining := int64(2)
+	resource := newPagedResource(&remaining)
+	d := newPagedDynamicCache(t, CachePolicy{ListPageThreshold: 2}, resource)
+
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+
+	ctx := store.WithListPage(context.Background(), store.ListPage{Limit: 2})
+	list, _, err := d.List(ctx, key)
+	require.NoError(t, err)
+	require.Len(t, list.Items, 2)
+	assert.Equal(t, "page-2", list.GetContinue())
+	assert.Nil(t, list.Items[0].GetManagedFields())
+
+	ctx = store.WithListPage(context.Background(), store.ListPage{Limit: 2, Continue: "page-2"})
+	list, _, err = d.List(ctx, key)
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "web-3", list.Items[0].GetName())
+	assert.Empty(t, list.GetContinue())
+	require.NotNil(t, list.GetRemainingItemCount())
+	assert.Equal(t, int64(0), *list.GetRemainingItemCount())
+
+	limits := 0
+	for _, options := range resource.options {
+		if options.Limit == 1 {
+			limits++
+		}
+	}
+	assert.Equal(t, 1, limits, "collection size is cached")
+
+	_, ok := d.knownInformers.Load(informerKey{gvr: podsGVR})
+	assert.False(t, ok, "paged collections aren't cached by an informer")
+}
+
+func TestDynamicCache_List_pagedExpired(t *testing.T) {
+	resource := newPagedResource(nil)
+	d := newPagedDynamicCache(t, CachePolicy{ListPageThreshold: 2}, resource)
+
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+
+	ctx := store.WithListPage(context.Background(), store.ListPage{Limit: 2, Continue: "expired"})
+	list, _, err := d.List(ctx, key)
+	require.NoError(t, err)
+	require.Len(t, list.Items, 2)
+	assert.Equal(t, "web-1", list.Items[0].GetName())
+}
+
+func TestDynamicCache_shouldPage(t *testing.T) {
+	int64Ptr := func(i int64) *int64 { return &i }
+
+	tests := []struct {
+		name      string
+		threshold int
+		remaining *int64
+		expected  bool
+	}{
+		{
+			name:      "no threshold",
+			threshold: 0,
+			remaining: int64Ptr(10),
+			expected:  false,
+		},
+		{
+			name:      "small collection",
+			threshold: 5,
+			remaining: int64Ptr(4),
+			expected:  false,
+		},
+		{
+			name:      "large collection",
+			threshold: 5,
+			remaining: int64Ptr(5),
+			expected:  true,
+		},
+		{
+			name:      "uncounted collection",
+			threshold: 5,
+			remaining: nil,
+			expected:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := newPagedDynamicCache(t, CachePolicy{ListPageThreshold: test.threshold}, newPagedResource(test.remaining))
+
+			key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+			assert.Equal(t, test.expected, d.shouldPage(context.Background(), key, podsGVR))
+		})
+	}
+}
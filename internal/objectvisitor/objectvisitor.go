@@ -67,6 +67,13 @@ func SetTypedVisitors(list []TypedVisitor) DefaultVisitorOption {
 	}
 }
 
+// SetPluginHandler sets the visitor for objects related by plugins.
+func SetPluginHandler(dtv DefaultTypedVisitor) DefaultVisitorOption {
+	return func(dv *DefaultVisitor) {
+		dv.pluginHandler = dtv
+	}
+}
+
 // DefaultVisitor is the default implementation of Visitor.
 type DefaultVisitor struct {
 	queryer   queryer.Queryer
@@ -75,6 +82,7 @@ type DefaultVisitor struct {
 
 	typedVisitors  []TypedVisitor
 	defaultHandler DefaultTypedVisitor
+	pluginHandler  DefaultTypedVisitor
 }
 
 var _ Visitor = (*DefaultVisitor)(nil)
@@ -95,6 +103,7 @@ func NewDefaultVisitor(dashConfig config.Dash, q queryer.Queryer, options ...Def
 			NewValidatingWebhookConfiguration(dashConfig.ObjectStore()),
 		},
 		defaultHandler: NewObject(dashConfig, q),
+		pluginHandler:  NewPlugin(dashConfig.PluginManager(), dashConfig.ObjectStore()),
 	}
 
 	for _, option := range options {
@@ -157,7 +166,8 @@ func (dv *DefaultVisitor) Visit(ctx context.Context, object *unstructured.Unstru
 }
 
 // visitObject visits an object. If the object is a service, ingress, or pod, it
-// also runs custom visitor code for them.
+// also runs custom visitor code for them. Objects plugins have declared relationships
+// for are visited as well.
 func (dv *DefaultVisitor) visitObject(ctx context.Context, object runtime.Object, handler ObjectHandler, visitDescendants bool) error {
 	ctx, span := trace.StartSpan(ctx, "visitObject")
 	defer span.End()
@@ -190,5 +200,11 @@ func (dv *DefaultVisitor) visitObject(ctx context.Context, object runtime.Object
 		}
 	}
 
+	if dv.pluginHandler != nil {
+		if err := dv.pluginHandler.Visit(ctx, u, handler, dv, visitDescendants); err != nil {
+			return err
+		}
+	}
+
 	return dv.defaultHandler.Visit(ctx, u, handler, dv, visitDescendants)
 }
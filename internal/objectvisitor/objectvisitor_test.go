@@ -18,6 +18,7 @@ import (
 	ovFake "github.com/vmware-tanzu/octant/internal/objectvisitor/fake"
 	queryerFake "github.com/vmware-tanzu/octant/internal/queryer/fake"
 	"github.com/vmware-tanzu/octant/internal/testutil"
+	pluginFake "github.com/vmware-tanzu/octant/pkg/plugin/fake"
 	objectStoreFake "github.com/vmware-tanzu/octant/pkg/store/fake"
 )
 
@@ -30,6 +31,9 @@ func TestDefaultVisitor_Visit_use_typed_visitor(t *testing.T) {
 	objectStore := objectStoreFake.NewMockStore(controller)
 	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
 
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	dashConfig.EXPECT().PluginManager().Return(pluginManager).AnyTimes()
+
 	pod := testutil.CreatePod("pod")
 	unstructuredPod := testutil.ToUnstructured(t, pod)
 
@@ -47,9 +51,14 @@ func TestDefaultVisitor_Visit_use_typed_visitor(t *testing.T) {
 		Visit(gomock.Any(), unstructuredPod, handler, gomock.Any(), true)
 	tvList := []objectvisitor.TypedVisitor{tv}
 
+	pluginHandler := ovFake.NewMockDefaultTypedVisitor(controller)
+	pluginHandler.EXPECT().
+		Visit(gomock.Any(), unstructuredPod, handler, gomock.Any(), true).Return(nil)
+
 	dv, err := objectvisitor.NewDefaultVisitor(dashConfig, q,
 		objectvisitor.SetDefaultHandler(defaultHandler),
-		objectvisitor.SetTypedVisitors(tvList))
+		objectvisitor.SetTypedVisitors(tvList),
+		objectvisitor.SetPluginHandler(pluginHandler))
 	require.NoError(t, err)
 
 	ctx := context.Background()
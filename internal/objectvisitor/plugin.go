@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectvisitor
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+	"golang.org/x/sync/errgroup"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// Plugin is a visitor for objects plugins have declared relationships for.
+type Plugin struct {
+	pluginManager plugin.ManagerInterface
+	objectStore   store.Store
+}
+
+var _ DefaultTypedVisitor = (*Plugin)(nil)
+
+// NewPlugin creates an instance of Plugin.
+func NewPlugin(pluginManager plugin.ManagerInterface, objectStore store.Store) *Plugin {
+	return &Plugin{
+		pluginManager: pluginManager,
+		objectStore:   objectStore,
+	}
+}
+
+// Visit visits an object. It asks plugins for the owners and related objects of the object.
+func (p *Plugin) Visit(ctx context.Context, object *unstructured.Unstructured, handler ObjectHandler, visitor Visitor, visitDescendants bool) error {
+	ctx, span := trace.StartSpan(ctx, "visitPlugin")
+	defer span.End()
+
+	if p.pluginManager == nil {
+		return nil
+	}
+
+	if p.objectStore == nil {
+		return errors.New("objectStore is nil")
+	}
+
+	// A failing plugin shouldn't break the graph the other visitors built.
+	resp, err := p.pluginManager.RelatedObjects(ctx, object)
+	if err != nil {
+		log.From(ctx).WithErr(err).Errorf("find plugin related objects for %s", kubernetes.PrintObject(object))
+		return nil
+	}
+
+	var g errgroup.Group
+
+	for i := range resp.Owners {
+		key := resp.Owners[i]
+		g.Go(func() error {
+			return p.visitKey(ctx, object, key, handler, visitor, false)
+		})
+	}
+
+	if visitDescendants {
+		for i := range resp.Related {
+			key := resp.Related[i]
+			g.Go(func() error {
+				return p.visitKey(ctx, object, key, handler, visitor, true)
+			})
+		}
+	}
+
+	return g.Wait()
+}
+
+func (p *Plugin) visitKey(ctx context.Context, object *unstructured.Unstructured, key store.Key, handler ObjectHandler, visitor Visitor, visitDescendants bool) error {
+	objects, err := p.objects(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	for _, related := range objects {
+		if err := visitor.Visit(ctx, related, handler, visitDescendants); err != nil {
+			return errors.Wrapf(err, "plugin visit %s for %s",
+				kubernetes.PrintObject(related), kubernetes.PrintObject(object))
+		}
+
+		if err := handler.AddEdge(ctx, object, related); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// objects returns the objects for a key. Keys without a name return all the
+// objects they select.
+func (p *Plugin) objects(ctx context.Context, key store.Key) ([]*unstructured.Unstructured, error) {
+	if key.Name != "" {
+		object, err := p.objectStore.Get(ctx, key)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		if object == nil {
+			return nil, nil
+		}
+
+		return []*unstructured.Unstructured{object}, nil
+	}
+
+	list, _, err := p.objectStore.List(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []*unstructured.Unstructured
+	for i := range list.Items {
+		objects = append(objects, &list.Items[i])
+	}
+
+	return objects, nil
+}
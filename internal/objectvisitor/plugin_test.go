@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectvisitor_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vmware-tanzu/octant/internal/objectvisitor"
+	"github.com/vmware-tanzu/octant/internal/objectvisitor/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+	pluginFake "github.com/vmware-tanzu/octant/pkg/plugin/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	objectStoreFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestPlugin_Visit(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	owner := testutil.ToUnstructured(t, testutil.CreateConfigMap("owner"))
+	pod1 := testutil.ToUnstructured(t, testutil.CreatePod("pod1"))
+	pod2 := testutil.ToUnstructured(t, testutil.CreatePod("pod2"))
+
+	object := testutil.ToUnstructured(t, testutil.CreateSecret("secret"))
+
+	ownerKey := store.Key{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Namespace:  owner.GetNamespace(),
+		Name:       owner.GetName(),
+	}
+	relatedKey := store.Key{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Namespace:  pod1.GetNamespace(),
+		Selector:   &labels.Set{"app": "app"},
+	}
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	pluginManager.EXPECT().
+		RelatedObjects(gomock.Any(), object).
+		Return(&plugin.RelatedObjectsResponse{
+			Owners:  []store.Key{ownerKey},
+			Related: []store.Key{relatedKey},
+		}, nil)
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), ownerKey).
+		Return(owner, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), relatedKey).
+		Return(testutil.ToUnstructuredList(t, testutil.CreatePod("pod1"), testutil.CreatePod("pod2")), false, nil)
+
+	handler := fake.NewMockObjectHandler(controller)
+	handler.EXPECT().AddEdge(gomock.Any(), object, owner).Return(nil)
+	handler.EXPECT().AddEdge(gomock.Any(), object, pod1).Return(nil)
+	handler.EXPECT().AddEdge(gomock.Any(), object, pod2).Return(nil)
+
+	var visited []unstructured.Unstructured
+	var mu sync.Mutex
+	visitor := fake.NewMockVisitor(controller)
+	visitor.EXPECT().
+		Visit(gomock.Any(), gomock.Any(), handler, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, object *unstructured.Unstructured, handler objectvisitor.ObjectHandler, _ bool) error {
+			mu.Lock()
+			defer mu.Unlock()
+			visited = append(visited, *object)
+			return nil
+		}).
+		Times(3)
+
+	p := objectvisitor.NewPlugin(pluginManager, objectStore)
+
+	ctx := context.Background()
+	err := p.Visit(ctx, object, handler, visitor, true)
+	assert.NoError(t, err)
+
+	sortObjectsByName(t, visited)
+
+	expected := testutil.ToUnstructuredList(t, testutil.CreateConfigMap("owner"), testutil.CreatePod("pod1"), testutil.CreatePod("pod2"))
+	assert.Equal(t, expected.Items, visited)
+}
+
+func TestPlugin_Visit_no_descendants(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	object := testutil.ToUnstructured(t, testutil.CreateSecret("secret"))
+
+	relatedKey := store.Key{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Namespace:  object.GetNamespace(),
+		Name:       "pod",
+	}
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	pluginManager.EXPECT().
+		RelatedObjects(gomock.Any(), object).
+		Return(&plugin.RelatedObjectsResponse{
+			Related: []store.Key{relatedKey},
+		}, nil)
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	handler := fake.NewMockObjectHandler(controller)
+	visitor := fake.NewMockVisitor(controller)
+
+	p := objectvisitor.NewPlugin(pluginManager, objectStore)
+
+	ctx := context.Background()
+	err := p.Visit(ctx, object, handler, visitor, false)
+	assert.NoError(t, err)
+}
+
+func TestPlugin_Visit_plugin_error(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	object := testutil.ToUnstructured(t, testutil.CreateSecret("secret"))
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	pluginManager.EXPECT().
+		RelatedObjects(gomock.Any(), object).
+		Return(nil, fmt.Errorf("plugin failed"))
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	handler := fake.NewMockObjectHandler(controller)
+	visitor := fake.NewMockVisitor(controller)
+
+	p := objectvisitor.NewPlugin(pluginManager, objectStore)
+
+	ctx := context.Background()
+	err := p.Visit(ctx, object, handler, visitor, true)
+	assert.NoError(t, err)
+}
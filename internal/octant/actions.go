@@ -1,14 +1,9 @@
 package octant
 
 import (
-	"fmt"
 	"time"
 
-	"k8s.io/apimachinery/pkg/api/meta"
-	"k8s.io/apimachinery/pkg/runtime"
-
 	"github.com/vmware-tanzu/octant/pkg/action"
-	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
 const (
@@ -23,6 +18,13 @@ const (
 	ActionDeploymentConfiguration = "action.octant.dev/deploymentConfiguration"
 	ActionUpdateObject            = "action.octant.dev/update"
 	ActionApplyYaml               = "action.octant.dev/apply"
+	ActionPreviewDeleteObject     = "action.octant.dev/previewDeleteObject"
+	ActionPreviewApplyYaml        = "action.octant.dev/previewApply"
+	ActionImportKubeConfig        = "action.octant.dev/importKubeConfig"
+	ActionEditKubeContext         = "action.octant.dev/editKubeContext"
+	ActionDeleteKubeContext       = "action.octant.dev/deleteKubeContext"
+	ActionPinObject               = "action.octant.dev/pinObject"
+	ActionUnpinObject             = "action.octant.dev/unpinObject"
 )
 
 func sendAlert(alerter action.Alerter, alertType action.AlertType, message string, expiration *time.Time) {
@@ -34,39 +36,3 @@ func sendAlert(alerter action.Alerter, alertType action.AlertType, message strin
 
 	alerter.SendAlert(alert)
 }
-
-func DeleteObjectConfirmationButton(object runtime.Object) (component.ButtonOption, error) {
-	if object == nil {
-		return nil, fmt.Errorf("object is nil")
-	}
-	_, kind := object.GetObjectKind().GroupVersionKind().ToAPIVersionAndKind()
-
-	accessor, err := meta.Accessor(object)
-	if err != nil {
-		return nil, err
-	}
-
-	confirmationTitle := fmt.Sprintf("Delete %s", kind)
-	confirmationBody := fmt.Sprintf("Are you sure you want to delete *%s* **%s**? This action is permanent and cannot be recovered.", kind, accessor.GetName())
-	return component.WithButtonConfirmation(confirmationTitle, confirmationBody), nil
-}
-
-func DeleteObjectConfirmation(object runtime.Object) (*component.Confirmation, error) {
-	if object == nil {
-		return nil, fmt.Errorf("object is nil")
-	}
-	_, kind := object.GetObjectKind().GroupVersionKind().ToAPIVersionAndKind()
-
-	accessor, err := meta.Accessor(object)
-	if err != nil {
-		return nil, err
-	}
-
-	confirmationTitle := fmt.Sprintf("Delete %s", kind)
-	confirmationBody := fmt.Sprintf("Are you sure you want to delete *%s* **%s**? This action is permanent and cannot be recovered.", kind, accessor.GetName())
-
-	return &component.Confirmation{
-		Title: confirmationTitle,
-		Body:  confirmationBody,
-	}, nil
-}
@@ -8,6 +8,7 @@ package octant
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -47,7 +48,7 @@ func (p *ApplyYaml) Handle(ctx context.Context, alerter action.Alerter, payload
 	}
 	p.logger.Debugf("%s", request)
 
-	results, err := p.objectStore.CreateOrUpdateFromYAML(ctx, request.Namespace, request.Update)
+	results, err := p.objectStore.CreateOrUpdateFromYAML(request.withOptions(ctx), request.Namespace, request.Update)
 	if err != nil {
 		p.logger.Warnf("unable to apply yaml: %s", err)
 		message := fmt.Sprintf("Unable to apply yaml: %s", err)
@@ -62,16 +63,80 @@ func (p *ApplyYaml) Handle(ctx context.Context, alerter action.Alerter, payload
 		message := results[0]
 		alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
 	default:
-		// TODO(scothis) send detailed results to client
-		message := fmt.Sprintf("Applied %d resources", len(results))
+		message := fmt.Sprintf("Applied %d resources: %s", len(results), strings.Join(results, "; "))
 		alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
 	}
 	return nil
 }
 
+// ApplyYamlPreviewer previews applying yaml. Resources are sent to the API
+// server as a dry run.
+type ApplyYamlPreviewer struct {
+	logger      log.Logger
+	objectStore store.Store
+}
+
+var _ action.Dispatcher = (*ApplyYamlPreviewer)(nil)
+
+// NewApplyYamlPreviewer creates an instance of ApplyYamlPreviewer
+func NewApplyYamlPreviewer(logger log.Logger, objectStore store.Store) *ApplyYamlPreviewer {
+	return &ApplyYamlPreviewer{
+		logger:      logger,
+		objectStore: objectStore,
+	}
+}
+
+// ActionName returns the name of this action
+func (p *ApplyYamlPreviewer) ActionName() string {
+	return ActionPreviewApplyYaml
+}
+
+// Handle sends a preview of applying the requested yaml
+func (p *ApplyYamlPreviewer) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	p.logger.With("payload", payload).Debugf("received action payload")
+
+	request, err := applyYamlRequestFromPayload(payload)
+	if err != nil {
+		return errors.Wrap(err, "convert payload to apply yaml request")
+	}
+
+	preview := action.Preview{
+		Title: "Apply YAML",
+		Confirm: action.CreatePayload(ActionApplyYaml, map[string]interface{}{
+			"namespace":       request.Namespace,
+			"update":          request.Update,
+			"serverSide":      request.ServerSide,
+			"force":           request.Force,
+			"continueOnError": request.ContinueOnError,
+		}),
+	}
+
+	results, err := p.objectStore.CreateOrUpdateFromYAML(store.WithDryRun(request.withOptions(ctx)), request.Namespace, request.Update)
+	if err != nil {
+		preview.Error = err.Error()
+	}
+	for _, result := range results {
+		preview.Changes = append(preview.Changes, action.PreviewChange{Description: result})
+	}
+
+	return action.SendPreview(alerter, preview)
+}
+
 type applyYamlRequest struct {
-	Namespace string `json:"namespace,omitempty"`
-	Update    string `json:"update,omitempty"`
+	Namespace       string `json:"namespace,omitempty"`
+	Update          string `json:"update,omitempty"`
+	ServerSide      bool   `json:"serverSide,omitempty"`
+	Force           bool   `json:"force,omitempty"`
+	ContinueOnError bool   `json:"continueOnError,omitempty"`
+}
+
+// withOptions returns a context which applies resources as requested.
+func (req *applyYamlRequest) withOptions(ctx context.Context) context.Context {
+	return store.WithApplyOptions(ctx, store.ApplyOptions{
+		ServerSide:      req.ServerSide,
+		Force:           req.Force,
+		ContinueOnError: req.ContinueOnError,
+	})
 }
 
 func (req *applyYamlRequest) Validate() error {
@@ -97,9 +162,27 @@ func applyYamlRequestFromPayload(payload action.Payload) (*applyYamlRequest, err
 		return nil, err
 	}
 
+	serverSide, err := payload.OptionalBool("serverSide")
+	if err != nil {
+		return nil, err
+	}
+
+	force, err := payload.OptionalBool("force")
+	if err != nil {
+		return nil, err
+	}
+
+	continueOnError, err := payload.OptionalBool("continueOnError")
+	if err != nil {
+		return nil, err
+	}
+
 	req := &applyYamlRequest{
-		Namespace: namespace,
-		Update:    update,
+		Namespace:       namespace,
+		Update:          update,
+		ServerSide:      serverSide,
+		Force:           force,
+		ContinueOnError: continueOnError,
 	}
 
 	if err := req.Validate(); err != nil {
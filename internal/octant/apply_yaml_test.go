@@ -333,3 +333,57 @@ data: {
 
 	require.NoError(t, applyYaml.Handle(ctx, alerter, payload))
 }
+
+type previewRecorder struct {
+	previews []action.Preview
+}
+
+func (r *previewRecorder) SendAlert(alert action.Alert) {}
+
+func (r *previewRecorder) SendPreview(preview action.Preview) {
+	r.previews = append(r.previews, preview)
+}
+
+func TestApplyYamlPreviewer(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := fake.NewMockStore(controller)
+	objectStore.EXPECT().
+		CreateOrUpdateFromYAML(gomock.Any(), "default", "yaml").
+		Times(2).
+		DoAndReturn(func(ctx context.Context, namespace, input string) ([]string, error) {
+			assert.True(t, store.DryRun(ctx), "changes are not persisted")
+			assert.Equal(t, store.ApplyOptions{ServerSide: true}, store.ApplyOptionsFrom(ctx))
+			return []string{"Create ConfigMap (v1) greeting in default"}, fmt.Errorf("invalid")
+		})
+
+	alerter := &previewRecorder{}
+	previewer := NewApplyYamlPreviewer(log.NopLogger(), objectStore)
+
+	payload := action.CreatePayload(ActionPreviewApplyYaml, map[string]interface{}{
+		"update":     "yaml",
+		"namespace":  "default",
+		"serverSide": true,
+	})
+	require.NoError(t, previewer.Handle(context.Background(), alerter, payload))
+
+	expected := []action.Preview{
+		{
+			Title:   "Apply YAML",
+			Changes: []action.PreviewChange{{Description: "Create ConfigMap (v1) greeting in default"}},
+			Error:   "invalid",
+			Confirm: action.CreatePayload(ActionApplyYaml, map[string]interface{}{
+				"update":          "yaml",
+				"namespace":       "default",
+				"serverSide":      true,
+				"force":           false,
+				"continueOnError": false,
+			}),
+		},
+	}
+	assert.Equal(t, expected, alerter.previews)
+
+	err := previewer.Handle(context.Background(), actionFake.NewMockAlerter(controller), payload)
+	assert.Equal(t, action.ErrPreviewUnsupported, err)
+}
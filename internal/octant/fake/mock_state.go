@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 
 	octant "github.com/vmware-tanzu/octant/internal/octant"
+	preferences "github.com/vmware-tanzu/octant/internal/preferences"
 	action "github.com/vmware-tanzu/octant/pkg/action"
 )
 
@@ -105,6 +106,20 @@ func (mr *MockStateMockRecorder) GetFilters() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilters", reflect.TypeOf((*MockState)(nil).GetFilters))
 }
 
+// GetListContinue mocks base method
+func (m *MockState) GetListContinue() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListContinue")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetListContinue indicates an expected call of GetListContinue
+func (mr *MockStateMockRecorder) GetListContinue() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListContinue", reflect.TypeOf((*MockState)(nil).GetListContinue))
+}
+
 // GetNamespace mocks base method
 func (m *MockState) GetNamespace() string {
 	m.ctrl.T.Helper()
@@ -133,6 +148,20 @@ func (mr *MockStateMockRecorder) GetQueryParams() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryParams", reflect.TypeOf((*MockState)(nil).GetQueryParams))
 }
 
+// GetView mocks base method
+func (m *MockState) GetView() preferences.View {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetView")
+	ret0, _ := ret[0].(preferences.View)
+	return ret0
+}
+
+// GetView indicates an expected call of GetView
+func (mr *MockStateMockRecorder) GetView() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetView", reflect.TypeOf((*MockState)(nil).GetView))
+}
+
 // OnContentPathUpdate mocks base method
 func (m *MockState) OnContentPathUpdate(arg0 octant.ContentPathUpdateFunc) octant.UpdateCancelFunc {
 	m.ctrl.T.Helper()
@@ -221,6 +250,18 @@ func (mr *MockStateMockRecorder) SetFilters(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFilters", reflect.TypeOf((*MockState)(nil).SetFilters), arg0)
 }
 
+// SetListContinue mocks base method
+func (m *MockState) SetListContinue(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetListContinue", arg0)
+}
+
+// SetListContinue indicates an expected call of SetListContinue
+func (mr *MockStateMockRecorder) SetListContinue(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetListContinue", reflect.TypeOf((*MockState)(nil).SetListContinue), arg0)
+}
+
 // SetNamespace mocks base method
 func (m *MockState) SetNamespace(arg0 string) {
 	m.ctrl.T.Helper()
@@ -232,3 +273,15 @@ func (mr *MockStateMockRecorder) SetNamespace(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNamespace", reflect.TypeOf((*MockState)(nil).SetNamespace), arg0)
 }
+
+// SetView mocks base method
+func (m *MockState) SetView(arg0 preferences.View) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetView", arg0)
+}
+
+// SetView indicates an expected call of SetView
+func (mr *MockStateMockRecorder) SetView(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetView", reflect.TypeOf((*MockState)(nil).SetView), arg0)
+}
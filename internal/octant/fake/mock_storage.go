@@ -9,6 +9,8 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 
+	preferences "github.com/vmware-tanzu/octant/internal/preferences"
+	search "github.com/vmware-tanzu/octant/internal/search"
 	store "github.com/vmware-tanzu/octant/pkg/store"
 )
 
@@ -48,3 +50,31 @@ func (mr *MockStorageMockRecorder) ObjectStore() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectStore", reflect.TypeOf((*MockStorage)(nil).ObjectStore))
 }
+
+// Preferences mocks base method
+func (m *MockStorage) Preferences() preferences.Store {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preferences")
+	ret0, _ := ret[0].(preferences.Store)
+	return ret0
+}
+
+// Preferences indicates an expected call of Preferences
+func (mr *MockStorageMockRecorder) Preferences() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preferences", reflect.TypeOf((*MockStorage)(nil).Preferences))
+}
+
+// Search mocks base method
+func (m *MockStorage) Search() search.Searcher {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search")
+	ret0, _ := ret[0].(search.Searcher)
+	return ret0
+}
+
+// Search indicates an expected call of Search
+func (mr *MockStorageMockRecorder) Search() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockStorage)(nil).Search))
+}
@@ -46,13 +46,18 @@ func newClusterPodMetricsCRUD(clusterClient cluster.ClientInterface) (*clusterPo
 }
 
 func (c *clusterPodMetricsCRUD) Get(ctx context.Context, namespace, name string) (*unstructured.Unstructured, bool, error) {
-	client, err := c.clusterClient.DynamicClient()
+	clusterClient, err := cluster.ClientFor(ctx, c.clusterClient)
+	if err != nil {
+		return nil, false, fmt.Errorf("get cluster client: %w", err)
+	}
+
+	client, err := clusterClient.DynamicClient()
 	if err != nil {
 		return nil, false, fmt.Errorf("get dynamic client: %w", err)
 	}
 
 	options := metav1.GetOptions{}
-	object, err := client.Resource(PodMetricsResource).Namespace(namespace).Get(context.TODO(), name, options)
+	object, err := client.Resource(PodMetricsResource).Namespace(namespace).Get(ctx, name, options)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			return nil, false, nil
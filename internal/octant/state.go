@@ -8,6 +8,7 @@ package octant
 import (
 	"context"
 
+	"github.com/vmware-tanzu/octant/internal/preferences"
 	"github.com/vmware-tanzu/octant/pkg/action"
 )
 
@@ -43,6 +44,18 @@ type State interface {
 	// SetFilters replaces the current filters with a slice of filters.
 	// The slice can be empty.
 	SetFilters(filters []Filter)
+	// GetListContinue returns the continue token of the page of a paged list
+	// being viewed.
+	GetListContinue() string
+	// SetListContinue sets the continue token of the page of a paged list to
+	// view. An empty token is the first page.
+	SetListContinue(token string)
+	// GetView returns the selected view. Its name is blank if no view
+	// is selected.
+	GetView() preferences.View
+	// SetView selects a view and replaces the filters with the view's
+	// filters. A view with a blank name clears the selection.
+	SetView(view preferences.View)
 	// SetContext sets the current context.
 	SetContext(requestedContext string)
 	// Dispatch dispatches a payload for an action.
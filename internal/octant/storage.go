@@ -5,7 +5,11 @@
 
 package octant
 
-import "github.com/vmware-tanzu/octant/pkg/store"
+import (
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/internal/search"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
 
 //go:generate mockgen -destination=./fake/mock_storage.go -package=fake . Storage
 
@@ -13,4 +17,8 @@ import "github.com/vmware-tanzu/octant/pkg/store"
 type Storage interface {
 	// ObjectStore returns the object store.
 	ObjectStore() store.Store
+	// Preferences returns the per context preferences store.
+	Preferences() preferences.Store
+	// Search returns the searcher for cached objects.
+	Search() search.Searcher
 }
@@ -10,12 +10,14 @@ import (
 	"os"
 
 	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/readonly"
 	"github.com/vmware-tanzu/octant/pkg/store"
 
 	"github.com/pkg/errors"
 )
 
-// Default create a port forward instance.
+// Default create a port forward instance. Port forwards are refused if ctx is
+// read-only.
 func Default(ctx context.Context, client cluster.ClientInterface, objectStore store.Store) (PortForwarder, error) {
 	restClient, err := client.RESTClient()
 	if err != nil {
@@ -26,6 +28,8 @@ func Default(ctx context.Context, client cluster.ClientInterface, objectStore st
 		RESTClient:  restClient,
 		Config:      client.RESTConfig(),
 		ObjectStore: objectStore,
+		Client:      client,
+		ReadOnly:    readonly.Enabled(ctx),
 		PortForwarder: &DefaultPortForwarder{
 			IOStreams: IOStreams{
 				In:     os.Stdin,
@@ -22,7 +22,9 @@ import (
 	"k8s.io/client-go/rest"
 	restclient "k8s.io/client-go/rest"
 
+	"github.com/vmware-tanzu/octant/internal/cluster"
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/readonly"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/log"
 	"github.com/vmware-tanzu/octant/pkg/store"
@@ -112,6 +114,10 @@ type ServiceOptions struct {
 	Config        *restclient.Config
 	ObjectStore   store.Store
 	PortForwarder portForwarder
+	// Client is used to forward ports as the identity in a request's context.
+	Client cluster.ClientInterface
+	// ReadOnly refuses to create port forwards.
+	ReadOnly bool
 }
 
 type forwarderEvent struct {
@@ -290,7 +296,7 @@ func (s *Service) verifyPod(ctx context.Context, namespace, name string) (bool,
 // createForwarder creates a port forwarder, forwards traffic, and blocks until
 // port state information is populated.
 // Returns forwarder id.
-func (s *Service) createForwarder(alerter action.Alerter, targetRequest, podRequest CreateRequest) (string, error) {
+func (s *Service) createForwarder(alerter action.Alerter, config *restclient.Config, restClient rest.Interface, targetRequest, podRequest CreateRequest) (string, error) {
 	logger := s.logger.With("context", "PortForwardService.createForwarder")
 
 	if s.opts.PortForwarder == nil {
@@ -331,8 +337,8 @@ func (s *Service) createForwarder(alerter action.Alerter, targetRequest, podRequ
 
 	o := &s.opts
 	opts := Options{
-		Config:        o.Config,
-		RESTClient:    o.RESTClient,
+		Config:        config,
+		RESTClient:    restClient,
 		Address:       []string{"localhost"},
 		Ports:         ports,
 		PortForwarder: o.PortForwarder,
@@ -365,7 +371,7 @@ func (s *Service) createForwarder(alerter action.Alerter, targetRequest, podRequ
 	s.state.portForwards[forwarderID] = forwardState
 	s.state.Unlock()
 
-	req := o.RESTClient.Post().
+	req := restClient.Post().
 		Resource("pods").
 		Namespace(podRequest.Namespace).
 		Name(podRequest.Name).
@@ -402,6 +408,30 @@ func (s *Service) createForwarder(alerter action.Alerter, targetRequest, podRequ
 	return forwarderID, nil
 }
 
+// clientFor returns the REST configuration and client to forward ports with. Ports
+// are forwarded as the identity in ctx if it has one.
+func (s *Service) clientFor(ctx context.Context) (*restclient.Config, rest.Interface, error) {
+	if _, ok := cluster.IdentityFrom(ctx); !ok {
+		return s.opts.Config, s.opts.RESTClient, nil
+	}
+
+	if s.opts.Client == nil {
+		return nil, nil, errors.New("port forwarder is unable to impersonate users")
+	}
+
+	client, err := cluster.ClientFor(ctx, s.opts.Client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	restClient, err := client.RESTClient()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client.RESTConfig(), restClient, nil
+}
+
 // responseForCreate creates a create response based on the state for the specified forward (by id)
 func (s *Service) responseForCreate(id string) (CreateResponse, error) {
 	var response CreateResponse
@@ -499,6 +529,10 @@ func (s *Service) Create(ctx context.Context, alerter action.Alerter, gvk schema
 	logger := s.logger.With("context", "PortForwardService.Create")
 	req := newForwardRequest(gvk, name, namespace, remotePort)
 
+	if s.opts.ReadOnly {
+		return emptyPortForwardResponse, readonly.ErrReadOnly
+	}
+
 	if err := s.validateCreateRequest(req); err != nil {
 		return emptyPortForwardResponse, errors.Wrap(err, "invalid request")
 	}
@@ -519,7 +553,12 @@ func (s *Service) Create(ctx context.Context, alerter action.Alerter, gvk schema
 	podReq.Name = podName
 	podReq.Kind = "Pod"
 
-	id, err := s.createForwarder(alerter, req, CreateRequest{
+	config, restClient, err := s.clientFor(ctx)
+	if err != nil {
+		return emptyPortForwardResponse, errors.Wrap(err, "get cluster client")
+	}
+
+	id, err := s.createForwarder(alerter, config, restClient, req, CreateRequest{
 		Namespace:  req.Namespace,
 		APIVersion: req.APIVersion,
 		Kind:       "Pod",
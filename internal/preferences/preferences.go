@@ -0,0 +1,322 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package preferences stores a user's preferences for each kubeconfig context
+// so they are restored when Octant restarts or the context is switched back.
+package preferences
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// Filter is a saved label filter.
+type Filter struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// View is a named group of namespaces and label filters. Lists in one of its
+// namespaces show objects from all of them.
+type View struct {
+	Name       string   `json:"name"`
+	Namespaces []string `json:"namespaces"`
+	Filters    []Filter `json:"filters,omitempty"`
+}
+
+// HasNamespace returns true if namespace is one of the view's namespaces.
+func (v View) HasNamespace(namespace string) bool {
+	for _, name := range v.Namespaces {
+		if name == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+func (v View) copy() View {
+	out := v
+	out.Namespaces = append([]string(nil), v.Namespaces...)
+	out.Filters = append([]Filter(nil), v.Filters...)
+	return out
+}
+
+// ObjectReference identifies a pinned object.
+type ObjectReference struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+// ObjectReferenceFromKey creates an ObjectReference from an object store key.
+func ObjectReferenceFromKey(key store.Key) ObjectReference {
+	return ObjectReference{
+		APIVersion: key.APIVersion,
+		Kind:       key.Kind,
+		Namespace:  key.Namespace,
+		Name:       key.Name,
+	}
+}
+
+// Context is the preferences for a kubeconfig context.
+type Context struct {
+	// Namespace is the namespace which was last selected.
+	Namespace string `json:"namespace,omitempty"`
+	// ContentPath is the page which was last visited.
+	ContentPath string `json:"contentPath,omitempty"`
+	// Filters are the label filters which were last applied.
+	Filters []Filter `json:"filters,omitempty"`
+	// FavoriteNamespaces are listed first in the namespace selector.
+	FavoriteNamespaces []string `json:"favoriteNamespaces,omitempty"`
+	// PinnedObjects are objects the user wants quick access to.
+	PinnedObjects []ObjectReference `json:"pinnedObjects,omitempty"`
+	// HiddenColumns are the hidden columns of tables keyed by table title.
+	HiddenColumns map[string][]string `json:"hiddenColumns,omitempty"`
+	// Views are the saved views.
+	Views []View `json:"views,omitempty"`
+	// View is the name of the view which was last selected.
+	View string `json:"view,omitempty"`
+	// Plugins are values stored by plugins. Plugins should prefix keys
+	// with their name.
+	Plugins map[string]string `json:"plugins,omitempty"`
+}
+
+// IsFavoriteNamespace returns true if namespace is a favorite.
+func (c Context) IsFavoriteNamespace(namespace string) bool {
+	for _, favorite := range c.FavoriteNamespaces {
+		if favorite == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPinned returns true if the object is pinned.
+func (c Context) IsPinned(ref ObjectReference) bool {
+	for _, pinned := range c.PinnedObjects {
+		if pinned == ref {
+			return true
+		}
+	}
+	return false
+}
+
+// Pin pins an object. Objects are only pinned once.
+func (c *Context) Pin(ref ObjectReference) {
+	if c.IsPinned(ref) {
+		return
+	}
+	c.PinnedObjects = append(c.PinnedObjects, ref)
+}
+
+// Unpin unpins an object.
+func (c *Context) Unpin(ref ObjectReference) {
+	var pinned []ObjectReference
+	for _, existing := range c.PinnedObjects {
+		if existing != ref {
+			pinned = append(pinned, existing)
+		}
+	}
+	c.PinnedObjects = pinned
+}
+
+// SetHiddenColumns sets the hidden columns of a table. Tables without hidden
+// columns are removed.
+func (c *Context) SetHiddenColumns(table string, columns []string) {
+	if len(columns) == 0 {
+		delete(c.HiddenColumns, table)
+		return
+	}
+	if c.HiddenColumns == nil {
+		c.HiddenColumns = map[string][]string{}
+	}
+	c.HiddenColumns[table] = append([]string{}, columns...)
+}
+
+// FindView returns the view with a name.
+func (c Context) FindView(name string) (View, bool) {
+	for _, view := range c.Views {
+		if view.Name == name {
+			return view.copy(), true
+		}
+	}
+	return View{}, false
+}
+
+// SaveView saves a view, replacing any view with the same name.
+func (c *Context) SaveView(view View) {
+	view = view.copy()
+	for i := range c.Views {
+		if c.Views[i].Name == view.Name {
+			c.Views[i] = view
+			return
+		}
+	}
+	c.Views = append(c.Views, view)
+}
+
+// DeleteView deletes a view. If it was selected, no view is selected.
+func (c *Context) DeleteView(name string) {
+	var views []View
+	for _, view := range c.Views {
+		if view.Name != name {
+			views = append(views, view)
+		}
+	}
+	c.Views = views
+	if c.View == name {
+		c.View = ""
+	}
+}
+
+// SetPlugin sets a plugin value. Blank values are removed.
+func (c *Context) SetPlugin(key, value string) {
+	if value == "" {
+		delete(c.Plugins, key)
+		return
+	}
+	if c.Plugins == nil {
+		c.Plugins = map[string]string{}
+	}
+	c.Plugins[key] = value
+}
+
+func (c Context) copy() Context {
+	out := c
+	out.Filters = append([]Filter(nil), c.Filters...)
+	out.FavoriteNamespaces = append([]string(nil), c.FavoriteNamespaces...)
+	out.PinnedObjects = append([]ObjectReference(nil), c.PinnedObjects...)
+	out.Views = nil
+	for _, view := range c.Views {
+		out.Views = append(out.Views, view.copy())
+	}
+	out.HiddenColumns = nil
+	for table, columns := range c.HiddenColumns {
+		out.SetHiddenColumns(table, columns)
+	}
+	out.Plugins = nil
+	for key, value := range c.Plugins {
+		out.SetPlugin(key, value)
+	}
+	return out
+}
+
+// Store stores preferences for contexts.
+type Store interface {
+	// Get returns the preferences of a context. Contexts without preferences
+	// return an empty Context.
+	Get(contextName string) Context
+	// Update updates the preferences of a context and saves them.
+	Update(contextName string, fn func(c *Context)) error
+}
+
+// FileStore stores preferences in a JSON file.
+type FileStore struct {
+	path string
+
+	mu       sync.Mutex
+	contexts map[string]Context
+}
+
+var _ Store = (*FileStore)(nil)
+
+type file struct {
+	Contexts map[string]Context `json:"contexts"`
+}
+
+// NewFileStore creates an instance of FileStore and loads the preferences in
+// path. A missing file has no preferences. If path is blank, preferences are
+// only kept in memory.
+func NewFileStore(path string) (*FileStore, error) {
+	s := &FileStore{
+		path:     path,
+		contexts: map[string]Context{},
+	}
+
+	if path == "" {
+		return s, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("read preferences: %w", err)
+	}
+
+	var f file
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("parse preferences %s: %w", path, err)
+	}
+	for name, c := range f.Contexts {
+		s.contexts[name] = c
+	}
+
+	return s, nil
+}
+
+// Get returns the preferences of a context.
+func (s *FileStore) Get(contextName string) Context {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.contexts[contextName].copy()
+}
+
+// Update updates the preferences of a context and writes the file.
+func (s *FileStore) Update(contextName string, fn func(c *Context)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.contexts[contextName].copy()
+	fn(&c)
+	s.contexts[contextName] = c
+
+	return s.save()
+}
+
+// save writes the file atomically so a crash never leaves a partial file.
+func (s *FileStore) save() error {
+	if s.path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(file{Contexts: s.contexts}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode preferences: %w", err)
+	}
+
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("create preferences directory: %w", err)
+	}
+
+	f, err := ioutil.TempFile(dir, "."+filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("create temporary preferences file: %w", err)
+	}
+	tmp := f.Name()
+	defer os.Remove(tmp)
+
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("write preferences: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("write preferences: %w", err)
+	}
+
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("replace preferences: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package preferences
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "octant", "preferences.json")
+
+	s, err := NewFileStore(path)
+	require.NoError(t, err)
+	assert.Equal(t, Context{}, s.Get("dev"))
+
+	pod := ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "pod"}
+	require.NoError(t, s.Update("dev", func(c *Context) {
+		c.Namespace = "frontend"
+		c.Filters = []Filter{{Key: "app", Value: "web"}}
+		c.FavoriteNamespaces = []string{"frontend"}
+		c.Pin(pod)
+		c.Pin(pod)
+		c.SetHiddenColumns("Pods", []string{"Node"})
+		c.SetPlugin("my-plugin.mode", "compact")
+		c.SaveView(View{Name: "payments", Namespaces: []string{"a", "b"}, Filters: []Filter{{Key: "team", Value: "payments"}}})
+		c.View = "payments"
+	}))
+
+	fi, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	entries, err := ioutil.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary files are removed")
+
+	loaded, err := NewFileStore(path)
+	require.NoError(t, err)
+
+	expected := Context{
+		Namespace:          "frontend",
+		Filters:            []Filter{{Key: "app", Value: "web"}},
+		FavoriteNamespaces: []string{"frontend"},
+		PinnedObjects:      []ObjectReference{pod},
+		HiddenColumns:      map[string][]string{"Pods": {"Node"}},
+		Plugins:            map[string]string{"my-plugin.mode": "compact"},
+		Views: []View{
+			{Name: "payments", Namespaces: []string{"a", "b"}, Filters: []Filter{{Key: "team", Value: "payments"}}},
+		},
+		View: "payments",
+	}
+	assert.Equal(t, expected, loaded.Get("dev"))
+	assert.Equal(t, Context{}, loaded.Get("prod"), "preferences are per context")
+}
+
+func TestFileStore_Get_copies(t *testing.T) {
+	s, err := NewFileStore("")
+	require.NoError(t, err)
+
+	require.NoError(t, s.Update("dev", func(c *Context) {
+		c.FavoriteNamespaces = []string{"default"}
+		c.SetHiddenColumns("Pods", []string{"Node"})
+	}))
+
+	c := s.Get("dev")
+	c.FavoriteNamespaces[0] = "changed"
+	c.HiddenColumns["Pods"][0] = "changed"
+
+	assert.Equal(t, []string{"default"}, s.Get("dev").FavoriteNamespaces)
+	assert.Equal(t, []string{"Node"}, s.Get("dev").HiddenColumns["Pods"])
+}
+
+func TestNewFileStore_invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "preferences.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte("{"), 0600))
+
+	_, err := NewFileStore(path)
+	require.Error(t, err)
+}
+
+func TestContext(t *testing.T) {
+	pod := ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "pod"}
+	service := ObjectReference{APIVersion: "v1", Kind: "Service", Namespace: "default", Name: "service"}
+
+	var c Context
+	c.Pin(pod)
+	c.Pin(service)
+	c.Unpin(pod)
+	assert.Equal(t, []ObjectReference{service}, c.PinnedObjects)
+	assert.True(t, c.IsPinned(service))
+	assert.False(t, c.IsPinned(pod))
+
+	c.SetHiddenColumns("Pods", []string{"Node"})
+	c.SetHiddenColumns("Pods", nil)
+	assert.Empty(t, c.HiddenColumns)
+
+	c.SetPlugin("key", "value")
+	c.SetPlugin("key", "")
+	assert.Empty(t, c.Plugins)
+
+	c.FavoriteNamespaces = []string{"default"}
+	assert.True(t, c.IsFavoriteNamespace("default"))
+	assert.False(t, c.IsFavoriteNamespace("other"))
+
+	c.SaveView(View{Name: "payments", Namespaces: []string{"a"}})
+	c.SaveView(View{Name: "search", Namespaces: []string{"search"}})
+	c.SaveView(View{Name: "payments", Namespaces: []string{"a", "b"}})
+	c.View = "payments"
+	view, ok := c.FindView("payments")
+	require.True(t, ok)
+	assert.Equal(t, []string{"a", "b"}, view.Namespaces, "saving replaces a view with the same name")
+	assert.True(t, view.HasNamespace("b"))
+	assert.False(t, view.HasNamespace("search"))
+	assert.Len(t, c.Views, 2)
+
+	c.DeleteView("payments")
+	_, ok = c.FindView("payments")
+	assert.False(t, ok)
+	assert.Empty(t, c.View, "deleting the selected view clears the selection")
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/vmware-tanzu/octant/internal/util/json"
 
 	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/readonly"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 
 	"path"
@@ -172,7 +173,12 @@ func (cc *ContainerConfiguration) Create() (*component.Summary, error) {
 
 	summary := component.NewSummary(fmt.Sprintf("%s %s", title, c.Name), sections...)
 
-	for _, actionFunc := range cc.actionGenerators {
+	actionGenerators := cc.actionGenerators
+	if readonly.Enabled(cc.context) {
+		actionGenerators = nil
+	}
+
+	for _, actionFunc := range actionGenerators {
 		action, err := actionFunc(cc.parent, c)
 		if err != nil {
 			logger := log.From(cc.context)
@@ -298,7 +304,7 @@ func describeContainerPorts(
 		pfs := component.PortForwardState{}
 		var port *component.Port
 
-		if isPod && cPort.Protocol == corev1.ProtocolTCP {
+		if isPod && cPort.Protocol == corev1.ProtocolTCP && !readonly.Enabled(ctx) {
 			pfs.IsForwardable = true
 		}
 
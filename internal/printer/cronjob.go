@@ -11,6 +11,7 @@ import (
 	"strconv"
 
 	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/readonly"
 
 	"github.com/pkg/errors"
 
@@ -47,8 +48,10 @@ func CronJobListHandler(ctx context.Context, list *batchv1beta1.CronJobList, opt
 		ts := c.CreationTimestamp.Time
 		row["Age"] = component.NewTimestamp(ts)
 
-		if err := addCronJobActions(c, row); err != nil {
-			return nil, err
+		if !readonly.Enabled(ctx) {
+			if err := addCronJobActions(c, row); err != nil {
+				return nil, err
+			}
 		}
 
 		if err := ot.AddRowForObject(ctx, &c, row); err != nil {
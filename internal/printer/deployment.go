@@ -10,6 +10,7 @@ import (
 	"fmt"
 
 	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/readonly"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 
 	"github.com/pkg/errors"
@@ -76,6 +77,10 @@ func DeploymentHandler(ctx context.Context, deployment *appsv1.Deployment, optio
 		return nil, err
 	}
 
+	if readonly.Enabled(ctx) {
+		dh.configFunc = readOnlyDeploymentConfig
+	}
+
 	if err := dh.Config(); err != nil {
 		return nil, errors.Wrap(err, "print deployment configuration")
 	}
@@ -336,6 +341,12 @@ func defaultDeploymentConfig(deployment *appsv1.Deployment) (*component.Summary,
 	return NewDeploymentConfiguration(deployment).Create()
 }
 
+func readOnlyDeploymentConfig(deployment *appsv1.Deployment) (*component.Summary, error) {
+	dc := NewDeploymentConfiguration(deployment)
+	dc.actionGenerators = nil
+	return dc.Create()
+}
+
 func (d *deploymentHandler) Status() error {
 	out, err := d.summaryFunc(d.deployment)
 	if err != nil {
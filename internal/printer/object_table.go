@@ -14,10 +14,24 @@ import (
 
 	"github.com/vmware-tanzu/octant/internal/objectstatus"
 	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/readonly"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
+type namespaceColumnKey struct{}
+
+// WithNamespaceColumn returns a context for printing objects from more than
+// one namespace. Object tables printed with it have a Namespace column.
+func WithNamespaceColumn(ctx context.Context) context.Context {
+	return context.WithValue(ctx, namespaceColumnKey{}, true)
+}
+
+func hasNamespaceColumn(ctx context.Context) bool {
+	enabled, _ := ctx.Value(namespaceColumnKey{}).(bool)
+	return enabled
+}
+
 // ObjectTable is a helper for creating a table containing a list of objects.
 type ObjectTable struct {
 	cols        []component.TableCol
@@ -27,6 +41,8 @@ type ObjectTable struct {
 	filters     map[string]component.TableFilter
 	sortOrder   *tableSetOrder
 	store       store.Store
+	// namespaced is true if rows have a Namespace column.
+	namespaced bool
 }
 
 // NewObjectTable creates an instance of ObjectTable.
@@ -65,6 +81,11 @@ func (ol *ObjectTable) AddRowForObject(ctx context.Context, object runtime.Objec
 		return fmt.Errorf("get accessor for object: %w", err)
 	}
 
+	if hasNamespaceColumn(ctx) && accessor.GetNamespace() != "" {
+		row["Namespace"] = component.NewText(accessor.GetNamespace())
+		ol.namespaced = true
+	}
+
 	if accessor.GetDeletionTimestamp() != nil {
 		row["_isDeleted"] = component.NewText("deleted")
 	}
@@ -82,7 +103,9 @@ func (ol *ObjectTable) AddRowForObject(ctx context.Context, object runtime.Objec
 		}
 	}
 
-	row.AddAction(gridAction)
+	if !readonly.Enabled(ctx) {
+		row.AddAction(gridAction)
+	}
 
 	ol.rows = append(ol.rows, row)
 
@@ -108,19 +131,12 @@ func objectDeleteAction(object runtime.Object) (component.GridAction, error) {
 		return component.GridAction{}, fmt.Errorf("create key from object: %w", err)
 	}
 
-	payload := key.ToActionPayload()
-
-	deleteConfirmation, err := octant.DeleteObjectConfirmation(object)
-	if err != nil {
-		return component.GridAction{}, fmt.Errorf("create delete object confirmation: %w", err)
-	}
-
+	// The preview is shown for confirmation before the object is deleted.
 	return component.GridAction{
-		Name:         "Delete",
-		ActionPath:   octant.ActionDeleteObject,
-		Payload:      payload,
-		Confirmation: deleteConfirmation,
-		Type:         component.GridActionDanger,
+		Name:       "Delete",
+		ActionPath: octant.ActionPreviewDeleteObject,
+		Payload:    key.ToActionPayload(),
+		Type:       component.GridActionDanger,
 	}, nil
 
 }
@@ -140,7 +156,14 @@ func (ol *ObjectTable) SetSortOrder(name string, reverse bool) {
 
 // ToComponent converts the ObjectTable instance to a component.
 func (ol *ObjectTable) ToComponent() (component.Component, error) {
-	table := component.NewTableWithRows(ol.title, ol.placeholder, ol.cols, ol.rows)
+	cols := ol.cols
+	if ol.namespaced && len(cols) > 0 {
+		// The namespace follows the first column, which is usually the name.
+		cols = append([]component.TableCol{cols[0]}, component.NewTableCols("Namespace")...)
+		cols = append(cols, ol.cols[1:]...)
+	}
+
+	table := component.NewTableWithRows(ol.title, ol.placeholder, cols, ol.rows)
 
 	for name, filter := range ol.filters {
 		table.AddFilter(name, filter)
@@ -15,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/readonly"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/store/fake"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
@@ -45,9 +46,11 @@ func TestObjectTable(t *testing.T) {
 	})
 
 	tests := []struct {
-		name     string
-		mutateFn func(*ObjectTable)
-		wanted   func() *component.Table
+		name            string
+		readOnly        bool
+		namespaceColumn bool
+		mutateFn        func(*ObjectTable)
+		wanted          func() *component.Table
 	}{
 		{
 			name: "no mutations",
@@ -122,10 +125,54 @@ func TestObjectTable(t *testing.T) {
 				return table
 			},
 		},
+		{
+			name:     "read-only",
+			readOnly: true,
+			mutateFn: func(table *ObjectTable) {},
+			wanted: func() *component.Table {
+				return component.NewTableWithRows("table", "placeholder", cols, []component.TableRow{
+					{
+						"A": pod1A,
+						"B": component.NewText("0"),
+					},
+					{
+						"A": pod2A,
+						"B": component.NewText("1"),
+					},
+				})
+			},
+		},
+		{
+			name:            "namespace column",
+			namespaceColumn: true,
+			mutateFn:        func(table *ObjectTable) {},
+			wanted: func() *component.Table {
+				return component.NewTableWithRows("table", "placeholder", component.NewTableCols("A", "Namespace", "B"), []component.TableRow{
+					{
+						"A":                     pod1A,
+						"Namespace":             component.NewText("namespace"),
+						"B":                     component.NewText("0"),
+						component.GridActionKey: genDeleteGA(pod1),
+					},
+					{
+						"A":                     pod2A,
+						"Namespace":             component.NewText("namespace"),
+						"B":                     component.NewText("1"),
+						component.GridActionKey: genDeleteGA(pod2),
+					},
+				})
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			ctx := context.Background()
+			if test.readOnly {
+				ctx = readonly.WithReadOnly(ctx)
+			}
+			if test.namespaceColumn {
+				ctx = WithNamespaceColumn(ctx)
+			}
 
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
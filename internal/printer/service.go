@@ -18,6 +18,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 
 	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/readonly"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
@@ -167,11 +168,13 @@ func (sc *ServiceConfiguration) Create(ctx context.Context, options Options) (*c
 
 	summary := component.NewSummary("Configuration", sections...)
 
-	configEditor, err := editServiceAction(ctx, service, options)
-	if err != nil {
-		return nil, err
+	if !readonly.Enabled(ctx) {
+		configEditor, err := editServiceAction(ctx, service, options)
+		if err != nil {
+			return nil, err
+		}
+		summary.AddAction(configEditor)
 	}
-	summary.AddAction(configEditor)
 
 	return summary, nil
 }
@@ -193,7 +196,7 @@ func (sc *ServiceConfiguration) describePorts(ctx context.Context, options Optio
 	var ports []component.Port
 	for _, servicePort := range service.Spec.Ports {
 		pfs := component.PortForwardState{
-			IsForwardable: servicePort.Protocol == corev1.ProtocolTCP,
+			IsForwardable: servicePort.Protocol == corev1.ProtocolTCP && !readonly.Enabled(ctx),
 		}
 
 		serviceTargetPortName := ""
@@ -0,0 +1,30 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package readonly tracks whether Octant may change the cluster. In read-only
+// mode, mutations are refused and controls which would make them are hidden.
+package readonly
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrReadOnly is returned when a mutation is refused.
+var ErrReadOnly = errors.New("octant is in read-only mode")
+
+type readOnlyKey struct{}
+
+// WithReadOnly returns a context for a read-only Octant. Contexts derived from it
+// are read-only as well.
+func WithReadOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlyKey{}, true)
+}
+
+// Enabled returns true if ctx is for a read-only Octant.
+func Enabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(readOnlyKey{}).(bool)
+	return enabled
+}
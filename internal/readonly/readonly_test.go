@@ -0,0 +1,21 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package readonly
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnabled(t *testing.T) {
+	assert.False(t, Enabled(context.Background()))
+
+	ctx, cancel := context.WithCancel(WithReadOnly(context.Background()))
+	defer cancel()
+	assert.True(t, Enabled(ctx), "derived contexts are read-only")
+}
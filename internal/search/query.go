@@ -0,0 +1,110 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package search
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Selector selects objects by a label or annotation. A blank value selects
+// objects which have the key.
+type Selector struct {
+	Key   string
+	Value string
+}
+
+// Query is a parsed search query. Objects match if they match every field
+// which is set. Fields with several values match if any value matches.
+type Query struct {
+	Kinds       []string
+	Namespaces  []string
+	Labels      []Selector
+	Annotations []Selector
+	Images      []string
+	Owners      []string
+	// Text are free text terms. Every term must match an object's name,
+	// labels, annotations, images or owners.
+	Text []string
+}
+
+// IsEmpty returns true if the query has no terms.
+func (q Query) IsEmpty() bool {
+	return len(q.Kinds) == 0 && len(q.Namespaces) == 0 && len(q.Labels) == 0 &&
+		len(q.Annotations) == 0 && len(q.Images) == 0 && len(q.Owners) == 0 && len(q.Text) == 0
+}
+
+// ParseQuery parses a query. Terms are separated by spaces and are either free
+// text or `field:value`, where field is one of:
+//
+//	kind:Pod               objects of a kind
+//	ns:prod                objects in a namespace (also namespace:prod)
+//	label:app=web          objects with a label (label:app for any value)
+//	annotation:team=pay    objects with an annotation (annotation:team for any value)
+//	image:nginx            objects with a container image containing nginx
+//	owner:checkout         objects with an owner whose name contains checkout
+//
+// Terms with other fields, like `nginx:1.19`, are free text. Fields without a
+// value are ignored so queries can be parsed as they are typed.
+func ParseQuery(in string) (Query, error) {
+	var q Query
+
+	for _, term := range strings.Fields(in) {
+		parts := strings.SplitN(term, ":", 2)
+		if len(parts) != 2 {
+			q.Text = append(q.Text, term)
+			continue
+		}
+
+		field, value := strings.ToLower(parts[0]), parts[1]
+		switch field {
+		case "kind", "ns", "namespace", "label", "annotation", "image", "owner":
+			if value == "" {
+				continue
+			}
+		}
+
+		switch field {
+		case "kind":
+			q.Kinds = append(q.Kinds, value)
+		case "ns", "namespace":
+			q.Namespaces = append(q.Namespaces, value)
+		case "label":
+			selector, err := parseSelector(value)
+			if err != nil {
+				return Query{}, fmt.Errorf("parse label %q: %w", value, err)
+			}
+			q.Labels = append(q.Labels, selector)
+		case "annotation":
+			selector, err := parseSelector(value)
+			if err != nil {
+				return Query{}, fmt.Errorf("parse annotation %q: %w", value, err)
+			}
+			q.Annotations = append(q.Annotations, selector)
+		case "image":
+			q.Images = append(q.Images, value)
+		case "owner":
+			q.Owners = append(q.Owners, value)
+		default:
+			q.Text = append(q.Text, term)
+		}
+	}
+
+	return q, nil
+}
+
+func parseSelector(in string) (Selector, error) {
+	parts := strings.SplitN(in, "=", 2)
+	if parts[0] == "" {
+		return Selector{}, fmt.Errorf("key is blank")
+	}
+
+	selector := Selector{Key: parts[0]}
+	if len(parts) == 2 {
+		selector.Value = parts[1]
+	}
+	return selector, nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    Query
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			in:   "  ",
+			want: Query{},
+		},
+		{
+			name: "fields",
+			in:   "kind:Pod label:app=web image:nginx ns:prod",
+			want: Query{
+				Kinds:      []string{"Pod"},
+				Namespaces: []string{"prod"},
+				Labels:     []Selector{{Key: "app", Value: "web"}},
+				Images:     []string{"nginx"},
+			},
+		},
+		{
+			name: "namespace, annotation and owner",
+			in:   "namespace:prod annotation:team owner:checkout",
+			want: Query{
+				Namespaces:  []string{"prod"},
+				Annotations: []Selector{{Key: "team"}},
+				Owners:      []string{"checkout"},
+			},
+		},
+		{
+			name: "free text",
+			in:   "web nginx:1.19",
+			want: Query{
+				Text: []string{"web", "nginx:1.19"},
+			},
+		},
+		{
+			name: "fields without values are ignored",
+			in:   "web kind:",
+			want: Query{
+				Text: []string{"web"},
+			},
+		},
+		{
+			name:    "label without key",
+			in:      "label:=web",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseQuery(test.in)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
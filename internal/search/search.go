@@ -0,0 +1,456 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package search indexes the objects cached by informers so they can be found
+// by name, labels, annotations, container images and owners.
+package search
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// Scores of a free text term matching part of an object. Results are
+// ranked by the sum of their terms' scores.
+const (
+	scoreNameExact  = 100
+	scoreNamePrefix = 60
+	scoreName       = 40
+	scoreLabel      = 20
+	scoreImage      = 20
+	scoreOwner      = 15
+	scoreAnnotation = 5
+)
+
+// Owner is an owner of an object.
+type Owner struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// Document is the indexed part of an object.
+type Document struct {
+	APIVersion  string            `json:"apiVersion"`
+	Kind        string            `json:"kind"`
+	Namespace   string            `json:"namespace,omitempty"`
+	Name        string            `json:"name"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Images      []string          `json:"images,omitempty"`
+	Owners      []Owner           `json:"owners,omitempty"`
+}
+
+// NewDocument creates a Document for an object.
+func NewDocument(object *unstructured.Unstructured) Document {
+	d := Document{
+		APIVersion:  object.GetAPIVersion(),
+		Kind:        object.GetKind(),
+		Namespace:   object.GetNamespace(),
+		Name:        object.GetName(),
+		Labels:      object.GetLabels(),
+		Annotations: indexedAnnotations(object.GetAnnotations()),
+		Images:      containerImages(object.Object),
+	}
+
+	for _, ref := range object.GetOwnerReferences() {
+		d.Owners = append(d.Owners, Owner{Kind: ref.Kind, Name: ref.Name})
+	}
+
+	return d
+}
+
+// indexedAnnotations returns the annotations which are searched. The last
+// applied configuration is a copy of the object, so it would match terms from
+// any part of it, including values users can't otherwise see.
+func indexedAnnotations(annotations map[string]string) map[string]string {
+	if _, ok := annotations[corev1.LastAppliedConfigAnnotation]; !ok {
+		return annotations
+	}
+
+	out := make(map[string]string, len(annotations)-1)
+	for key, value := range annotations {
+		if key != corev1.LastAppliedConfigAnnotation {
+			out[key] = value
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// key returns the object store key of the document's object.
+func (d Document) key() store.Key {
+	return store.Key{
+		APIVersion: d.APIVersion,
+		Kind:       d.Kind,
+		Namespace:  d.Namespace,
+		Name:       d.Name,
+	}
+}
+
+// Object returns an object identifying the document's object.
+func (d Document) Object() *unstructured.Unstructured {
+	object := &unstructured.Unstructured{}
+	object.SetAPIVersion(d.APIVersion)
+	object.SetKind(d.Kind)
+	object.SetNamespace(d.Namespace)
+	object.SetName(d.Name)
+	return object
+}
+
+// Result is a document which matched a query.
+type Result struct {
+	Document
+	Score int `json:"score"`
+}
+
+// Searcher searches objects.
+type Searcher interface {
+	// Search returns up to limit results for a query, best first. Only
+	// objects the identity in ctx can list are returned.
+	Search(ctx context.Context, query Query, limit int) []Result
+}
+
+// AccessChecker checks whether objects can be listed.
+type AccessChecker interface {
+	// CanList returns true if the identity in ctx can list the objects of
+	// key's type in key's namespace.
+	CanList(ctx context.Context, key store.Key) bool
+}
+
+// Index indexes objects from informers. It implements the indexer interface
+// of the object store.
+type Index struct {
+	mu sync.RWMutex
+	// access filters results. Informers are shared by all identities, so
+	// without it every cached object can be found.
+	access AccessChecker
+	// sources are documents keyed by the informer they came from and their
+	// object's key.
+	sources map[string]map[string]Document
+}
+
+var _ Searcher = (*Index)(nil)
+
+// NewIndex creates an instance of Index.
+func NewIndex() *Index {
+	return &Index{
+		sources: map[string]map[string]Document{},
+	}
+}
+
+// Handler returns an event handler which indexes an informer's objects.
+func (i *Index) Handler(id string) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			i.put(id, obj)
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			i.put(id, newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			object, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				return
+			}
+
+			i.mu.Lock()
+			defer i.mu.Unlock()
+			delete(i.sources[id], documentKey(object))
+		},
+	}
+}
+
+// SetAccessChecker sets the checker which filters results.
+func (i *Index) SetAccessChecker(access AccessChecker) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.access = access
+}
+
+// Remove removes the objects of an informer.
+func (i *Index) Remove(id string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	delete(i.sources, id)
+}
+
+func (i *Index) put(id string, obj interface{}) {
+	object, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	document := NewDocument(object)
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.sources[id] == nil {
+		i.sources[id] = map[string]Document{}
+	}
+	i.sources[id][documentKey(object)] = document
+}
+
+// Search returns up to limit results for a query, best first. An empty
+// query has no results.
+func (i *Index) Search(ctx context.Context, query Query, limit int) []Result {
+	if query.IsEmpty() {
+		return nil
+	}
+
+	results, access := i.find(query)
+
+	sort.Slice(results, func(a, b int) bool {
+		ra, rb := results[a], results[b]
+		if ra.Score != rb.Score {
+			return ra.Score > rb.Score
+		}
+		if ra.Kind != rb.Kind {
+			return ra.Kind < rb.Kind
+		}
+		if ra.Namespace != rb.Namespace {
+			return ra.Namespace < rb.Namespace
+		}
+		return ra.Name < rb.Name
+	})
+
+	if access != nil {
+		results = filterListable(ctx, access, results, limit)
+	}
+
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results
+}
+
+// find returns the unsorted results for a query and the access checker. The
+// checker is called without the lock since it may ask the cluster.
+func (i *Index) find(query Query) ([]Result, AccessChecker) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	// Objects can be cached by a full and a metadata-only informer. The
+	// result with the most information wins.
+	found := map[string]Result{}
+	for _, documents := range i.sources {
+		for key, document := range documents {
+			score, ok := match(query, document)
+			if !ok {
+				continue
+			}
+			if existing, ok := found[key]; ok && existing.Score >= score {
+				continue
+			}
+			found[key] = Result{Document: document, Score: score}
+		}
+	}
+
+	results := make([]Result, 0, len(found))
+	for _, result := range found {
+		results = append(results, result)
+	}
+
+	return results, i.access
+}
+
+// filterListable returns up to limit results the identity in ctx can list.
+// Access is checked once for each type and namespace.
+func filterListable(ctx context.Context, access AccessChecker, results []Result, limit int) []Result {
+	allowed := map[store.Key]bool{}
+
+	var out []Result
+	for _, result := range results {
+		if limit > 0 && len(out) == limit {
+			break
+		}
+
+		key := result.key()
+		key.Name = ""
+
+		ok, checked := allowed[key]
+		if !checked {
+			ok = access.CanList(ctx, key)
+			allowed[key] = ok
+		}
+		if ok {
+			out = append(out, result)
+		}
+	}
+
+	return out
+}
+
+// match returns the score of a document for a query. False is returned if
+// the document doesn't match.
+func match(query Query, d Document) (int, bool) {
+	if len(query.Kinds) > 0 && !anyOf(query.Kinds, func(kind string) bool { return strings.EqualFold(kind, d.Kind) }) {
+		return 0, false
+	}
+	if len(query.Namespaces) > 0 && !anyOf(query.Namespaces, func(namespace string) bool { return namespace == d.Namespace }) {
+		return 0, false
+	}
+	for _, selector := range query.Labels {
+		if !selects(selector, d.Labels) {
+			return 0, false
+		}
+	}
+	for _, selector := range query.Annotations {
+		if !selects(selector, d.Annotations) {
+			return 0, false
+		}
+	}
+	if len(query.Images) > 0 && !anyOf(query.Images, func(image string) bool { return anyContains(d.Images, image) }) {
+		return 0, false
+	}
+	if len(query.Owners) > 0 && !anyOf(query.Owners, func(owner string) bool { return anyContains(ownerNames(d.Owners), owner) }) {
+		return 0, false
+	}
+
+	// Queries without free text only filter, so every match ranks the same.
+	score := 1
+	if len(query.Text) > 0 {
+		score = 0
+	}
+	for _, term := range query.Text {
+		termScore := textScore(term, d)
+		if termScore == 0 {
+			return 0, false
+		}
+		score += termScore
+	}
+
+	return score, true
+}
+
+// textScore returns the best score of a free text term for a document.
+func textScore(term string, d Document) int {
+	term = strings.ToLower(term)
+	name := strings.ToLower(d.Name)
+
+	switch {
+	case name == term:
+		return scoreNameExact
+	case strings.HasPrefix(name, term):
+		return scoreNamePrefix
+	case strings.Contains(name, term):
+		return scoreName
+	}
+
+	for key, value := range d.Labels {
+		if containsFold(key, term) || containsFold(value, term) {
+			return scoreLabel
+		}
+	}
+	if anyContains(d.Images, term) {
+		return scoreImage
+	}
+	if anyContains(ownerNames(d.Owners), term) {
+		return scoreOwner
+	}
+	for _, value := range d.Annotations {
+		if containsFold(value, term) {
+			return scoreAnnotation
+		}
+	}
+
+	return 0
+}
+
+func selects(selector Selector, values map[string]string) bool {
+	value, ok := values[selector.Key]
+	if !ok {
+		return false
+	}
+	return selector.Value == "" || selector.Value == value
+}
+
+func anyOf(values []string, fn func(string) bool) bool {
+	for _, value := range values {
+		if fn(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func anyContains(values []string, substr string) bool {
+	return anyOf(values, func(value string) bool { return containsFold(value, substr) })
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+func ownerNames(owners []Owner) []string {
+	var names []string
+	for _, owner := range owners {
+		names = append(names, owner.Name)
+	}
+	return names
+}
+
+// documentKey identifies an object across informers.
+func documentKey(object *unstructured.Unstructured) string {
+	return strings.Join([]string{object.GetAPIVersion(), object.GetKind(), object.GetNamespace(), object.GetName()}, "/")
+}
+
+// containerImages returns the images of the containers in an object, such as
+// a pod or the pod template of a workload.
+func containerImages(object map[string]interface{}) []string {
+	seen := map[string]bool{}
+	var images []string
+
+	var walk func(value interface{})
+	walk = func(value interface{}) {
+		switch t := value.(type) {
+		case map[string]interface{}:
+			for key, child := range t {
+				switch key {
+				case "containers", "initContainers", "ephemeralContainers":
+					containers, _ := child.([]interface{})
+					for _, container := range containers {
+						c, ok := container.(map[string]interface{})
+						if !ok {
+							continue
+						}
+						if image, ok := c["image"].(string); ok && image != "" && !seen[image] {
+							seen[image] = true
+							images = append(images, image)
+						}
+					}
+				case "metadata", "status":
+					// Images are only found in specs.
+				default:
+					walk(child)
+				}
+			}
+		case []interface{}:
+			for _, child := range t {
+				walk(child)
+			}
+		}
+	}
+	walk(object)
+
+	sort.Strings(images)
+	return images
+}
@@ -0,0 +1,229 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func newObject(apiVersion, kind, namespace, name string, labels map[string]string, images ...string) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	object.SetAPIVersion(apiVersion)
+	object.SetKind(kind)
+	object.SetNamespace(namespace)
+	object.SetName(name)
+	object.SetLabels(labels)
+
+	if len(images) > 0 {
+		var containers []interface{}
+		for _, image := range images {
+			containers = append(containers, map[string]interface{}{"name": "c", "image": image})
+		}
+		spec := map[string]interface{}{"containers": containers}
+		if kind != "Pod" {
+			spec = map[string]interface{}{
+				"template": map[string]interface{}{"spec": spec},
+			}
+		}
+		object.Object["spec"] = spec
+	}
+
+	return object
+}
+
+func resultNames(results []Result) []string {
+	var names []string
+	for _, result := range results {
+		names = append(names, result.Kind+" "+result.Namespace+"/"+result.Name)
+	}
+	return names
+}
+
+func TestNewDocument(t *testing.T) {
+	object := newObject("apps/v1", "Deployment", "prod", "web", map[string]string{"app": "web"}, "nginx:1.19", "envoy")
+	object.SetAnnotations(map[string]string{
+		"team":                             "payments",
+		corev1.LastAppliedConfigAnnotation: `{"kind":"Deployment"}`,
+	})
+	object.SetOwnerReferences([]metav1.OwnerReference{{Kind: "Rollout", Name: "web-rollout"}})
+
+	want := Document{
+		APIVersion:  "apps/v1",
+		Kind:        "Deployment",
+		Namespace:   "prod",
+		Name:        "web",
+		Labels:      map[string]string{"app": "web"},
+		Annotations: map[string]string{"team": "payments"},
+		Images:      []string{"envoy", "nginx:1.19"},
+		Owners:      []Owner{{Kind: "Rollout", Name: "web-rollout"}},
+	}
+
+	assert.Equal(t, want, NewDocument(object))
+}
+
+func TestIndex_Search(t *testing.T) {
+	index := NewIndex()
+
+	pods := index.Handler("pods")
+	pods.OnAdd(newObject("v1", "Pod", "prod", "web-1", map[string]string{"app": "web"}, "nginx:1.19"))
+	pods.OnAdd(newObject("v1", "Pod", "prod", "api-1", map[string]string{"app": "api"}, "golang"))
+	pods.OnAdd(newObject("v1", "Pod", "dev", "web-1", map[string]string{"app": "web"}, "nginx:1.20"))
+
+	deployments := index.Handler("deployments")
+	deployments.OnAdd(newObject("apps/v1", "Deployment", "prod", "web", map[string]string{"app": "web"}, "nginx:1.19"))
+	deployments.OnAdd(newObject("apps/v1", "Deployment", "prod", "frontend", map[string]string{"tier": "web"}))
+
+	tests := []struct {
+		name  string
+		query string
+		limit int
+		want  []string
+	}{
+		{
+			name:  "empty query",
+			query: "",
+		},
+		{
+			name:  "fields",
+			query: "kind:Pod label:app=web image:nginx ns:prod",
+			want:  []string{"Pod prod/web-1"},
+		},
+		{
+			name:  "kinds are case insensitive",
+			query: "kind:deployment",
+			want:  []string{"Deployment prod/frontend", "Deployment prod/web"},
+		},
+		{
+			name:  "label key",
+			query: "label:tier",
+			want:  []string{"Deployment prod/frontend"},
+		},
+		{
+			name:  "text is ranked",
+			query: "web",
+			want: []string{
+				"Deployment prod/web",
+				"Pod dev/web-1",
+				"Pod prod/web-1",
+				"Deployment prod/frontend",
+			},
+		},
+		{
+			name:  "all text terms match",
+			query: "web 1.20",
+			want:  []string{"Pod dev/web-1"},
+		},
+		{
+			name:  "limit",
+			query: "web",
+			limit: 1,
+			want:  []string{"Deployment prod/web"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			q, err := ParseQuery(test.query)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.want, resultNames(index.Search(context.Background(), q, test.limit)))
+		})
+	}
+}
+
+type fakeAccessChecker struct {
+	namespaces map[string]bool
+	checks     int
+}
+
+func (f *fakeAccessChecker) CanList(_ context.Context, key store.Key) bool {
+	f.checks++
+	return f.namespaces[key.Namespace]
+}
+
+func TestIndex_Search_access(t *testing.T) {
+	index := NewIndex()
+	access := &fakeAccessChecker{namespaces: map[string]bool{"dev": true}}
+	index.SetAccessChecker(access)
+
+	pods := index.Handler("pods")
+	pods.OnAdd(newObject("v1", "Pod", "prod", "web-1", nil))
+	pods.OnAdd(newObject("v1", "Pod", "prod", "web-2", nil))
+	pods.OnAdd(newObject("v1", "Pod", "dev", "web-1", nil))
+	pods.OnAdd(newObject("v1", "Pod", "dev", "web-2", nil))
+
+	q, err := ParseQuery("web")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Pod dev/web-1"}, resultNames(index.Search(context.Background(), q, 1)))
+	assert.Equal(t, 1, access.checks)
+
+	access.checks = 0
+	assert.Equal(t, []string{"Pod dev/web-1", "Pod dev/web-2"}, resultNames(index.Search(context.Background(), q, 0)))
+	assert.Equal(t, 2, access.checks)
+}
+
+func TestIndex_Search_lastAppliedConfiguration(t *testing.T) {
+	index := NewIndex()
+
+	pod := newObject("v1", "Pod", "prod", "web-1", nil)
+	pod.SetAnnotations(map[string]string{corev1.LastAppliedConfigAnnotation: `{"secret":"hunter2"}`})
+	index.Handler("pods").OnAdd(pod)
+
+	for _, query := range []string{"hunter2", "annotation:" + corev1.LastAppliedConfigAnnotation} {
+		q, err := ParseQuery(query)
+		require.NoError(t, err)
+		assert.Empty(t, index.Search(context.Background(), q, 0), query)
+	}
+}
+
+func TestIndex_Handler(t *testing.T) {
+	index := NewIndex()
+	handler := index.Handler("pods")
+
+	q, err := ParseQuery("web")
+	require.NoError(t, err)
+
+	pod := newObject("v1", "Pod", "prod", "web-1", nil)
+	handler.OnAdd(pod)
+	assert.Equal(t, []string{"Pod prod/web-1"}, resultNames(index.Search(context.Background(), q, 0)))
+
+	updated := pod.DeepCopy()
+	updated.SetLabels(map[string]string{"app": "api"})
+	handler.OnUpdate(pod, updated)
+	results := index.Search(context.Background(), q, 0)
+	require.Len(t, results, 1)
+	assert.Equal(t, map[string]string{"app": "api"}, results[0].Labels)
+
+	handler.OnDelete(cache.DeletedFinalStateUnknown{Key: "prod/web-1", Obj: updated})
+	assert.Empty(t, index.Search(context.Background(), q, 0))
+}
+
+func TestIndex_Remove(t *testing.T) {
+	index := NewIndex()
+	index.Handler("pods").OnAdd(newObject("v1", "Pod", "prod", "web-1", nil))
+	index.Handler("metadata").OnAdd(newObject("v1", "Pod", "prod", "web-1", nil))
+
+	q, err := ParseQuery("web")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Pod prod/web-1"}, resultNames(index.Search(context.Background(), q, 0)))
+
+	index.Remove("pods")
+	assert.Len(t, index.Search(context.Background(), q, 0), 1)
+
+	index.Remove("metadata")
+	assert.Empty(t, index.Search(context.Background(), q, 0))
+}
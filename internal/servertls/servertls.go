@@ -0,0 +1,204 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package servertls configures TLS for Octant's HTTP listener.
+package servertls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// CertFileName is the name of the generated self-signed certificate.
+	CertFileName = "octant.crt"
+	// KeyFileName is the name of the generated self-signed certificate's key.
+	KeyFileName = "octant.key"
+
+	// selfSignedTTL is how long a generated certificate is valid.
+	selfSignedTTL = 365 * 24 * time.Hour
+	// renewBefore is how long before it expires a generated certificate is replaced.
+	renewBefore = 30 * 24 * time.Hour
+)
+
+// Options configures TLS.
+type Options struct {
+	// CertFile and KeyFile are a PEM encoded certificate and private key.
+	CertFile string
+	KeyFile  string
+	// SelfSigned generates a self-signed certificate if CertFile is blank.
+	SelfSigned bool
+	// Dir is where the self-signed certificate is stored so browsers only have
+	// to trust it once.
+	Dir string
+	// Hosts are the host names and IP addresses the self-signed certificate is for.
+	Hosts []string
+}
+
+// Enabled returns true if options configure TLS.
+func (o Options) Enabled() bool {
+	return o.CertFile != "" || o.KeyFile != "" || o.SelfSigned
+}
+
+// Config creates a TLS config. It returns nil if TLS is not enabled.
+func Config(options Options) (*tls.Config, error) {
+	if !options.Enabled() {
+		return nil, nil
+	}
+
+	certFile, keyFile := options.CertFile, options.KeyFile
+	if certFile == "" && keyFile == "" {
+		if options.Dir == "" {
+			return nil, fmt.Errorf("unable to find a directory to store the self-signed certificate")
+		}
+
+		var err error
+		if certFile, keyFile, err = SelfSigned(options.Dir, options.Hosts, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	if certFile == "" || keyFile == "" {
+		return nil, fmt.Errorf("TLS requires both a certificate file and a private key file")
+	}
+
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load TLS certificate: %w", err)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+		NextProtos:   []string{"http/1.1"},
+	}, nil
+}
+
+// SelfSigned returns the paths to a self-signed certificate and key in dir. The
+// certificate is reused unless it is about to expire or it isn't valid for all
+// hosts.
+func SelfSigned(dir string, hosts []string, now time.Time) (string, string, error) {
+	certFile := filepath.Join(dir, CertFileName)
+	keyFile := filepath.Join(dir, KeyFileName)
+
+	if reusable(certFile, keyFile, hosts, now) {
+		return certFile, keyFile, nil
+	}
+
+	certPEM, keyPEM, err := generate(hosts, now)
+	if err != nil {
+		return "", "", fmt.Errorf("generate self-signed certificate: %w", err)
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", "", fmt.Errorf("create certificate directory: %w", err)
+	}
+	if err := ioutil.WriteFile(keyFile, keyPEM, 0600); err != nil {
+		return "", "", fmt.Errorf("write private key: %w", err)
+	}
+	if err := ioutil.WriteFile(certFile, certPEM, 0644); err != nil {
+		return "", "", fmt.Errorf("write certificate: %w", err)
+	}
+
+	return certFile, keyFile, nil
+}
+
+func reusable(certFile, keyFile string, hosts []string, now time.Time) bool {
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return false
+	}
+
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	if err != nil {
+		return false
+	}
+
+	if now.Add(renewBefore).After(leaf.NotAfter) {
+		return false
+	}
+
+	for _, host := range hosts {
+		if leaf.VerifyHostname(host) != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func generate(hosts []string, now time.Time) ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template := x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{Organization: []string{"Octant"}, CommonName: "Octant"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(selfSignedTTL),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	return certPEM, keyPEM, nil
+}
+
+// Hosts returns the hosts a self-signed certificate for a listener should be
+// valid for.
+func Hosts(addr net.Addr) []string {
+	hosts := []string{"localhost", "127.0.0.1", "::1"}
+
+	if name, err := os.Hostname(); err == nil && name != "" {
+		hosts = append(hosts, name)
+	}
+
+	if tcpAddr, ok := addr.(*net.TCPAddr); ok && !tcpAddr.IP.IsUnspecified() && !tcpAddr.IP.IsLoopback() {
+		hosts = append(hosts, tcpAddr.IP.String())
+	}
+
+	return hosts
+}
@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package servertls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_disabled(t *testing.T) {
+	config, err := Config(Options{})
+	require.NoError(t, err)
+	assert.Nil(t, config)
+}
+
+func TestConfig_missingKey(t *testing.T) {
+	_, err := Config(Options{CertFile: "tls.crt"})
+	require.Error(t, err)
+}
+
+func TestConfig_files(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile, err := SelfSigned(dir, []string{"localhost"}, time.Now())
+	require.NoError(t, err)
+
+	config, err := Config(Options{CertFile: certFile, KeyFile: keyFile})
+	require.NoError(t, err)
+	require.Len(t, config.Certificates, 1)
+}
+
+func TestConfig_selfSigned(t *testing.T) {
+	dir := t.TempDir()
+
+	config, err := Config(Options{SelfSigned: true, Dir: dir, Hosts: []string{"localhost", "127.0.0.1"}})
+	require.NoError(t, err)
+	require.Len(t, config.Certificates, 1)
+
+	leaf := parseLeaf(t, config.Certificates[0])
+	assert.NoError(t, leaf.VerifyHostname("localhost"))
+	assert.NoError(t, leaf.VerifyHostname("127.0.0.1"))
+
+	info, err := os.Stat(filepath.Join(dir, KeyFileName))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestSelfSigned(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	hosts := []string{"localhost", "127.0.0.1"}
+
+	certFile, _, err := SelfSigned(dir, hosts, now)
+	require.NoError(t, err)
+	original := readFile(t, certFile)
+
+	_, _, err = SelfSigned(dir, hosts, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, original, readFile(t, certFile), "certificate is reused")
+
+	_, _, err = SelfSigned(dir, append(hosts, "octant.example.com"), now)
+	require.NoError(t, err)
+	replaced := readFile(t, certFile)
+	assert.NotEqual(t, original, replaced, "certificate is replaced for new hosts")
+
+	_, _, err = SelfSigned(dir, hosts, now.Add(selfSignedTTL-renewBefore+time.Hour))
+	require.NoError(t, err)
+	assert.NotEqual(t, replaced, readFile(t, certFile), "certificate is replaced before it expires")
+}
+
+func TestHosts(t *testing.T) {
+	hosts := Hosts(&net.TCPAddr{IP: net.ParseIP("10.0.0.5"), Port: 7777})
+	assert.Contains(t, hosts, "localhost")
+	assert.Contains(t, hosts, "10.0.0.5")
+
+	hosts = Hosts(&net.TCPAddr{IP: net.ParseIP("0.0.0.0"), Port: 7777})
+	assert.NotContains(t, hosts, "0.0.0.0")
+}
+
+func parseLeaf(t *testing.T, certificate tls.Certificate) *x509.Certificate {
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	require.NoError(t, err)
+	return leaf
+}
+
+func readFile(t *testing.T, name string) []byte {
+	data, err := ioutil.ReadFile(name)
+	require.NoError(t, err)
+	return data
+}
@@ -0,0 +1,142 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package jsonpatch creates RFC 6902 JSON Patch documents.
+package jsonpatch
+
+import (
+	"bytes"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+const (
+	// OpAdd adds a value.
+	OpAdd = "add"
+	// OpRemove removes a value.
+	OpRemove = "remove"
+	// OpReplace replaces a value.
+	OpReplace = "replace"
+)
+
+// Operation is a JSON Patch operation.
+type Operation struct {
+	Op    string
+	Path  string
+	Value interface{}
+}
+
+// MarshalJSON marshals the operation. Remove operations have no value.
+func (o Operation) MarshalJSON() ([]byte, error) {
+	if o.Op == OpRemove {
+		return json.Marshal(struct {
+			Op   string `json:"op"`
+			Path string `json:"path"`
+		}{o.Op, o.Path})
+	}
+
+	return json.Marshal(struct {
+		Op    string      `json:"op"`
+		Path  string      `json:"path"`
+		Value interface{} `json:"value"`
+	}{o.Op, o.Path, o.Value})
+}
+
+// Decode decodes JSON into the generic form Create compares. Numbers are
+// kept as json.Number so they don't lose precision.
+func Decode(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// Create creates the operations which turn a into b. a and b are decoded JSON
+// documents as returned by Decode. Arrays are compared by index.
+func Create(a, b interface{}) []Operation {
+	return diff("", a, b, nil)
+}
+
+func diff(path string, a, b interface{}, ops []Operation) []Operation {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			return append(ops, Operation{Op: OpReplace, Path: path, Value: b})
+		}
+
+		for _, key := range sortedKeys(av) {
+			if _, ok := bv[key]; !ok {
+				ops = append(ops, Operation{Op: OpRemove, Path: path + "/" + escape(key)})
+			}
+		}
+
+		for _, key := range sortedKeys(bv) {
+			keyPath := path + "/" + escape(key)
+			if value, ok := av[key]; ok {
+				ops = diff(keyPath, value, bv[key], ops)
+				continue
+			}
+			ops = append(ops, Operation{Op: OpAdd, Path: keyPath, Value: bv[key]})
+		}
+
+		return ops
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok {
+			return append(ops, Operation{Op: OpReplace, Path: path, Value: b})
+		}
+
+		common := len(av)
+		if len(bv) < common {
+			common = len(bv)
+		}
+
+		for i := 0; i < common; i++ {
+			ops = diff(path+"/"+strconv.Itoa(i), av[i], bv[i], ops)
+		}
+
+		// Remove from the end so the indexes of the remaining items don't change.
+		for i := len(av) - 1; i >= len(bv); i-- {
+			ops = append(ops, Operation{Op: OpRemove, Path: path + "/" + strconv.Itoa(i)})
+		}
+
+		for i := len(av); i < len(bv); i++ {
+			ops = append(ops, Operation{Op: OpAdd, Path: path + "/" + strconv.Itoa(i), Value: bv[i]})
+		}
+
+		return ops
+	default:
+		if !reflect.DeepEqual(a, b) {
+			ops = append(ops, Operation{Op: OpReplace, Path: path, Value: b})
+		}
+		return ops
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// escape escapes a key for use in a JSON pointer.
+func escape(key string) string {
+	return pointerEscaper.Replace(key)
+}
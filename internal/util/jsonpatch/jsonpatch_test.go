@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package jsonpatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+func TestCreate(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected string
+	}{
+		{
+			name:     "equal",
+			a:        `{"a":[1,{"b":2}]}`,
+			b:        `{"a":[1,{"b":2}]}`,
+			expected: `[]`,
+		},
+		{
+			name:     "object members",
+			a:        `{"a":1,"b":{"c":"d"},"e":true}`,
+			b:        `{"a":2,"b":{"c":"d","f":null},"g":[]}`,
+			expected: `[{"op":"remove","path":"/e"},{"op":"replace","path":"/a","value":2},{"op":"add","path":"/b/f","value":null},{"op":"add","path":"/g","value":[]}]`,
+		},
+		{
+			name:     "shorter array",
+			a:        `[1,2,3,4]`,
+			b:        `[1,5]`,
+			expected: `[{"op":"replace","path":"/1","value":5},{"op":"remove","path":"/3"},{"op":"remove","path":"/2"}]`,
+		},
+		{
+			name:     "longer array",
+			a:        `{"rows":[{"name":"a"}]}`,
+			b:        `{"rows":[{"name":"a"},{"name":"b"},{"name":"c"}]}`,
+			expected: `[{"op":"add","path":"/rows/1","value":{"name":"b"}},{"op":"add","path":"/rows/2","value":{"name":"c"}}]`,
+		},
+		{
+			name:     "type change",
+			a:        `{"a":{"b":1}}`,
+			b:        `{"a":[1]}`,
+			expected: `[{"op":"replace","path":"/a","value":[1]}]`,
+		},
+		{
+			name:     "escaped keys",
+			a:        `{"a/b":1,"c~d":1}`,
+			b:        `{"a/b":2,"c~d":2}`,
+			expected: `[{"op":"replace","path":"/a~1b","value":2},{"op":"replace","path":"/c~0d","value":2}]`,
+		},
+		{
+			name:     "large numbers",
+			a:        `{"a":12345678901}`,
+			b:        `{"a":12345678902}`,
+			expected: `[{"op":"replace","path":"/a","value":12345678902}]`,
+		},
+		{
+			name:     "root",
+			a:        `1`,
+			b:        `"a"`,
+			expected: `[{"op":"replace","path":"","value":"a"}]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a, err := Decode([]byte(test.a))
+			require.NoError(t, err)
+			b, err := Decode([]byte(test.b))
+			require.NoError(t, err)
+
+			ops := Create(a, b)
+			if ops == nil {
+				ops = []Operation{}
+			}
+
+			actual, err := json.Marshal(ops)
+			require.NoError(t, err)
+			assert.JSONEq(t, test.expected, string(actual))
+		})
+	}
+}
@@ -14,3 +14,12 @@ type NotFoundError struct {
 func (e *NotFoundError) Error() string {
 	return fmt.Sprintf("action path %q not found", e.Path)
 }
+
+// ReadOnlyError is returned when an action is refused because Octant is read-only.
+type ReadOnlyError struct {
+	Path string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("action path %q is not allowed in read-only mode", e.Path)
+}
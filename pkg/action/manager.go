@@ -106,9 +106,46 @@ func (d Dispatchers) ToActionPaths() map[string]DispatcherFunc {
 	return m
 }
 
+// Recorder records dispatched actions.
+type Recorder interface {
+	// Record is called after an action's dispatchers have run. err is the first
+	// error returned by a dispatcher.
+	Record(ctx context.Context, actionPath string, payload Payload, err error)
+}
+
+// ManagerOption is an option for configuring Manager.
+type ManagerOption func(m *Manager)
+
+// WithRecorder records the actions dispatched by Manager.
+func WithRecorder(recorder Recorder) ManagerOption {
+	return func(m *Manager) {
+		m.recorder = recorder
+	}
+}
+
+// WithReadOnly refuses to dispatch actions other than allowed. Actions which only
+// change what a client is viewing are always allowed.
+func WithReadOnly(allowed ...string) ManagerOption {
+	return func(m *Manager) {
+		m.readOnly = true
+		m.readOnlyAllowed = map[string]bool{
+			RequestSetNamespace: true,
+			RequestSetFilter:    true,
+			RequestSetContext:   true,
+		}
+		for _, actionPath := range allowed {
+			m.readOnlyAllowed[actionPath] = true
+		}
+	}
+}
+
 // Manager manages actions.
 type Manager struct {
-	logger log.Logger
+	logger   log.Logger
+	recorder Recorder
+
+	readOnly        bool
+	readOnlyAllowed map[string]bool
 
 	// key: string, value: []dispatcherEntry
 	dispatches sync.Map
@@ -120,11 +157,17 @@ type dispatcherEntry struct {
 }
 
 // NewManager creates an instance of Manager.
-func NewManager(logger log.Logger) *Manager {
-	return &Manager{
+func NewManager(logger log.Logger, options ...ManagerOption) *Manager {
+	m := &Manager{
 		logger:     logger.With("component", "action-manager"),
 		dispatches: sync.Map{},
 	}
+
+	for _, option := range options {
+		option(m)
+	}
+
+	return m
 }
 
 // Register registers a dispatcher function to an action path.
@@ -187,12 +230,31 @@ func (m *Manager) Dispatch(ctx context.Context, alerter Alerter, actionPath stri
 		return &NotFoundError{Path: actionPath}
 	}
 
+	if m.readOnly && !m.readOnlyAllowed[actionPath] {
+		err := &ReadOnlyError{Path: actionPath}
+		if alerter != nil {
+			alerter.SendAlert(CreateAlert(AlertTypeWarning, "Octant is in read-only mode", DefaultAlertExpiration))
+		}
+		if m.recorder != nil {
+			m.recorder.Record(ctx, actionPath, payload, err)
+		}
+		return err
+	}
+
+	var dispatchErr error
 	entries := val.([]dispatcherEntry)
 	for _, entry := range entries {
 		if err := entry.f(ctx, alerter, payload); err != nil {
 			m.logger.Errorf("actionFunc returned err: %s", err)
+			if dispatchErr == nil {
+				dispatchErr = err
+			}
 		}
 	}
 
+	if m.recorder != nil {
+		m.recorder.Record(ctx, actionPath, payload, dispatchErr)
+	}
+
 	return nil
 }
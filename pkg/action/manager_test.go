@@ -7,6 +7,7 @@ package action_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -48,3 +49,70 @@ func TestManager(t *testing.T) {
 
 	assert.True(t, payloadRan)
 }
+
+type recordedAction struct {
+	actionPath string
+	err        error
+}
+
+type fakeRecorder struct {
+	recorded []recordedAction
+}
+
+func (r *fakeRecorder) Record(ctx context.Context, actionPath string, payload action.Payload, err error) {
+	r.recorded = append(r.recorded, recordedAction{actionPath: actionPath, err: err})
+}
+
+func TestManager_recorder(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	alerter := fake.NewMockAlerter(controller)
+
+	recorder := &fakeRecorder{}
+	m := action.NewManager(log.NopLogger(), action.WithRecorder(recorder))
+
+	dispatchErr := fmt.Errorf("failed")
+	require.NoError(t, m.Register("path", "internal", func(context.Context, action.Alerter, action.Payload) error {
+		return dispatchErr
+	}))
+
+	ctx := context.Background()
+	require.NoError(t, m.Dispatch(ctx, alerter, "path", action.Payload{}))
+
+	err := m.Dispatch(ctx, alerter, "missing", action.Payload{})
+	require.Error(t, err)
+
+	expected := []recordedAction{{actionPath: "path", err: dispatchErr}}
+	assert.Equal(t, expected, recorder.recorded, "only dispatched actions are recorded")
+}
+
+func TestManager_readOnly(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	alerter := fake.NewMockAlerter(controller)
+	alerter.EXPECT().SendAlert(gomock.Any())
+
+	recorder := &fakeRecorder{}
+	m := action.NewManager(log.NopLogger(), action.WithRecorder(recorder), action.WithReadOnly("allowed"))
+
+	var ran []string
+	for _, actionPath := range []string{"path", "allowed", action.RequestSetNamespace} {
+		actionPath := actionPath
+		require.NoError(t, m.Register(actionPath, "internal", func(context.Context, action.Alerter, action.Payload) error {
+			ran = append(ran, actionPath)
+			return nil
+		}))
+	}
+
+	ctx := context.Background()
+	err := m.Dispatch(ctx, alerter, "path", action.Payload{})
+	assert.Equal(t, &action.ReadOnlyError{Path: "path"}, err)
+
+	require.NoError(t, m.Dispatch(ctx, alerter, "allowed", action.Payload{}))
+	require.NoError(t, m.Dispatch(ctx, alerter, action.RequestSetNamespace, action.Payload{}))
+
+	assert.Equal(t, []string{"allowed", action.RequestSetNamespace}, ran)
+	assert.Equal(t, recordedAction{actionPath: "path", err: err}, recorder.recorded[0], "refused actions are recorded")
+}
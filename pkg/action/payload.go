@@ -129,6 +129,22 @@ func (p Payload) Bool(key string) (bool, error) {
 	return s, nil
 }
 
+// OptionalBool returns a bool from the payload. If the bool does not exist, it
+// returns false.
+func (p Payload) OptionalBool(key string) (bool, error) {
+	i, ok := p[key]
+	if !ok || i == nil {
+		return false, nil
+	}
+
+	b, ok := i.(bool)
+	if !ok {
+		return false, errors.Errorf("payload %q is not a bool", key)
+	}
+
+	return b, nil
+}
+
 // OptionalString returns a string from the payload. If the string
 // does not exist, it returns an empty string.
 func (p Payload) OptionalString(key string) (string, error) {
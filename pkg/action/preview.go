@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package action
+
+import (
+	"errors"
+)
+
+// ErrPreviewUnsupported is returned when an action's alerter can't show previews.
+var ErrPreviewUnsupported = errors.New("previews are not supported by this client")
+
+// Preview describes the changes an action would make. Clients show previews so
+// users can confirm actions before they are performed.
+type Preview struct {
+	// Title is the title of the preview.
+	Title string `json:"title"`
+	// Changes are the changes the action would make.
+	Changes []PreviewChange `json:"changes,omitempty"`
+	// Error is set if the action would fail.
+	Error string `json:"error,omitempty"`
+	// Confirm is the payload which performs the action.
+	Confirm Payload `json:"confirm"`
+	// PropagationPolicies are the deletion propagation policies the user can
+	// choose from. The first policy is the default. The chosen policy is sent
+	// as the confirm payload's propagationPolicy.
+	PropagationPolicies []string `json:"propagationPolicies,omitempty"`
+}
+
+// PreviewChange is a change in a preview.
+type PreviewChange struct {
+	// Description describes the change.
+	Description string `json:"description"`
+	// Dependent is true if the change cascades from another change. Dependents
+	// are orphaned instead of deleted with the Orphan propagation policy.
+	Dependent bool `json:"dependent,omitempty"`
+}
+
+// Previewer sends previews.
+type Previewer interface {
+	SendPreview(preview Preview)
+}
+
+// SendPreview sends preview with alerter if it is a Previewer.
+func SendPreview(alerter Alerter, preview Preview) error {
+	previewer, ok := alerter.(Previewer)
+	if !ok {
+		return ErrPreviewUnsupported
+	}
+
+	previewer.SendPreview(preview)
+	return nil
+}
@@ -7,6 +7,7 @@ package dash
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
@@ -21,14 +22,14 @@ import (
 
 	"github.com/vmware-tanzu/octant/internal/util/path_util"
 
-	"contrib.go.opencensus.io/exporter/jaeger"
 	"github.com/skratchdot/open-golang/open"
 	"github.com/soheilhy/cmux"
 	"github.com/spf13/afero"
 	"github.com/spf13/viper"
-	"go.opencensus.io/trace"
 
 	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/audit"
+	"github.com/vmware-tanzu/octant/internal/auth"
 	"github.com/vmware-tanzu/octant/internal/cluster"
 	"github.com/vmware-tanzu/octant/internal/config"
 	ocontext "github.com/vmware-tanzu/octant/internal/context"
@@ -39,12 +40,18 @@ import (
 	"github.com/vmware-tanzu/octant/internal/module"
 	"github.com/vmware-tanzu/octant/internal/modules/applications"
 	"github.com/vmware-tanzu/octant/internal/modules/clusteroverview"
+	"github.com/vmware-tanzu/octant/internal/modules/compare"
 	"github.com/vmware-tanzu/octant/internal/modules/configuration"
 	"github.com/vmware-tanzu/octant/internal/modules/localcontent"
+	"github.com/vmware-tanzu/octant/internal/modules/multicluster"
 	"github.com/vmware-tanzu/octant/internal/modules/overview"
 	"github.com/vmware-tanzu/octant/internal/modules/workloads"
 	"github.com/vmware-tanzu/octant/internal/objectstore"
+	internalOctant "github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/portforward"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/internal/readonly"
+	"github.com/vmware-tanzu/octant/internal/search"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/log"
 	"github.com/vmware-tanzu/octant/pkg/octant"
@@ -55,7 +62,6 @@ import (
 )
 
 type Options struct {
-	EnableOpenCensus       bool
 	EnableMemStats         bool
 	DisableClusterOverview bool
 	KubeConfig             string
@@ -69,6 +75,14 @@ type Options struct {
 	UserAgent              string
 	BuildInfo              config.BuildInfo
 	Listener               net.Listener
+	Authenticator          *auth.Authenticator
+	Impersonation          *api.Impersonation
+	AuditLog               *audit.Log
+	Preferences            preferences.Store
+	ReadOnly               bool
+	Contexts               []string
+	CachePolicy            objectstore.CachePolicy
+	TLSConfig              *tls.Config
 	clusterClient          cluster.ClientInterface
 	factory                dynamicinformer.DynamicSharedInformerFactory
 }
@@ -87,15 +101,6 @@ func WithDynamicSharedInformerFactory(factory dynamicinformer.DynamicSharedInfor
 	}
 }
 
-func WithOpenCensus() RunnerOption {
-	return RunnerOption{
-		kubeConfigOption: kubeconfig.Noop(),
-		nonClusterOption: func(o *Options) {
-			o.EnableOpenCensus = true
-		},
-	}
-}
-
 func WithMemStats() RunnerOption {
 	return RunnerOption{
 		kubeConfigOption: kubeconfig.Noop(),
@@ -207,6 +212,93 @@ func WithListener(listener net.Listener) RunnerOption {
 	}
 }
 
+// WithAuthenticator requires users to authenticate. Authentication is disabled
+// if authenticator is nil.
+func WithAuthenticator(authenticator *auth.Authenticator) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.Authenticator = authenticator
+		},
+	}
+}
+
+// WithImpersonation makes cluster calls as the authenticated user instead of
+// the kube config's user. Impersonation is disabled if impersonation is nil.
+func WithImpersonation(impersonation *api.Impersonation) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.Impersonation = impersonation
+		},
+	}
+}
+
+// WithAuditLog records dispatched actions to log. An in-memory log is used if
+// log is nil.
+func WithAuditLog(log *audit.Log) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.AuditLog = log
+		},
+	}
+}
+
+// WithPreferences stores per context preferences in store. Preferences are
+// only kept in memory if store is nil.
+func WithPreferences(store preferences.Store) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.Preferences = store
+		},
+	}
+}
+
+// WithReadOnly prevents Octant from changing the cluster. Actions that change
+// the cluster are refused, and terminals and port forwards are disabled.
+func WithReadOnly() RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.ReadOnly = true
+		},
+	}
+}
+
+// WithContexts browses contexts alongside the current context. Each context has
+// its own cluster client and object store, and is browsed read-only.
+func WithContexts(contexts []string) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.Contexts = contexts
+		},
+	}
+}
+
+// WithCachePolicy bounds the memory used by the object store's informers.
+func WithCachePolicy(policy objectstore.CachePolicy) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.CachePolicy = policy
+		},
+	}
+}
+
+// WithTLSConfig serves the dashboard over HTTPS. The dashboard is served over
+// HTTP if config is nil.
+func WithTLSConfig(config *tls.Config) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.TLSConfig = config
+		},
+	}
+}
+
 func WithClusterClient(client cluster.ClientInterface) RunnerOption {
 	return RunnerOption{
 		nonClusterOption: func(o *Options) {
@@ -222,6 +314,8 @@ type Runner struct {
 	moduleManager          *module.Manager
 	actionManager          *action.Manager
 	websocketClientManager *api.WebsocketClientManager
+	auditLog               *audit.Log
+	compareSources         *compare.Sources
 	apiCreated             bool
 	fs                     afero.Fs
 }
@@ -235,13 +329,37 @@ func NewRunner(ctx context.Context, logger log.Logger, opts ...RunnerOption) (*R
 	r := Runner{}
 	ctx = internalLog.WithLoggerContext(ctx, logger)
 	ctx = ocontext.WithKubeConfigCh(ctx)
+	if options.ReadOnly {
+		ctx = readonly.WithReadOnly(ctx)
+	}
+	r.compareSources = compare.NewSources()
+	ctx = compare.WithSources(ctx, r.compareSources)
+
+	r.auditLog = options.AuditLog
+	if r.auditLog == nil {
+		r.auditLog = audit.NewLog(logger, audit.DefaultCapacity)
+	}
+	ctx = audit.WithLog(ctx, r.auditLog)
 	r.ctx = ctx
 
 	if options.Context != "" {
 		logger.With("initial-context", options.Context).Infof("Setting initial context from user flags")
 	}
 
-	actionManger := action.NewManager(logger)
+	managerOptions := []action.ManagerOption{action.WithRecorder(r.auditLog)}
+	if options.ReadOnly {
+		logger.Infof("Octant is in read-only mode")
+		// Previews don't change the cluster and pinning objects only changes
+		// preferences.
+		managerOptions = append(managerOptions, action.WithReadOnly(
+			internalOctant.ActionPreviewDeleteObject,
+			internalOctant.ActionPreviewApplyYaml,
+			internalOctant.ActionPinObject,
+			internalOctant.ActionUnpinObject,
+		))
+	}
+
+	actionManger := action.NewManager(logger, managerOptions...)
 	r.actionManager = actionManger
 
 	websocketClientManager := api.NewWebsocketClientManager(ctx, r.actionManager)
@@ -273,6 +391,8 @@ func NewRunner(ctx context.Context, logger log.Logger, opts ...RunnerOption) (*R
 	if viper.GetBool("disable-open-browser") {
 		d.willOpenBrowser = false
 	}
+	d.authenticator = options.Authenticator
+	d.tlsConfig = options.TLSConfig
 
 	r.dash = d
 
@@ -280,6 +400,11 @@ func NewRunner(ctx context.Context, logger log.Logger, opts ...RunnerOption) (*R
 }
 
 func (r *Runner) apiFromKubeConfig(kubeConfig string, opts ...RunnerOption) (api.Service, *pluginAPI.GRPCService, error) {
+	options := Options{}
+	for _, opt := range opts {
+		opt.nonClusterOption(&options)
+	}
+
 	logger := internalLog.From(r.ctx)
 	validKubeConfig, err := ValidateKubeConfig(logger, kubeConfig, r.fs)
 	if err == nil {
@@ -287,7 +412,9 @@ func (r *Runner) apiFromKubeConfig(kubeConfig string, opts ...RunnerOption) (api
 		return r.initAPI(r.ctx, logger, opts...)
 	} else {
 		logger.Infof("no valid kube config found, initializing loading API")
-		return api.NewLoadingAPI(r.ctx, api.PathPrefix, r.actionManager, r.websocketClientManager, logger), nil, nil
+		return api.NewLoadingAPI(r.ctx, api.PathPrefix, r.actionManager, r.websocketClientManager, logger,
+			api.WithAuthenticator(options.Authenticator),
+			api.WithImpersonation(options.Impersonation)), nil, nil
 	}
 }
 
@@ -368,10 +495,22 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, opts ...RunnerO
 	}
 	clusterClient := kubeContextDecorator.ClusterClient()
 
-	if options.EnableOpenCensus {
-		if err := enableOpenCensus(); err != nil {
-			logger.Infof("Enabling OpenCensus")
-			return nil, nil, fmt.Errorf("enabling open census: %w", err)
+	preferenceStore := options.Preferences
+	if preferenceStore == nil {
+		var err error
+		if preferenceStore, err = preferences.NewFileStore(""); err != nil {
+			return nil, nil, fmt.Errorf("initializing preferences: %w", err)
+		}
+	}
+
+	// A namespace set with a flag replaces the namespace last used in the
+	// current context.
+	if options.Namespace != "" {
+		err := preferenceStore.Update(kubeContextDecorator.CurrentContext(), func(prefs *preferences.Context) {
+			prefs.Namespace = options.Namespace
+		})
+		if err != nil {
+			logger.WithErr(err).Errorf("save initial namespace")
 		}
 	}
 
@@ -394,11 +533,23 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, opts ...RunnerO
 
 	logger.Debugf("initial namespace for dashboard is %s", options.Namespace)
 
-	factoryOption := objectstore.WithDynamicSharedInformerFactory(options.factory)
-	appObjectStore, err := initObjectStore(ctx, clusterClient, factoryOption)
+	searchIndex := search.NewIndex()
+
+	storeOptions := []objectstore.Option{
+		objectstore.WithDynamicSharedInformerFactory(options.factory),
+		objectstore.WithCachePolicy(options.CachePolicy),
+		objectstore.WithIndexer(searchIndex),
+	}
+	if options.ReadOnly {
+		storeOptions = append(storeOptions, objectstore.ReadOnly())
+	}
+	appObjectStore, err := initObjectStore(ctx, clusterClient, storeOptions...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("initializing store: %w", err)
 	}
+	if access, ok := appObjectStore.(search.AccessChecker); ok {
+		searchIndex.SetAccessChecker(access)
+	}
 
 	errorStore, err := oerrors.NewErrorStore()
 	if err != nil {
@@ -441,6 +592,7 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, opts ...RunnerO
 		NamespaceInterface:     nsClient,
 		FrontendProxy:          frontendProxy,
 		WebsocketClientManager: r.websocketClientManager,
+		RequireIdentity:        options.Impersonation != nil,
 	}
 
 	pluginManager, err := initPlugin(moduleManager, r.actionManager, r.websocketClientManager, pluginDashboardService)
@@ -467,6 +619,8 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, opts ...RunnerO
 		logger,
 		moduleManager,
 		appObjectStore,
+		preferenceStore,
+		searchIndex,
 		errorStore,
 		pluginManager,
 		portForwarder,
@@ -476,16 +630,31 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, opts ...RunnerO
 	)
 
 	pluginManager.SetOctantClient(dashConfig)
+	pluginDashboardService.PreferenceStorage = dashConfig
 
 	if err := watchConfigs(ctx, dashConfig, options.KubeConfig); err != nil {
 		return nil, nil, fmt.Errorf("set up config watcher: %w", err)
 	}
 
+	options.AuditLog = r.auditLog
 	moduleList, err := initModules(ctx, dashConfig, options.Namespace, options)
 	if err != nil {
 		return nil, nil, fmt.Errorf("initializing modules: %w", err)
 	}
 
+	compareSources := []compare.Source{compare.CurrentContext(dashConfig)}
+	if len(options.Contexts) > 0 {
+		multiClusterModule, err := initMultiClusterModule(ctx, dashConfig, kubeContextDecorator, errorStore, options)
+		if err != nil {
+			return nil, nil, fmt.Errorf("initializing multi-cluster module: %w", err)
+		}
+		moduleList = append(moduleList, multiClusterModule)
+		compareSources = append(compareSources, multiClusterModule)
+	}
+
+	r.compareSources.Set(compareSources...)
+	moduleList = append(moduleList, compare.New(compare.Options{Sources: r.compareSources}))
+
 	for _, mod := range moduleList {
 		if err := moduleManager.Register(mod); err != nil {
 			return nil, nil, fmt.Errorf("loading module %s: %w", mod.Name(), err)
@@ -501,7 +670,9 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, opts ...RunnerO
 		return nil, nil, fmt.Errorf("unable to start CRD watcher: %w", err)
 	}
 
-	apiService := api.New(ctx, api.PathPrefix, r.actionManager, r.websocketClientManager, dashConfig)
+	apiService := api.New(ctx, api.PathPrefix, r.actionManager, r.websocketClientManager, dashConfig,
+		api.WithAuthenticator(options.Authenticator),
+		api.WithImpersonation(options.Impersonation))
 	frontendProxy.FrontendUpdateController = apiService
 
 	r.apiCreated = true
@@ -583,7 +754,9 @@ func initModules(ctx context.Context, dashConfig config.Dash, namespace string,
 	}
 
 	configurationOptions := configuration.Options{
-		DashConfig: dashConfig,
+		DashConfig:     dashConfig,
+		KubeConfigPath: options.KubeConfig,
+		AuditLog:       options.AuditLog,
 	}
 	configurationModule := configuration.New(ctx, configurationOptions)
 
@@ -598,6 +771,82 @@ func initModules(ctx context.Context, dashConfig config.Dash, namespace string,
 	return list, nil
 }
 
+// initMultiClusterModule initializes the module for browsing options.Contexts.
+// Each context is connected to with its own cluster client and read-only object
+// store.
+func initMultiClusterModule(ctx context.Context, dashConfig config.Dash, kubeContextDecorator config.KubeContextDecorator, errorStore oerrors.ErrorStore, options Options) (*multicluster.MultiCluster, error) {
+	contextManager, ok := kubeContextDecorator.(*kubeconfig.KubeConfigContextManager)
+	if !ok {
+		return nil, fmt.Errorf("browsing several contexts requires a kube config")
+	}
+
+	connect := func(ctx context.Context, contextName string) (*multicluster.Connection, error) {
+		clusterClient, err := contextManager.ClientForContext(ctx, contextName)
+		if err != nil {
+			return nil, err
+		}
+
+		objectStore, err := initObjectStore(ctx, clusterClient, objectstore.ReadOnly(), objectstore.WithCachePolicy(options.CachePolicy))
+		if err != nil {
+			clusterClient.Close()
+			return nil, fmt.Errorf("initializing store: %w", err)
+		}
+
+		crdWatcher, err := describer.NewDefaultCRDWatcher(ctx, clusterClient, objectStore, errorStore)
+		if err != nil {
+			clusterClient.Close()
+			return nil, fmt.Errorf("initializing CRD watcher: %w", err)
+		}
+
+		return &multicluster.Connection{
+			ClusterClient: clusterClient,
+			ObjectStore:   objectStore,
+			CRDWatcher:    crdWatcher,
+		}, nil
+	}
+
+	modules := func(ctx context.Context, contextConfig config.Dash) ([]module.Module, error) {
+		return initContextModules(ctx, contextConfig, options.Namespace, options)
+	}
+
+	return multicluster.New(ctx, multicluster.Options{
+		DashConfig: dashConfig,
+		Namespace:  options.Namespace,
+		Contexts:   options.Contexts,
+		Connect:    connect,
+		Modules:    modules,
+	})
+}
+
+// initContextModules initializes the modules served for each context in
+// multi-cluster mode.
+func initContextModules(ctx context.Context, dashConfig config.Dash, namespace string, options Options) ([]module.Module, error) {
+	var list []module.Module
+
+	overviewModule, err := overview.New(ctx, overview.Options{
+		Namespace:  namespace,
+		DashConfig: dashConfig,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create overview module: %w", err)
+	}
+
+	list = append(list, overviewModule)
+
+	if !options.DisableClusterOverview {
+		clusterOverviewModule, err := clusteroverview.New(ctx, clusteroverview.Options{
+			DashConfig: dashConfig,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create cluster overview module: %w", err)
+		}
+
+		list = append(list, clusterOverviewModule)
+	}
+
+	return list, nil
+}
+
 // initModuleManager initializes the moduleManager (and currently the modules themselves)
 func initModuleManager(options *moduleOptions) (*module.Manager, error) {
 	moduleManager, err := module.NewManager(options.clusterClient, options.namespace, options.actionManager, options.logger)
@@ -621,6 +870,8 @@ type dash struct {
 	handlerFactory  *octant.HandlerFactory
 	server          http.Server
 	pluginService   pluginAPI.Service
+	authenticator   *auth.Authenticator
+	tlsConfig       *tls.Config
 }
 
 func newDash(listener net.Listener, namespace, uiURL string, browserPath string, apiHandler api.Service, pluginHandler pluginAPI.Service, logger log.Logger) (*dash, error) {
@@ -663,7 +914,7 @@ func (d *dash) Run(ctx context.Context, startupCh chan bool) error {
 		return err
 	}
 
-	d.server = http.Server{Handler: handler}
+	d.server = http.Server{Handler: handler, TLSConfig: d.tlsConfig}
 
 	// Enable serving the plugin API on the same endpoint as the Octant streaming API.
 	// This enables remote gRPC plugins.
@@ -674,7 +925,13 @@ func (d *dash) Run(ctx context.Context, startupCh chan bool) error {
 
 	http1 := d.mux.Match(cmux.Any())
 	go func() {
-		if err = d.server.Serve(http1); err != nil && err != http.ErrServerClosed {
+		if d.tlsConfig != nil {
+			// The certificate is in the TLS config.
+			err = d.server.ServeTLS(http1, "", "")
+		} else {
+			err = d.server.Serve(http1)
+		}
+		if err != nil && err != http.ErrServerClosed {
 			d.logger.Errorf("http server: %v", err)
 			os.Exit(1) // TODO graceful shutdown for other goroutines (GH#494)
 		}
@@ -689,19 +946,28 @@ func (d *dash) Run(ctx context.Context, startupCh chan bool) error {
 		}
 	}()
 
-	dashboardURL := fmt.Sprintf("http://%s", d.listener.Addr())
+	scheme := "http"
+	if d.tlsConfig != nil {
+		scheme = "https"
+	}
+	dashboardURL := fmt.Sprintf("%s://%s", scheme, d.listener.Addr())
 
 	d.logger.Infof("Dashboard is available at %s\n", dashboardURL)
+	if d.authenticator != nil && d.authenticator.Mode() == auth.ModeToken {
+		// Opening this URL logs the browser in.
+		d.logger.Infof("Log in to the dashboard at %s\n", d.authenticator.BrowserURL(dashboardURL, ""))
+	}
 
 	if startupCh != nil {
 		startupCh <- true
 	}
 
 	if d.willOpenBrowser {
-		runURL := dashboardURL
+		browserPath := ""
 		if d.browserPath != "" {
-			runURL += path_util.PrefixedPath(d.browserPath)
+			browserPath = path_util.PrefixedPath(d.browserPath)
 		}
+		runURL := d.authenticator.BrowserURL(dashboardURL, browserPath)
 		if err = open.Run(runURL); err != nil {
 			d.logger.Warnf("unable to open browser: %v", err)
 		}
@@ -715,26 +981,6 @@ func (d *dash) Run(ctx context.Context, startupCh chan bool) error {
 	return d.server.Shutdown(shutdownCtx)
 }
 
-func enableOpenCensus() error {
-	agentEndpointURI := "localhost:6831"
-
-	je, err := jaeger.NewExporter(jaeger.Options{
-		AgentEndpoint: agentEndpointURI,
-		Process: jaeger.Process{
-			ServiceName: "octant",
-		},
-	})
-
-	if err != nil {
-		return fmt.Errorf("failed to create Jaeger exporter: %w", err)
-	}
-
-	trace.RegisterExporter(je)
-	trace.ApplyConfig(trace.Config{DefaultSampler: trace.AlwaysSample()})
-
-	return nil
-}
-
 func memStats() error {
 	memstats := viper.GetString("memstats")
 	if memstats != "" {
@@ -183,6 +183,8 @@ func TestNewRunnerUsesClusterClient(t *testing.T) {
 	sharedIndexInformer := clusterFake.NewMockSharedIndexInformer(controller)
 	sharedIndexInformer.EXPECT().SetWatchErrorHandler(gomock.Any())
 	sharedIndexInformer.EXPECT().AddEventHandlerWithResyncPeriod(gomock.Any(), gomock.Any())
+	// Informers notify the store and index objects for search.
+	sharedIndexInformer.EXPECT().AddEventHandler(gomock.Any()).Times(2)
 	sharedIndexInformer.EXPECT().Run(gomock.Any()).AnyTimes()
 
 	genericInformer := clusterFake.NewMockGenericInformer(controller)
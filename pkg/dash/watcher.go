@@ -132,7 +132,14 @@ func (cw *ConfigWatcher) Watch(ctx context.Context) {
 		case <-ctx.Done():
 			done = true
 			logger.Infof("shutting down config watcher")
-		case _ = <-cw.FileWatcher.Events():
+		case event := <-cw.FileWatcher.Events():
+			// Files which are written atomically are replaced, which removes
+			// the watch on the original file.
+			if event.Op&(fsnotify.Remove|fsnotify.Rename) != 0 {
+				if err := cw.FileWatcher.Add(event.Name); err != nil {
+					logger.WithErr(err).With("config", event.Name).Errorf("watch replaced config file")
+				}
+			}
 			if err := cw.watcherConfig.UseFSContext(ctx); err != nil {
 				logger.WithErr(err).Errorf("reload config")
 			}
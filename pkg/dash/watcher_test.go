@@ -102,3 +102,36 @@ func TestConfigWatcher_Watch(t *testing.T) {
 	<-ch
 	cancel()
 }
+
+func TestConfigWatcher_Watch_replaced(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	watcherConfig := fake.NewMockWatcherConfig(controller)
+
+	ch := make(chan bool, 1)
+	watcherConfig.EXPECT().UseFSContext(gomock.Any()).
+		DoAndReturn(func(_ context.Context) error {
+			ch <- true
+			return nil
+		})
+
+	fileWatcher := fake.NewMockFileWatcher(controller)
+	fileWatcher.EXPECT().Add("kubeconfig").Return(nil)
+
+	eventCh := make(chan fsnotify.Event)
+	fileWatcher.EXPECT().Events().Return(eventCh).AnyTimes()
+
+	errCh := make(chan error)
+	fileWatcher.EXPECT().Errors().Return(errCh).AnyTimes()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cw, err := dash.NewConfigWatcher(watcherConfig, dash.ConfigWatcherFileWatcher(fileWatcher))
+	require.NoError(t, err)
+
+	go cw.Watch(ctx)
+
+	eventCh <- fsnotify.Event{Name: "kubeconfig", Op: fsnotify.Remove}
+	<-ch
+	cancel()
+}
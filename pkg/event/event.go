@@ -18,6 +18,9 @@ const (
 	// EventTypeContent is a content event.
 	EventTypeContent EventType = "event.octant.dev/content"
 
+	// EventTypeContentPatch is a content event containing JSON Patch operations.
+	EventTypeContentPatch EventType = "event.octant.dev/contentPatch"
+
 	// EventTypeNamespaces is a namespaces event.
 	EventTypeNamespaces EventType = "event.octant.dev/namespaces"
 
@@ -51,6 +54,18 @@ const (
 	// EventTypeAlert is an alert event.
 	EventTypeAlert EventType = "event.octant.dev/alert"
 
+	// EventTypePreview is a preview of the changes an action would make.
+	EventTypePreview EventType = "event.octant.dev/preview"
+
+	// EventTypeAuthStatus is the status of the credentials for the current context.
+	EventTypeAuthStatus EventType = "event.octant.dev/authStatus"
+
+	// EventTypeContextPreferences is the preferences of the current context.
+	EventTypeContextPreferences EventType = "event.octant.dev/contextPreferences"
+
+	// EventTypeSearchResults is the results of a search of cached objects.
+	EventTypeSearchResults EventType = "event.octant.dev/searchResults"
+
 	// EventTypeRefresh is a refresh event.
 	EventTypeRefresh EventType = "event.octant.dev/refresh"
 
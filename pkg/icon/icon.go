@@ -15,6 +15,7 @@ const (
 	RBAC                      = "assign-user"
 	Events                    = "event"
 	Cluster                   = "cluster"
+	Fleet                     = "world"
 
 	Namespaces      = "namespace"
 	ApiServer       = "hard-disk"
@@ -33,6 +34,9 @@ const (
 
 	Configuration       = "cog"
 	ConfigurationPlugin = "plugin"
+	ConfigurationAudit  = "history"
+
+	ConfigurationKubeConfig = "file-settings"
 
 	CustomResourceDefinition = "dna"
 )
@@ -8,6 +8,8 @@ package octant
 
 import (
 	"context"
+	"crypto/tls"
+	"net"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
@@ -18,6 +20,7 @@ import (
 
 	"github.com/vmware-tanzu/octant/internal/api"
 	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/metrics"
 )
 
 type HandlerFactoryFunc func(ctx context.Context) (http.Handler, error)
@@ -66,6 +69,7 @@ func (hf *HandlerFactory) Handler(ctx context.Context) (http.Handler, error) {
 	}
 
 	router.PathPrefix(api.PathPrefix).Handler(backendHandler)
+	router.Path(metrics.Path).Handler(backendHandler)
 
 	router.PathPrefix("/").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		hf.mu.RLock()
@@ -126,5 +130,36 @@ func createProxyTarget(targetURL string) (*httputil.ReverseProxy, error) {
 	}
 
 	handler := httputil.NewSingleHostReverseProxy(target)
+
+	director := handler.Director
+	handler.Director = func(r *http.Request) {
+		// Let the frontend server know the scheme the browser is using, since the
+		// dashboard may be served over HTTPS while the frontend server is not.
+		proto := "http"
+		if r.TLS != nil {
+			proto = "https"
+		}
+		r.Header.Set("X-Forwarded-Proto", proto)
+		r.Header.Set("X-Forwarded-Host", r.Host)
+
+		director(r)
+	}
+
+	if target.Scheme == "https" && isLoopback(target.Hostname()) {
+		// Frontend development servers on this machine use self-signed certificates.
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} // nolint:gosec
+		handler.Transport = transport
+	}
+
 	return handler, nil
 }
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
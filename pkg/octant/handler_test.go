@@ -8,6 +8,7 @@ package octant
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -174,6 +175,24 @@ func TestNewProxiedFrontend(t *testing.T) {
 	}
 }
 
+func TestNewProxiedFrontend_tls(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.Header.Get("X-Forwarded-Proto"))
+	}))
+	defer ts.Close()
+
+	pf, err := NewProxiedFrontend(context.Background(), ts.URL)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "https://octant.example.com/", nil)
+	req.TLS = &tls.ConnectionState{}
+	rr := httptest.NewRecorder()
+	pf.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "https", rr.Body.String())
+}
+
 func readFromCloser(t *testing.T, rc io.ReadCloser) []byte {
 	data, err := ioutil.ReadAll(rc)
 	require.NoError(t, err)
@@ -19,9 +19,12 @@ import (
 	"google.golang.org/grpc/metadata"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	ocontext "github.com/vmware-tanzu/octant/internal/context"
 	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/internal/portforward"
 	portForwardFake "github.com/vmware-tanzu/octant/internal/portforward/fake"
+	"github.com/vmware-tanzu/octant/internal/preferences"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/plugin/api"
 	"github.com/vmware-tanzu/octant/pkg/store"
@@ -292,6 +295,95 @@ func TestAPI(t *testing.T) {
 	}
 }
 
+func TestAPI_identity(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	viper.SetDefault("client-max-recv-msg-size", 1024*1024*16)
+
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+	identity := cluster.Identity{User: "alice", Groups: []string{"dev"}}
+
+	appObjectStore := storeFake.NewMockStore(controller)
+	appObjectStore.EXPECT().
+		List(contextType, key).
+		DoAndReturn(func(ctx context.Context, _ store.Key) (*unstructured.UnstructuredList, bool, error) {
+			got, ok := cluster.IdentityFrom(ctx)
+			require.True(t, ok)
+			assert.Equal(t, identity, got)
+			return &unstructured.UnstructuredList{}, false, nil
+		})
+
+	service := &api.GRPCService{
+		ObjectStore:     appObjectStore,
+		RequireIdentity: true,
+	}
+
+	a, err := api.New(service)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, a.Start(ctx))
+
+	client, err := api.NewClient(a.Addr())
+	require.NoError(t, err)
+
+	_, err = client.List(ctx, key)
+	require.Error(t, err, "calls without an identity are refused")
+
+	_, err = client.List(ocontext.WithClientState(ctx, ocontext.ClientState{Identity: &identity}), key)
+	require.NoError(t, err)
+}
+
+type preferenceStorage struct {
+	store       preferences.Store
+	contextName string
+}
+
+func (s *preferenceStorage) Preferences() preferences.Store {
+	return s.store
+}
+
+func (s *preferenceStorage) CurrentContext() string {
+	return s.contextName
+}
+
+func TestAPI_preferences(t *testing.T) {
+	viper.SetDefault("client-max-recv-msg-size", 1024*1024*16)
+
+	prefs, err := preferences.NewFileStore("")
+	require.NoError(t, err)
+
+	service := &api.GRPCService{
+		PreferenceStorage: &preferenceStorage{store: prefs, contextName: "dev"},
+	}
+
+	a, err := api.New(service)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, a.Start(ctx))
+
+	client, err := api.NewClient(a.Addr())
+	require.NoError(t, err)
+
+	require.NoError(t, client.SetPreference(ctx, "my-plugin.mode", "compact"))
+	assert.Equal(t, map[string]string{"my-plugin.mode": "compact"}, prefs.Get("dev").Plugins)
+	assert.Empty(t, prefs.Get("prod").Plugins, "preferences are stored for the current context")
+
+	got, err := client.GetPreference(ctx, "my-plugin.mode")
+	require.NoError(t, err)
+	assert.Equal(t, "compact", got)
+
+	got, err = client.GetPreference(ctx, "my-plugin.missing")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
 func checkPort(t *testing.T, isListen bool, addr string) {
 	_, err := net.Listen("tcp", addr)
 	if isListen {
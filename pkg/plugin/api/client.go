@@ -7,13 +7,17 @@ package api
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/spf13/viper"
 
+	ocontext "github.com/vmware-tanzu/octant/internal/context"
 	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/util/json"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/config"
 	"github.com/vmware-tanzu/octant/pkg/plugin/api/proto"
@@ -79,6 +83,22 @@ func NewClient(address string, options ...ClientOption) (*Client, error) {
 	return client, nil
 }
 
+// withIdentityMetadata adds the identity in the client state to the metadata
+// of a call so the dashboard makes it as that identity.
+func withIdentityMetadata(ctx context.Context) (context.Context, error) {
+	identity := ocontext.ClientStateFrom(ctx).Identity
+	if identity == nil {
+		return ctx, nil
+	}
+
+	data, err := json.Marshal(identity)
+	if err != nil {
+		return nil, fmt.Errorf("encode identity: %w", err)
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, identityMetadataKey, string(data)), nil
+}
+
 // Close closes the client's connection.
 func (c *Client) Close() error {
 	return c.DashboardConnection.Close()
@@ -86,6 +106,11 @@ func (c *Client) Close() error {
 
 // List lists objects in the dashboard's object store.
 func (c *Client) List(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, error) {
+	ctx, err := withIdentityMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	client := c.DashboardConnection.Client()
 
 	keyRequest, err := convertFromKey(key)
@@ -108,6 +133,11 @@ func (c *Client) List(ctx context.Context, key store.Key) (*unstructured.Unstruc
 
 // Get retrieves an object from the dashboard's objectStore.
 func (c *Client) Get(ctx context.Context, key store.Key) (*unstructured.Unstructured, error) {
+	ctx, err := withIdentityMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	client := c.DashboardConnection.Client()
 
 	keyRequest, err := convertFromKey(key)
@@ -130,6 +160,11 @@ func (c *Client) Get(ctx context.Context, key store.Key) (*unstructured.Unstruct
 
 // Update updates an object in the store.
 func (c *Client) Update(ctx context.Context, object *unstructured.Unstructured) error {
+	ctx, err := withIdentityMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
 	client := c.DashboardConnection.Client()
 
 	data, err := convertFromObject(object)
@@ -147,6 +182,11 @@ func (c *Client) Update(ctx context.Context, object *unstructured.Unstructured)
 }
 
 func (c *Client) Create(ctx context.Context, object *unstructured.Unstructured) error {
+	ctx, err := withIdentityMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
 	client := c.DashboardConnection.Client()
 
 	data, err := convertFromObject(object)
@@ -166,6 +206,11 @@ func (c *Client) Create(ctx context.Context, object *unstructured.Unstructured)
 
 // Delete deletes an object from the dashboard's objectStore.
 func (c *Client) Delete(ctx context.Context, key store.Key) error {
+	ctx, err := withIdentityMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
 	client := c.DashboardConnection.Client()
 
 	keyRequest, err := convertFromKey(key)
@@ -180,6 +225,11 @@ func (c *Client) Delete(ctx context.Context, key store.Key) error {
 
 // PortForward creates a port forward.
 func (c *Client) PortForward(ctx context.Context, req PortForwardRequest) (PortForwardResponse, error) {
+	ctx, err := withIdentityMetadata(ctx)
+	if err != nil {
+		return PortForwardResponse{}, err
+	}
+
 	client := c.DashboardConnection.Client()
 
 	pfRequest := &proto.PortForwardRequest{
@@ -273,3 +323,26 @@ func (c *Client) CreateLink(ctx context.Context, key store.Key) (LinkResponse, e
 		Link: *linkComponent,
 	}, nil
 }
+
+// GetPreference gets a preference stored for the current context. Keys which
+// are not set return an empty string.
+func (c *Client) GetPreference(ctx context.Context, key string) (string, error) {
+	client := c.DashboardConnection.Client()
+
+	resp, err := client.GetPreference(ctx, &proto.GetPreferenceRequest{Key: key})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Value, nil
+}
+
+// SetPreference stores a preference for the current context. Keys are shared
+// by all plugins, so plugins should prefix them with their name. Setting an
+// empty string removes the preference.
+func (c *Client) SetPreference(ctx context.Context, key, value string) error {
+	client := c.DashboardConnection.Client()
+
+	_, err := client.SetPreference(ctx, &proto.SetPreferenceRequest{Key: key, Value: value})
+	return err
+}
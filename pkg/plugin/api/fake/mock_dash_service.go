@@ -123,6 +123,21 @@ func (mr *MockServiceMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockService)(nil).Get), arg0, arg1)
 }
 
+// GetPreference mocks base method
+func (m *MockService) GetPreference(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreference", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreference indicates an expected call of GetPreference
+func (mr *MockServiceMockRecorder) GetPreference(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreference", reflect.TypeOf((*MockService)(nil).GetPreference), arg0, arg1)
+}
+
 // List mocks base method
 func (m *MockService) List(arg0 context.Context, arg1 store.Key) (*unstructured.UnstructuredList, error) {
 	m.ctrl.T.Helper()
@@ -182,6 +197,20 @@ func (mr *MockServiceMockRecorder) SendAlert(arg0, arg1, arg2 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAlert", reflect.TypeOf((*MockService)(nil).SendAlert), arg0, arg1, arg2)
 }
 
+// SetPreference mocks base method
+func (m *MockService) SetPreference(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPreference", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPreference indicates an expected call of SetPreference
+func (mr *MockServiceMockRecorder) SetPreference(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPreference", reflect.TypeOf((*MockService)(nil).SetPreference), arg0, arg1, arg2)
+}
+
 // Update mocks base method
 func (m *MockService) Update(arg0 context.Context, arg1 *unstructured.Unstructured) error {
 	m.ctrl.T.Helper()
@@ -157,6 +157,26 @@ func (mr *MockDashboardClientMockRecorder) Get(arg0, arg1 interface{}, arg2 ...i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDashboardClient)(nil).Get), varargs...)
 }
 
+// GetPreference mocks base method
+func (m *MockDashboardClient) GetPreference(arg0 context.Context, arg1 *proto.GetPreferenceRequest, arg2 ...grpc.CallOption) (*proto.GetPreferenceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPreference", varargs...)
+	ret0, _ := ret[0].(*proto.GetPreferenceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreference indicates an expected call of GetPreference
+func (mr *MockDashboardClientMockRecorder) GetPreference(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreference", reflect.TypeOf((*MockDashboardClient)(nil).GetPreference), varargs...)
+}
+
 // List mocks base method
 func (m *MockDashboardClient) List(arg0 context.Context, arg1 *proto.KeyRequest, arg2 ...grpc.CallOption) (*proto.ListResponse, error) {
 	m.ctrl.T.Helper()
@@ -237,6 +257,26 @@ func (mr *MockDashboardClientMockRecorder) SendAlert(arg0, arg1 interface{}, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAlert", reflect.TypeOf((*MockDashboardClient)(nil).SendAlert), varargs...)
 }
 
+// SetPreference mocks base method
+func (m *MockDashboardClient) SetPreference(arg0 context.Context, arg1 *proto.SetPreferenceRequest, arg2 ...grpc.CallOption) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetPreference", varargs...)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPreference indicates an expected call of SetPreference
+func (mr *MockDashboardClientMockRecorder) SetPreference(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPreference", reflect.TypeOf((*MockDashboardClient)(nil).SetPreference), varargs...)
+}
+
 // Update mocks base method
 func (m *MockDashboardClient) Update(arg0 context.Context, arg1 *proto.UpdateRequest, arg2 ...grpc.CallOption) (*proto.UpdateResponse, error) {
 	m.ctrl.T.Helper()
@@ -7,6 +7,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -16,7 +17,10 @@ import (
 	"google.golang.org/grpc/metadata"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	internalCluster "github.com/vmware-tanzu/octant/internal/cluster"
 	ocontext "github.com/vmware-tanzu/octant/internal/context"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/internal/util/json"
 	"github.com/vmware-tanzu/octant/pkg/event"
 
 	"github.com/vmware-tanzu/octant/internal/gvk"
@@ -30,6 +34,10 @@ import (
 // DashboardMetadataKey is a type used for metadata keys passed by plugins
 type DashboardMetadataKey string
 
+// identityMetadataKey is the metadata key of the identity a plugin's call is
+// made as. Keys ending in -bin may hold any bytes.
+const identityMetadataKey = "octant-identity-bin"
+
 // PortForwardRequest describes a port forward request.
 type PortForwardRequest struct {
 	Namespace     string
@@ -53,6 +61,12 @@ type LinkResponse struct {
 	Link component.Link
 }
 
+// PreferenceStorage stores preferences for the current context.
+type PreferenceStorage interface {
+	Preferences() preferences.Store
+	CurrentContext() string
+}
+
 // Service is the dashboard service.
 type Service interface {
 	List(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, error)
@@ -66,6 +80,8 @@ type Service interface {
 	ForceFrontendUpdate(ctx context.Context) error
 	SendAlert(ctx context.Context, clientID string, alert action.Alert) error
 	CreateLink(ctx context.Context, key store.Key) (LinkResponse, error)
+	GetPreference(ctx context.Context, key string) (string, error)
+	SetPreference(ctx context.Context, key, value string) error
 }
 
 // FrontendUpdateController can control the frontend. ie. the web gui
@@ -95,6 +111,11 @@ type GRPCService struct {
 	NamespaceInterface     cluster.NamespaceInterface
 	WebsocketClientManager event.WSClientGetter
 	LinkGenerator          octant.LinkGenerator
+	PreferenceStorage      PreferenceStorage
+	// RequireIdentity refuses calls which don't have an identity. It is set
+	// when cluster calls are made as signed-in users, so calls without one
+	// aren't made with Octant's credentials.
+	RequireIdentity bool
 }
 
 var _ Service = (*GRPCService)(nil)
@@ -102,14 +123,20 @@ var _ Service = (*GRPCService)(nil)
 // List lists objects.
 func (s *GRPCService) List(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, error) {
 	// TODO: support hasSynced
-	ctx = extractObjectStoreMetadata(ctx)
+	ctx, err := s.withIdentity(extractObjectStoreMetadata(ctx))
+	if err != nil {
+		return nil, err
+	}
 	list, _, err := s.ObjectStore.List(ctx, key)
 	return list, err
 }
 
 // Get retrieves an object.
 func (s *GRPCService) Get(ctx context.Context, key store.Key) (*unstructured.Unstructured, error) {
-	ctx = extractObjectStoreMetadata(ctx)
+	ctx, err := s.withIdentity(extractObjectStoreMetadata(ctx))
+	if err != nil {
+		return nil, err
+	}
 	return s.ObjectStore.Get(ctx, key)
 }
 
@@ -119,7 +146,10 @@ func (s *GRPCService) Update(ctx context.Context, object *unstructured.Unstructu
 		return err
 	}
 
-	ctx = extractObjectStoreMetadata(ctx)
+	ctx, err = s.withIdentity(extractObjectStoreMetadata(ctx))
+	if err != nil {
+		return err
+	}
 	return s.ObjectStore.Update(ctx, key, func(u *unstructured.Unstructured) error {
 		u.Object = object.Object
 		return nil
@@ -127,17 +157,28 @@ func (s *GRPCService) Update(ctx context.Context, object *unstructured.Unstructu
 }
 
 func (s *GRPCService) Create(ctx context.Context, object *unstructured.Unstructured) error {
-	ctx = extractObjectStoreMetadata(ctx)
+	ctx, err := s.withIdentity(extractObjectStoreMetadata(ctx))
+	if err != nil {
+		return err
+	}
 	return s.ObjectStore.Create(ctx, object)
 }
 
 func (s *GRPCService) Delete(ctx context.Context, key store.Key) error {
-	ctx = extractObjectStoreMetadata(ctx)
+	ctx, err := s.withIdentity(extractObjectStoreMetadata(ctx))
+	if err != nil {
+		return err
+	}
 	return s.ObjectStore.Delete(ctx, key)
 }
 
 // PortForward creates a port forward.
 func (s *GRPCService) PortForward(ctx context.Context, req PortForwardRequest) (PortForwardResponse, error) {
+	ctx, err := s.withIdentity(ctx)
+	if err != nil {
+		return PortForwardResponse{}, err
+	}
+
 	pfResponse, err := s.PortForwarder.Create(ctx, nil, gvk.Pod, req.PodName, req.Namespace, req.Port)
 	if err != nil {
 		return PortForwardResponse{}, err
@@ -210,6 +251,30 @@ func (s *GRPCService) CreateLink(_ context.Context, key store.Key) (LinkResponse
 	}, nil
 }
 
+// GetPreference gets a preference stored by a plugin for the current context.
+// Keys which are not set return an empty string.
+func (s *GRPCService) GetPreference(_ context.Context, key string) (string, error) {
+	if s.PreferenceStorage == nil {
+		return "", fmt.Errorf("preference storage is nil")
+	}
+
+	prefs := s.PreferenceStorage.Preferences().Get(s.PreferenceStorage.CurrentContext())
+	return prefs.Plugins[key], nil
+}
+
+// SetPreference stores a preference for the current context. Keys are shared
+// by all plugins, so plugins should prefix them with their name. Setting an
+// empty string removes the preference.
+func (s *GRPCService) SetPreference(_ context.Context, key, value string) error {
+	if s.PreferenceStorage == nil {
+		return fmt.Errorf("preference storage is nil")
+	}
+
+	return s.PreferenceStorage.Preferences().Update(s.PreferenceStorage.CurrentContext(), func(prefs *preferences.Context) {
+		prefs.SetPlugin(key, value)
+	})
+}
+
 func NewGRPCServer(service Service) *grpcServer {
 	return &grpcServer{
 		service: service,
@@ -411,6 +476,48 @@ func (c *grpcServer) CreateLink(ctx context.Context, in *proto.KeyRequest) (*pro
 	}, nil
 }
 
+// GetPreference gets a preference for the current context.
+func (c *grpcServer) GetPreference(ctx context.Context, in *proto.GetPreferenceRequest) (*proto.GetPreferenceResponse, error) {
+	value, err := c.service.GetPreference(ctx, in.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.GetPreferenceResponse{
+		Value: value,
+	}, nil
+}
+
+// SetPreference stores a preference for the current context.
+func (c *grpcServer) SetPreference(ctx context.Context, in *proto.SetPreferenceRequest) (*proto.Empty, error) {
+	if err := c.service.SetPreference(ctx, in.Key, in.Value); err != nil {
+		return nil, err
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// withIdentity returns ctx with the identity sent by the plugin. If there is
+// no identity and one is required, an error is returned.
+func (s *GRPCService) withIdentity(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(identityMetadataKey); len(values) > 0 {
+		var identity internalCluster.Identity
+		if err := json.Unmarshal([]byte(values[0]), &identity); err != nil {
+			return nil, fmt.Errorf("decode identity: %w", err)
+		}
+		if identity.User != "" {
+			return internalCluster.WithIdentity(ctx, identity), nil
+		}
+	}
+
+	if s.RequireIdentity {
+		return nil, errors.New("plugin call has no identity; it must be made while handling a request from a signed-in user")
+	}
+
+	return ctx, nil
+}
+
 func extractObjectStoreMetadata(ctx context.Context) context.Context {
 	// Second value is ignored as metadata is always set by grpc.
 	md, _ := metadata.FromIncomingContext(ctx)
@@ -13,6 +13,7 @@ import (
 
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -37,6 +38,8 @@ type Capabilities struct {
 	IsModule bool `json:",omitempty"`
 	// ActionNames is a list of action names this plugin handles
 	ActionNames []string `json:",omitempty"`
+	// SupportsRelatedObjects are the GVKs the plugin will supply related objects for.
+	SupportsRelatedObjects []schema.GroupVersionKind `json:",omitempty"`
 }
 
 // HasPrinterSupport returns true if this plugin supports the supplied GVK.
@@ -58,6 +61,12 @@ func (c Capabilities) HasObjectStatusSupport(gvk schema.GroupVersionKind) bool {
 	return includesGVK(gvk, c.SupportsObjectStatus)
 }
 
+// HasRelatedObjectsSupport returns true if this plugin supports supplying related
+// objects for the supplied GVK.
+func (c Capabilities) HasRelatedObjectsSupport(gvk schema.GroupVersionKind) bool {
+	return includesGVK(gvk, c.SupportsRelatedObjects)
+}
+
 // PrintResponse is a printer response from the plugin. The dashboard
 // will use this to the add the plugin's output to a summary view.
 type PrintResponse struct {
@@ -82,6 +91,16 @@ type ObjectStatusResponse struct {
 	ObjectStatus component.PodSummary
 }
 
+// RelatedObjectsResponse is a related objects response from a plugin. The
+// resource viewer will use this to add edges for an object.
+type RelatedObjectsResponse struct {
+	// Owners are keys for objects which own the object.
+	Owners []store.Key
+	// Related are keys for objects the object creates or references. A key
+	// without a name will match all objects selected by the key.
+	Related []store.Key
+}
+
 // Metadata is plugin metadata.
 type Metadata struct {
 	Name         string
@@ -96,6 +115,7 @@ type Service interface {
 	Print(ctx context.Context, object runtime.Object) (PrintResponse, error)
 	PrintTabs(ctx context.Context, object runtime.Object) ([]TabResponse, error)
 	ObjectStatus(ctx context.Context, object runtime.Object) (ObjectStatusResponse, error)
+	RelatedObjects(ctx context.Context, object runtime.Object) (RelatedObjectsResponse, error)
 	HandleAction(ctx context.Context, actionName string, payload action.Payload) error
 }
 
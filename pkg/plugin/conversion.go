@@ -21,13 +21,14 @@ func convertToCapabilities(in *dashboard.RegisterResponse_Capabilities) Capabili
 	}
 
 	c := Capabilities{
-		SupportsPrinterStatus: convertToGroupVersionKindList(in.SupportsPrinterStatus),
-		SupportsPrinterConfig: convertToGroupVersionKindList(in.SupportsPrinterConfig),
-		SupportsPrinterItems:  convertToGroupVersionKindList(in.SupportsPrinterItems),
-		SupportsObjectStatus:  convertToGroupVersionKindList(in.SupportsObjectStatus),
-		SupportsTab:           convertToGroupVersionKindList(in.SupportsTab),
-		IsModule:              in.IsModule,
-		ActionNames:           in.ActionNames,
+		SupportsPrinterStatus:  convertToGroupVersionKindList(in.SupportsPrinterStatus),
+		SupportsPrinterConfig:  convertToGroupVersionKindList(in.SupportsPrinterConfig),
+		SupportsPrinterItems:   convertToGroupVersionKindList(in.SupportsPrinterItems),
+		SupportsObjectStatus:   convertToGroupVersionKindList(in.SupportsObjectStatus),
+		SupportsTab:            convertToGroupVersionKindList(in.SupportsTab),
+		IsModule:               in.IsModule,
+		ActionNames:            in.ActionNames,
+		SupportsRelatedObjects: convertToGroupVersionKindList(in.SupportsRelatedObjects),
 	}
 
 	return c
@@ -35,13 +36,14 @@ func convertToCapabilities(in *dashboard.RegisterResponse_Capabilities) Capabili
 
 func convertFromCapabilities(in Capabilities) *dashboard.RegisterResponse_Capabilities {
 	c := dashboard.RegisterResponse_Capabilities{
-		SupportsPrinterStatus: convertFromGroupVersionKindList(in.SupportsObjectStatus),
-		SupportsPrinterConfig: convertFromGroupVersionKindList(in.SupportsPrinterConfig),
-		SupportsPrinterItems:  convertFromGroupVersionKindList(in.SupportsPrinterItems),
-		SupportsObjectStatus:  convertFromGroupVersionKindList(in.SupportsObjectStatus),
-		SupportsTab:           convertFromGroupVersionKindList(in.SupportsTab),
-		IsModule:              in.IsModule,
-		ActionNames:           in.ActionNames,
+		SupportsPrinterStatus:  convertFromGroupVersionKindList(in.SupportsObjectStatus),
+		SupportsPrinterConfig:  convertFromGroupVersionKindList(in.SupportsPrinterConfig),
+		SupportsPrinterItems:   convertFromGroupVersionKindList(in.SupportsPrinterItems),
+		SupportsObjectStatus:   convertFromGroupVersionKindList(in.SupportsObjectStatus),
+		SupportsTab:            convertFromGroupVersionKindList(in.SupportsTab),
+		IsModule:               in.IsModule,
+		ActionNames:            in.ActionNames,
+		SupportsRelatedObjects: convertFromGroupVersionKindList(in.SupportsRelatedObjects),
 	}
 
 	return &c
@@ -71,6 +71,21 @@ func (mr *MockRunnersMockRecorder) Print(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Print", reflect.TypeOf((*MockRunners)(nil).Print), arg0)
 }
 
+// RelatedObjects mocks base method
+func (m *MockRunners) RelatedObjects(arg0 plugin.ManagerStore) (plugin.DefaultRunner, chan plugin.RelatedObjectsResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelatedObjects", arg0)
+	ret0, _ := ret[0].(plugin.DefaultRunner)
+	ret1, _ := ret[1].(chan plugin.RelatedObjectsResponse)
+	return ret0, ret1
+}
+
+// RelatedObjects indicates an expected call of RelatedObjects
+func (mr *MockRunnersMockRecorder) RelatedObjects(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelatedObjects", reflect.TypeOf((*MockRunners)(nil).RelatedObjects), arg0)
+}
+
 // Tab mocks base method
 func (m *MockRunners) Tab(arg0 plugin.ManagerStore) (plugin.DefaultRunner, chan []component.Tab) {
 	m.ctrl.T.Helper()
@@ -211,6 +226,18 @@ func (mr *MockManagerStoreMockRecorder) NamesJS() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamesJS", reflect.TypeOf((*MockManagerStore)(nil).NamesJS))
 }
 
+// Remove mocks base method
+func (m *MockManagerStore) Remove(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Remove", arg0)
+}
+
+// Remove indicates an expected call of Remove
+func (mr *MockManagerStoreMockRecorder) Remove(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockManagerStore)(nil).Remove), arg0)
+}
+
 // RemoveJS mocks base method
 func (m *MockManagerStore) RemoveJS(arg0 string) {
 	m.ctrl.T.Helper()
@@ -415,6 +442,21 @@ func (mr *MockModuleServiceMockRecorder) Register(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockModuleService)(nil).Register), arg0, arg1)
 }
 
+// RelatedObjects mocks base method
+func (m *MockModuleService) RelatedObjects(arg0 context.Context, arg1 runtime.Object) (plugin.RelatedObjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelatedObjects", arg0, arg1)
+	ret0, _ := ret[0].(plugin.RelatedObjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelatedObjects indicates an expected call of RelatedObjects
+func (mr *MockModuleServiceMockRecorder) RelatedObjects(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelatedObjects", reflect.TypeOf((*MockModuleService)(nil).RelatedObjects), arg0, arg1)
+}
+
 // MockService is a mock of Service interface
 type MockService struct {
 	ctrl     *gomock.Controller
@@ -512,6 +554,21 @@ func (mr *MockServiceMockRecorder) Register(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockService)(nil).Register), arg0, arg1)
 }
 
+// RelatedObjects mocks base method
+func (m *MockService) RelatedObjects(arg0 context.Context, arg1 runtime.Object) (plugin.RelatedObjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelatedObjects", arg0, arg1)
+	ret0, _ := ret[0].(plugin.RelatedObjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelatedObjects indicates an expected call of RelatedObjects
+func (mr *MockServiceMockRecorder) RelatedObjects(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelatedObjects", reflect.TypeOf((*MockService)(nil).RelatedObjects), arg0, arg1)
+}
+
 // MockBroker is a mock of Broker interface
 type MockBroker struct {
 	ctrl     *gomock.Controller
@@ -69,6 +69,21 @@ func (mr *MockManagerInterfaceMockRecorder) Print(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Print", reflect.TypeOf((*MockManagerInterface)(nil).Print), arg0, arg1)
 }
 
+// RelatedObjects mocks base method
+func (m *MockManagerInterface) RelatedObjects(arg0 context.Context, arg1 runtime.Object) (*plugin.RelatedObjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelatedObjects", arg0, arg1)
+	ret0, _ := ret[0].(*plugin.RelatedObjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelatedObjects indicates an expected call of RelatedObjects
+func (mr *MockManagerInterfaceMockRecorder) RelatedObjects(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelatedObjects", reflect.TypeOf((*MockManagerInterface)(nil).RelatedObjects), arg0, arg1)
+}
+
 // SetOctantClient mocks base method
 func (m *MockManagerInterface) SetOctantClient(arg0 javascript.OctantClient) {
 	m.ctrl.T.Helper()
@@ -177,6 +177,26 @@ func (mr *MockPluginClientMockRecorder) PrintTabs(ctx, in interface{}, opts ...i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintTabs", reflect.TypeOf((*MockPluginClient)(nil).PrintTabs), varargs...)
 }
 
+// RelatedObjects mocks base method
+func (m *MockPluginClient) RelatedObjects(ctx context.Context, in *dashboard.ObjectRequest, opts ...grpc.CallOption) (*dashboard.RelatedObjectsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RelatedObjects", varargs...)
+	ret0, _ := ret[0].(*dashboard.RelatedObjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelatedObjects indicates an expected call of RelatedObjects
+func (mr *MockPluginClientMockRecorder) RelatedObjects(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelatedObjects", reflect.TypeOf((*MockPluginClient)(nil).RelatedObjects), varargs...)
+}
+
 // WatchAdd mocks base method
 func (m *MockPluginClient) WatchAdd(ctx context.Context, in *dashboard.WatchRequest, opts ...grpc.CallOption) (*dashboard.Empty, error) {
 	m.ctrl.T.Helper()
@@ -365,6 +385,21 @@ func (mr *MockPluginServerMockRecorder) PrintTabs(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintTabs", reflect.TypeOf((*MockPluginServer)(nil).PrintTabs), arg0, arg1)
 }
 
+// RelatedObjects mocks base method
+func (m *MockPluginServer) RelatedObjects(arg0 context.Context, arg1 *dashboard.ObjectRequest) (*dashboard.RelatedObjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelatedObjects", arg0, arg1)
+	ret0, _ := ret[0].(*dashboard.RelatedObjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelatedObjects indicates an expected call of RelatedObjects
+func (mr *MockPluginServerMockRecorder) RelatedObjects(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelatedObjects", reflect.TypeOf((*MockPluginServer)(nil).RelatedObjects), arg0, arg1)
+}
+
 // WatchAdd mocks base method
 func (m *MockPluginServer) WatchAdd(arg0 context.Context, arg1 *dashboard.WatchRequest) (*dashboard.Empty, error) {
 	m.ctrl.T.Helper()
@@ -7,6 +7,7 @@ package plugin
 
 import (
 	"context"
+	"time"
 
 	"github.com/vmware-tanzu/octant/internal/util/json"
 
@@ -17,7 +18,9 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/cluster"
 	ocontext "github.com/vmware-tanzu/octant/internal/context"
+	"github.com/vmware-tanzu/octant/internal/metrics"
 
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/navigation"
@@ -29,6 +32,8 @@ import (
 type GRPCClient struct {
 	broker Broker
 	client dashboard.PluginClient
+	// name is the name of the plugin. It labels RPC metrics.
+	name string
 }
 
 var _ Service = (*GRPCClient)(nil)
@@ -42,11 +47,12 @@ func NewGRPCClient(broker Broker, client dashboard.PluginClient) *GRPCClient {
 	}
 }
 
-func (c *GRPCClient) run(fn func() error) error {
+func (c *GRPCClient) run(method string, fn func() error) error {
 	if fn == nil {
 		return errors.New("client function is nil")
 	}
 
+	defer metrics.ObserveDuration(metrics.PluginRPCDuration.WithLabelValues(c.name, method), time.Now())
 	return fn()
 }
 
@@ -54,8 +60,8 @@ func (c *GRPCClient) run(fn func() error) error {
 func (c *GRPCClient) Content(ctx context.Context, contentPath string) (component.ContentResponse, error) {
 	var contentResponse component.ContentResponse
 
-	err := c.run(func() error {
-		clientState := ocontext.ClientStateFrom(ctx)
+	err := c.run("Content", func() error {
+		clientState := pluginClientState(ctx)
 		clientStateData, err := json.Marshal(&clientState)
 		if err != nil {
 			return err
@@ -87,13 +93,13 @@ func (c *GRPCClient) Content(ctx context.Context, contentPath string) (component
 
 // HandleAction runs an action on a plugin.
 func (c *GRPCClient) HandleAction(ctx context.Context, actionName string, payload action.Payload) error {
-	err := c.run(func() error {
+	err := c.run("HandleAction", func() error {
 		data, err := json.Marshal(&payload)
 		if err != nil {
 			return err
 		}
 
-		clientState := ocontext.ClientStateFrom(ctx)
+		clientState := pluginClientState(ctx)
 		clientStateData, err := json.Marshal(&clientState)
 		if err != nil {
 			return err
@@ -127,8 +133,8 @@ func (c *GRPCClient) Navigation(ctx context.Context) (navigation.Navigation, err
 		return navigation.Navigation{}, nil
 	}
 
-	err := c.run(func() error {
-		clientState := ocontext.ClientStateFrom(ctx)
+	err := c.run("Navigation", func() error {
+		clientState := pluginClientState(ctx)
 		clientStateData, err := json.Marshal(&clientState)
 		if err != nil {
 			return err
@@ -159,7 +165,7 @@ func (c *GRPCClient) Navigation(ctx context.Context) (navigation.Navigation, err
 func (c *GRPCClient) Register(ctx context.Context, dashboardAPIAddress string) (Metadata, error) {
 	var m Metadata
 
-	err := c.run(func() error {
+	err := c.run("Register", func() error {
 		registerRequest := &dashboard.RegisterRequest{
 			DashboardAPIAddress: dashboardAPIAddress,
 		}
@@ -192,8 +198,8 @@ func (c *GRPCClient) Register(ctx context.Context, dashboardAPIAddress string) (
 func (c *GRPCClient) ObjectStatus(ctx context.Context, object runtime.Object) (ObjectStatusResponse, error) {
 	var osr ObjectStatusResponse
 
-	err := c.run(func() error {
-		clientState := ocontext.ClientStateFrom(ctx)
+	err := c.run("ObjectStatus", func() error {
+		clientState := pluginClientState(ctx)
 		clientStateData, err := json.Marshal(&clientState)
 		if err != nil {
 			return err
@@ -228,12 +234,55 @@ func (c *GRPCClient) ObjectStatus(ctx context.Context, object runtime.Object) (O
 	return osr, nil
 }
 
+// RelatedObjects gets objects related to an object.
+func (c *GRPCClient) RelatedObjects(ctx context.Context, object runtime.Object) (RelatedObjectsResponse, error) {
+	var ror RelatedObjectsResponse
+
+	err := c.run("RelatedObjects", func() error {
+		clientState := pluginClientState(ctx)
+		clientStateData, err := json.Marshal(&clientState)
+		if err != nil {
+			return err
+		}
+
+		in, err := createObjectRequest(object, clientStateData)
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.client.RelatedObjects(ctx, in, grpc.WaitForReady(true))
+		if err != nil {
+			return errors.Wrap(err, "grpc client related objects")
+		}
+
+		if len(resp.Owners) > 0 {
+			if err := json.Unmarshal(resp.Owners, &ror.Owners); err != nil {
+				return errors.Wrap(err, "convert owners")
+			}
+		}
+
+		if len(resp.Related) > 0 {
+			if err := json.Unmarshal(resp.Related, &ror.Related); err != nil {
+				return errors.Wrap(err, "convert related objects")
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return RelatedObjectsResponse{}, err
+	}
+
+	return ror, nil
+}
+
 // Print prints an object.
 func (c *GRPCClient) Print(ctx context.Context, object runtime.Object) (PrintResponse, error) {
 	var pr PrintResponse
 
-	err := c.run(func() error {
-		clientState := ocontext.ClientStateFrom(ctx)
+	err := c.run("Print", func() error {
+		clientState := pluginClientState(ctx)
 		clientStateData, err := json.Marshal(&clientState)
 		if err != nil {
 			return err
@@ -282,6 +331,16 @@ func (c *GRPCClient) Print(ctx context.Context, object runtime.Object) (PrintRes
 	return pr, nil
 }
 
+// pluginClientState returns the client state sent to plugins. It includes the
+// identity in ctx so the plugin's calls to the dashboard API are made as it.
+func pluginClientState(ctx context.Context) ocontext.ClientState {
+	clientState := ocontext.ClientStateFrom(ctx)
+	if identity, ok := cluster.IdentityFrom(ctx); ok {
+		clientState.Identity = &identity
+	}
+	return clientState
+}
+
 func createObjectRequest(object runtime.Object, clientState []byte) (*dashboard.ObjectRequest, error) {
 	data, err := json.Marshal(object)
 	if err != nil {
@@ -300,8 +359,8 @@ func createObjectRequest(object runtime.Object, clientState []byte) (*dashboard.
 func (c *GRPCClient) PrintTabs(ctx context.Context, object runtime.Object) ([]TabResponse, error) {
 	var responses []TabResponse
 
-	err := c.run(func() error {
-		clientState := ocontext.ClientStateFrom(ctx)
+	err := c.run("PrintTabs", func() error {
+		clientState := pluginClientState(ctx)
 		clientStateData, err := json.Marshal(&clientState)
 		if err != nil {
 			return err
@@ -520,6 +579,42 @@ func (s *GRPCServer) ObjectStatus(ctx context.Context, objectRequest *dashboard.
 	return out, nil
 }
 
+// RelatedObjects returns objects related to an object.
+func (s *GRPCServer) RelatedObjects(ctx context.Context, objectRequest *dashboard.ObjectRequest) (*dashboard.RelatedObjectsResponse, error) {
+	u, err := decodeObjectRequest(objectRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	var clientState ocontext.ClientState
+	if err := json.Unmarshal(objectRequest.ClientState, &clientState); err != nil {
+		return nil, err
+	}
+
+	ctx = ocontext.WithClientState(ctx, clientState)
+	ror, err := s.Impl.RelatedObjects(ctx, u)
+	if err != nil {
+		return nil, errors.Wrap(err, "grpc server related objects")
+	}
+
+	ownerBytes, err := json.Marshal(ror.Owners)
+	if err != nil {
+		return nil, err
+	}
+
+	relatedBytes, err := json.Marshal(ror.Related)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &dashboard.RelatedObjectsResponse{
+		Owners:  ownerBytes,
+		Related: relatedBytes,
+	}
+
+	return out, nil
+}
+
 func decodeObjectRequest(req *dashboard.ObjectRequest) (*unstructured.Unstructured, error) {
 	m := map[string]interface{}{}
 
@@ -28,6 +28,7 @@ import (
 	"github.com/vmware-tanzu/octant/pkg/plugin"
 	"github.com/vmware-tanzu/octant/pkg/plugin/dashboard"
 	"github.com/vmware-tanzu/octant/pkg/plugin/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 	"github.com/vmware-tanzu/octant/pkg/view/flexlayout"
 )
@@ -371,6 +372,47 @@ func Test_GRPCClient_ObjectStatus(t *testing.T) {
 	})
 }
 
+func Test_GRPCClient_RelatedObjects(t *testing.T) {
+	testWithGRPCClient(t, func(mocks *grpcClientMocks) {
+		clientState := ocontext.ClientState{
+			ClientID:  "foo-client",
+			Namespace: "foo-namespace",
+		}
+		clientStateData, _ := json.Marshal(&clientState)
+
+		object := testutil.CreatePod("pod")
+
+		objectData, err := json.Marshal(object)
+		require.NoError(t, err)
+		objectRequest := &dashboard.ObjectRequest{
+			Object:      objectData,
+			ClientState: clientStateData,
+		}
+
+		owners := []store.Key{{APIVersion: "example.com/v1", Kind: "Widget", Namespace: "default", Name: "widget"}}
+		ownerData, err := json.Marshal(owners)
+		require.NoError(t, err)
+
+		relatedObjectsResponse := &dashboard.RelatedObjectsResponse{
+			Owners: ownerData,
+		}
+
+		mocks.protoClient.EXPECT().RelatedObjects(gomock.Any(), gomock.Eq(objectRequest), grpc.WaitForReady(true)).Return(relatedObjectsResponse, nil)
+
+		client := mocks.genClient()
+		ctx := context.Background()
+		ctx = ocontext.WithClientState(ctx, clientState)
+		got, err := client.RelatedObjects(ctx, object)
+		require.NoError(t, err)
+
+		expected := plugin.RelatedObjectsResponse{
+			Owners: owners,
+		}
+
+		assert.Equal(t, expected, got)
+	})
+}
+
 func Test_GRPCClient_HandleAction(t *testing.T) {
 	testWithGRPCClient(t, func(mocks *grpcClientMocks) {
 		clientState := ocontext.ClientState{
@@ -638,6 +680,58 @@ func Test_GRPCServer_ObjectStatus(t *testing.T) {
 	})
 }
 
+func Test_GRPCServer_RelatedObjects(t *testing.T) {
+	testWithGRPCServer(t, func(mocks *grpcServerMocks) {
+		object := testutil.CreatePod("pod")
+
+		ror := plugin.RelatedObjectsResponse{
+			Related: []store.Key{{APIVersion: "example.com/v1", Kind: "Widget", Namespace: "default", Name: "widget"}},
+		}
+
+		m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+		require.NoError(t, err)
+		u := &unstructured.Unstructured{Object: m}
+
+		clientState := ocontext.ClientState{
+			ClientID:  "foo-client",
+			Namespace: "foo-namespace",
+		}
+		clientStateData, _ := json.Marshal(&clientState)
+
+		mocks.service.EXPECT().RelatedObjects(gomock.Any(), gomock.Eq(u)).
+			Do(func(ctx context.Context, _ *unstructured.Unstructured) {
+				ps := ocontext.ClientStateFrom(ctx)
+				assert.Equal(t, clientState, ps)
+			}).
+			Return(ror, nil)
+
+		objectData, err := json.Marshal(object)
+		require.NoError(t, err)
+		objectRequest := &dashboard.ObjectRequest{
+			Object:      objectData,
+			ClientState: clientStateData,
+		}
+
+		ctx := context.Background()
+
+		server := mocks.genServer()
+		got, err := server.RelatedObjects(ctx, objectRequest)
+		require.NoError(t, err)
+
+		encodedOwners, err := json.Marshal(ror.Owners)
+		require.NoError(t, err)
+		encodedRelated, err := json.Marshal(ror.Related)
+		require.NoError(t, err)
+
+		expected := &dashboard.RelatedObjectsResponse{
+			Owners:  encodedOwners,
+			Related: encodedRelated,
+		}
+
+		assert.Equal(t, expected, got)
+	})
+}
+
 func encodeComponent(t *testing.T, view component.Component) []byte {
 	data, err := json.Marshal(view)
 	require.NoError(t, err)
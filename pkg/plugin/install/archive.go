@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package install
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// unpack returns the plugin file name and contents for an artifact. Archived
+// artifacts must name the plugin file with bin.
+func unpack(source, bin string, data []byte) (string, []byte, error) {
+	switch {
+	case strings.HasSuffix(source, ".tar.gz"), strings.HasSuffix(source, ".tgz"):
+		if bin == "" {
+			return "", nil, errors.New("archived artifacts must set bin")
+		}
+		contents, err := fromTarGz(data, bin)
+		if err != nil {
+			return "", nil, err
+		}
+		return pluginFileName(source, bin), contents, nil
+	case strings.HasSuffix(source, ".zip"):
+		if bin == "" {
+			return "", nil, errors.New("archived artifacts must set bin")
+		}
+		contents, err := fromZip(data, bin)
+		if err != nil {
+			return "", nil, err
+		}
+		return pluginFileName(source, bin), contents, nil
+	default:
+		return pluginFileName(source, bin), data, nil
+	}
+}
+
+func fromTarGz(data []byte, bin string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "read gzip")
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil, errors.Errorf("%s not found in archive", bin)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "read tar")
+		}
+
+		if header.Typeflag != tar.TypeReg || !archivePathMatches(header.Name, bin) {
+			continue
+		}
+
+		return ioutil.ReadAll(tr)
+	}
+}
+
+func fromZip(data []byte, bin string) ([]byte, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, errors.Wrap(err, "read zip")
+	}
+
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || !archivePathMatches(f.Name, bin) {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return nil, errors.Wrapf(err, "open %s", f.Name)
+		}
+		defer rc.Close()
+
+		return ioutil.ReadAll(rc)
+	}
+
+	return nil, errors.Errorf("%s not found in archive", bin)
+}
+
+func archivePathMatches(name, bin string) bool {
+	return path.Clean(strings.TrimPrefix(name, "./")) == path.Clean(strings.TrimPrefix(bin, "./"))
+}
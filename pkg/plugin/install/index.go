@@ -0,0 +1,184 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package install
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/util/version"
+	"sigs.k8s.io/yaml"
+)
+
+// Index is a list of plugins which can be installed.
+type Index struct {
+	Plugins []Entry `json:"plugins"`
+
+	// location is where the index was loaded from. Relative artifact URLs
+	// are resolved against it.
+	location string
+}
+
+// Entry is a plugin in an index.
+type Entry struct {
+	Name        string     `json:"name"`
+	Version     string     `json:"version"`
+	Description string     `json:"description,omitempty"`
+	Artifacts   []Artifact `json:"artifacts"`
+}
+
+// Artifact is a downloadable plugin build for an operating system and architecture.
+type Artifact struct {
+	OS   string `json:"os"`
+	Arch string `json:"arch"`
+	// URL is a path, file URL, or HTTP(S) URL. Relative paths are resolved against the
+	// index location.
+	URL string `json:"url"`
+	// SHA256 is the hex encoded checksum of the file URL points to.
+	SHA256 string `json:"sha256"`
+	// Bin is the plugin file inside a .tar.gz, .tgz, or .zip archive. It may also be
+	// used to rename a plugin that isn't archived.
+	Bin string `json:"bin,omitempty"`
+}
+
+// Validate validates an index entry.
+func (e Entry) Validate() error {
+	if e.Name == "" {
+		return errors.New("name is required")
+	}
+	if strings.ContainsAny(e.Name, `/\`) {
+		return errors.Errorf("name %q can't contain path separators", e.Name)
+	}
+	if e.Version == "" {
+		return errors.Errorf("%s: version is required", e.Name)
+	}
+
+	for _, artifact := range e.Artifacts {
+		if artifact.URL == "" {
+			return errors.Errorf("%s: artifact for %s/%s has no url", e.Name, artifact.OS, artifact.Arch)
+		}
+		if artifact.SHA256 == "" {
+			return errors.Errorf("%s: artifact for %s/%s has no sha256", e.Name, artifact.OS, artifact.Arch)
+		}
+	}
+
+	return nil
+}
+
+// Artifact returns the artifact for an operating system and architecture. An artifact
+// without an OS or architecture matches any.
+func (e Entry) Artifact(goos, goarch string) (Artifact, bool) {
+	for _, artifact := range e.Artifacts {
+		if (artifact.OS == "" || artifact.OS == goos) && (artifact.Arch == "" || artifact.Arch == goarch) {
+			return artifact, true
+		}
+	}
+
+	return Artifact{}, false
+}
+
+// Find finds a plugin by name.
+func (i *Index) Find(name string) (Entry, bool) {
+	for _, entry := range i.Plugins {
+		if entry.Name == name {
+			return entry, true
+		}
+	}
+
+	return Entry{}, false
+}
+
+// LoadIndex loads an index from a path, file URL, or HTTP(S) URL.
+func LoadIndex(ctx context.Context, fs afero.Fs, location string) (*Index, error) {
+	data, err := read(ctx, fs, location)
+	if err != nil {
+		return nil, errors.Wrap(err, "read plugin index")
+	}
+
+	index := &Index{location: location}
+	if err := yaml.Unmarshal(data, index); err != nil {
+		return nil, errors.Wrap(err, "parse plugin index")
+	}
+
+	for _, entry := range index.Plugins {
+		if err := entry.Validate(); err != nil {
+			return nil, errors.Wrap(err, "invalid plugin index")
+		}
+	}
+
+	return index, nil
+}
+
+// resolve resolves an artifact URL relative to the index location.
+func (i *Index) resolve(artifactURL string) string {
+	if isURL(artifactURL) || filepath.IsAbs(artifactURL) || i.location == "" {
+		return artifactURL
+	}
+
+	if isURL(i.location) {
+		base, err := url.Parse(i.location)
+		if err != nil {
+			return artifactURL
+		}
+		base.Path = path.Join(path.Dir(base.Path), artifactURL)
+		return base.String()
+	}
+
+	return filepath.Join(filepath.Dir(i.location), artifactURL)
+}
+
+// IsNewer returns true if version a is newer than version b. Versions which
+// aren't semantic versions are compared as strings and are newer if they differ.
+func IsNewer(a, b string) bool {
+	av, aErr := version.ParseGeneric(a)
+	bv, bErr := version.ParseGeneric(b)
+	if aErr != nil || bErr != nil {
+		return a != b
+	}
+
+	return bv.LessThan(av)
+}
+
+func isURL(location string) bool {
+	return strings.HasPrefix(location, "file://") ||
+		strings.HasPrefix(location, "http://") ||
+		strings.HasPrefix(location, "https://")
+}
+
+func read(ctx context.Context, fs afero.Fs, location string) ([]byte, error) {
+	switch {
+	case strings.HasPrefix(location, "file://"):
+		u, err := url.Parse(location)
+		if err != nil {
+			return nil, err
+		}
+		return afero.ReadFile(fs, filepath.FromSlash(u.Path))
+	case strings.HasPrefix(location, "http://"), strings.HasPrefix(location, "https://"):
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, errors.Errorf("get %s: %s", location, resp.Status)
+		}
+		return ioutil.ReadAll(resp.Body)
+	default:
+		return afero.ReadFile(fs, location)
+	}
+}
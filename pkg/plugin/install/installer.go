@@ -0,0 +1,341 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package install installs, updates, and removes plugins listed in a plugin index.
+package install
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+)
+
+// stateDir is the directory inside the plugin directory holding install receipts
+// and partially installed plugins. The plugin loader ignores directories.
+const stateDir = ".install"
+
+// Receipt records an installed plugin.
+type Receipt struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	// File is the name of the installed plugin file in the plugin directory.
+	File        string    `json:"file"`
+	SHA256      string    `json:"sha256"`
+	Source      string    `json:"source"`
+	InstalledAt time.Time `json:"installedAt"`
+}
+
+// Installer installs plugins into a plugin directory.
+type Installer struct {
+	fs     afero.Fs
+	dir    string
+	goos   string
+	goarch string
+	now    func() time.Time
+}
+
+// NewInstaller creates an instance of Installer. Plugins are installed into the
+// first directory of the plugin path, or the default plugin directory if no
+// plugin path is set.
+func NewInstaller(config plugin.Config) (*Installer, error) {
+	if config == nil {
+		return nil, errors.New("config is nil")
+	}
+
+	dirs, err := config.PluginDirs(config.Home())
+	if err != nil {
+		return nil, errors.Wrap(err, "get plugin directory")
+	}
+	if len(dirs) == 0 {
+		return nil, errors.New("no plugin directory is available")
+	}
+
+	i := &Installer{
+		fs: config.Fs(),
+		// Plugin path directories come before the default plugin directory.
+		dir:    dirs[0],
+		goos:   config.OS(),
+		goarch: runtime.GOARCH,
+		now:    time.Now,
+	}
+	return i, nil
+}
+
+// Dir returns the directory plugins are installed into.
+func (i *Installer) Dir() string {
+	return i.dir
+}
+
+// Install installs a plugin from an index. It is an error to install a plugin which
+// is already installed.
+func (i *Installer) Install(ctx context.Context, index *Index, name string) (Receipt, error) {
+	if _, err := i.receipt(name); err == nil {
+		return Receipt{}, errors.Errorf("plugin %s is already installed", name)
+	} else if !os.IsNotExist(errors.Cause(err)) {
+		return Receipt{}, err
+	}
+
+	return i.install(ctx, index, name)
+}
+
+// Update updates an installed plugin to the version in the index. It returns false
+// if the installed version is current.
+func (i *Installer) Update(ctx context.Context, index *Index, name string) (Receipt, bool, error) {
+	current, err := i.receipt(name)
+	if err != nil {
+		if os.IsNotExist(errors.Cause(err)) {
+			return Receipt{}, false, errors.Errorf("plugin %s is not installed", name)
+		}
+		return Receipt{}, false, err
+	}
+
+	entry, ok := index.Find(name)
+	if !ok {
+		return Receipt{}, false, errors.Errorf("plugin %s is not in the index", name)
+	}
+	if !IsNewer(entry.Version, current.Version) {
+		return current, false, nil
+	}
+
+	receipt, err := i.install(ctx, index, name)
+	if err != nil {
+		return Receipt{}, false, err
+	}
+
+	if current.File != receipt.File {
+		if err := i.fs.Remove(filepath.Join(i.dir, current.File)); err != nil && !os.IsNotExist(err) {
+			return Receipt{}, false, errors.Wrap(err, "remove previous version")
+		}
+	}
+
+	return receipt, true, nil
+}
+
+// Remove removes an installed plugin.
+func (i *Installer) Remove(name string) error {
+	receipt, err := i.receipt(name)
+	if err != nil {
+		if os.IsNotExist(errors.Cause(err)) {
+			return errors.Errorf("plugin %s is not installed", name)
+		}
+		return err
+	}
+
+	if err := i.fs.Remove(filepath.Join(i.dir, receipt.File)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove plugin")
+	}
+
+	if err := i.fs.Remove(i.receiptPath(name)); err != nil {
+		return errors.Wrap(err, "remove install receipt")
+	}
+
+	return nil
+}
+
+// Installed lists installed plugins sorted by name.
+func (i *Installer) Installed() ([]Receipt, error) {
+	fis, err := afero.ReadDir(i.fs, filepath.Join(i.dir, stateDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "read install receipts")
+	}
+
+	var receipts []Receipt
+	for _, fi := range fis {
+		if fi.IsDir() || filepath.Ext(fi.Name()) != ".yaml" {
+			continue
+		}
+
+		receipt, err := i.receipt(strings.TrimSuffix(fi.Name(), ".yaml"))
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+
+	sort.Slice(receipts, func(a, b int) bool {
+		return receipts[a].Name < receipts[b].Name
+	})
+
+	return receipts, nil
+}
+
+// InstalledVersions returns the versions of installed plugins keyed by their path.
+func InstalledVersions(config plugin.Config) (map[string]string, error) {
+	versions := map[string]string{}
+
+	dirs, err := config.PluginDirs(config.Home())
+	if err != nil || len(dirs) == 0 {
+		// There is nowhere plugins could have been installed.
+		return versions, err
+	}
+
+	installer, err := NewInstaller(config)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts, err := installer.Installed()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, receipt := range receipts {
+		versions[filepath.Join(installer.dir, receipt.File)] = receipt.Version
+	}
+
+	return versions, nil
+}
+
+func (i *Installer) install(ctx context.Context, index *Index, name string) (Receipt, error) {
+	entry, ok := index.Find(name)
+	if !ok {
+		return Receipt{}, errors.Errorf("plugin %s is not in the index", name)
+	}
+
+	artifact, ok := entry.Artifact(i.goos, i.goarch)
+	if !ok {
+		return Receipt{}, errors.Errorf("plugin %s has no artifact for %s/%s", name, i.goos, i.goarch)
+	}
+
+	source := index.resolve(artifact.URL)
+	data, err := read(ctx, i.fs, source)
+	if err != nil {
+		return Receipt{}, errors.Wrapf(err, "download %s", source)
+	}
+
+	if err := verifyChecksum(data, artifact.SHA256); err != nil {
+		return Receipt{}, errors.Wrapf(err, "verify %s", source)
+	}
+
+	fileName, contents, err := unpack(source, artifact.Bin, data)
+	if err != nil {
+		return Receipt{}, errors.Wrapf(err, "unpack %s", source)
+	}
+
+	if err := i.writePlugin(fileName, contents); err != nil {
+		return Receipt{}, err
+	}
+
+	receipt := Receipt{
+		Name:        entry.Name,
+		Version:     entry.Version,
+		File:        fileName,
+		SHA256:      strings.ToLower(artifact.SHA256),
+		Source:      source,
+		InstalledAt: i.now().UTC(),
+	}
+
+	if err := i.writeReceipt(receipt); err != nil {
+		return Receipt{}, err
+	}
+
+	return receipt, nil
+}
+
+// writePlugin writes a plugin into the plugin directory. The plugin is staged in
+// the state directory and renamed into place so the plugin watcher never sees a
+// partially written plugin.
+func (i *Installer) writePlugin(fileName string, contents []byte) error {
+	staging := filepath.Join(i.dir, stateDir)
+	if err := i.fs.MkdirAll(staging, 0700); err != nil {
+		return errors.Wrap(err, "create plugin directory")
+	}
+
+	f, err := afero.TempFile(i.fs, staging, "staged-")
+	if err != nil {
+		return errors.Wrap(err, "stage plugin")
+	}
+	staged := f.Name()
+	defer func() {
+		_ = i.fs.Remove(staged)
+	}()
+
+	if _, err := f.Write(contents); err != nil {
+		_ = f.Close()
+		return errors.Wrap(err, "stage plugin")
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "stage plugin")
+	}
+
+	mode := os.FileMode(0755)
+	if plugin.IsJavaScriptPlugin(fileName) {
+		mode = 0644
+	}
+	if err := i.fs.Chmod(staged, mode); err != nil {
+		return errors.Wrap(err, "set plugin permissions")
+	}
+
+	if err := i.fs.Rename(staged, filepath.Join(i.dir, fileName)); err != nil {
+		return errors.Wrap(err, "install plugin")
+	}
+
+	return nil
+}
+
+func (i *Installer) receiptPath(name string) string {
+	return filepath.Join(i.dir, stateDir, name+".yaml")
+}
+
+func (i *Installer) receipt(name string) (Receipt, error) {
+	data, err := afero.ReadFile(i.fs, i.receiptPath(name))
+	if err != nil {
+		return Receipt{}, errors.Wrapf(err, "read install receipt for %s", name)
+	}
+
+	var receipt Receipt
+	if err := yaml.Unmarshal(data, &receipt); err != nil {
+		return Receipt{}, errors.Wrapf(err, "parse install receipt for %s", name)
+	}
+
+	return receipt, nil
+}
+
+func (i *Installer) writeReceipt(receipt Receipt) error {
+	data, err := yaml.Marshal(receipt)
+	if err != nil {
+		return errors.Wrap(err, "encode install receipt")
+	}
+
+	if err := afero.WriteFile(i.fs, i.receiptPath(receipt.Name), data, 0600); err != nil {
+		return errors.Wrap(err, "write install receipt")
+	}
+
+	return nil
+}
+
+func verifyChecksum(data []byte, expected string) error {
+	sum := sha256.Sum256(data)
+	actual := hex.EncodeToString(sum[:])
+	if !strings.EqualFold(actual, expected) {
+		return errors.Errorf("checksum mismatch: expected %s, got %s", expected, actual)
+	}
+
+	return nil
+}
+
+// pluginFileName returns the name a plugin is installed as.
+func pluginFileName(source, bin string) string {
+	if bin != "" {
+		return path.Base(filepath.ToSlash(bin))
+	}
+	return path.Base(filepath.ToSlash(source))
+}
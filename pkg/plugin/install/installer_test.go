@@ -0,0 +1,302 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package install
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+)
+
+func TestInstaller_lifecycle(t *testing.T) {
+	fs := afero.NewMemMapFs()
+
+	writeArtifact(t, fs, "/index/sample-1.0.0.tar.gz", tarGz(t, "bin/sample", "v1"))
+	writeArtifact(t, fs, "/index/sample-1.1.0.zip", zipped(t, "sample", "v2"))
+	writeArtifact(t, fs, "/index/module.js", []byte("module"))
+
+	installer := newTestInstaller(t, fs)
+	ctx := context.Background()
+
+	index := loadIndex(t, fs, `
+plugins:
+- name: sample
+  version: 1.0.0
+  artifacts:
+  - os: windows
+    url: sample.exe
+    sha256: 0000
+  - os: linux
+    arch: amd64
+    url: sample-1.0.0.tar.gz
+    bin: bin/sample
+    sha256: %s
+- name: module
+  version: 0.1.0
+  artifacts:
+  - url: module.js
+    sha256: %s
+`, checksum(tarGz(t, "bin/sample", "v1")), checksum([]byte("module")))
+
+	receipt, err := installer.Install(ctx, index, "sample")
+	require.NoError(t, err)
+	assert.Equal(t, Receipt{
+		Name:        "sample",
+		Version:     "1.0.0",
+		File:        "sample",
+		SHA256:      checksum(tarGz(t, "bin/sample", "v1")),
+		Source:      "/index/sample-1.0.0.tar.gz",
+		InstalledAt: testTime(),
+	}, receipt)
+	assertPlugin(t, fs, "/custom/sample", "v1", 0755)
+
+	_, err = installer.Install(ctx, index, "sample")
+	require.Error(t, err, "installing twice")
+
+	_, err = installer.Install(ctx, index, "module")
+	require.NoError(t, err)
+	assertPlugin(t, fs, "/custom/module.js", "module", 0644)
+
+	found, err := plugin.AvailablePlugins(&testConfig{fs: fs})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/custom/module.js", "/custom/sample"}, found)
+
+	receipts, err := installer.Installed()
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+	assert.Equal(t, "module", receipts[0].Name)
+	assert.Equal(t, "sample", receipts[1].Name)
+
+	_, updated, err := installer.Update(ctx, index, "sample")
+	require.NoError(t, err)
+	assert.False(t, updated, "same version")
+
+	index = loadIndex(t, fs, `
+plugins:
+- name: sample
+  version: 1.1.0
+  artifacts:
+  - url: sample-1.1.0.zip
+    bin: sample
+    sha256: %s
+`, checksum(zipped(t, "sample", "v2")))
+
+	receipt, updated, err = installer.Update(ctx, index, "sample")
+	require.NoError(t, err)
+	assert.True(t, updated)
+	assert.Equal(t, "1.1.0", receipt.Version)
+	assertPlugin(t, fs, "/custom/sample", "v2", 0755)
+
+	versions, err := InstalledVersions(&testConfig{fs: fs})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"/custom/module.js": "0.1.0",
+		"/custom/sample":    "1.1.0",
+	}, versions)
+
+	require.NoError(t, installer.Remove("sample"))
+	exists, err := afero.Exists(fs, "/custom/sample")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	require.Error(t, installer.Remove("sample"), "removing twice")
+
+	receipts, err = installer.Installed()
+	require.NoError(t, err)
+	require.Len(t, receipts, 1)
+	assert.Equal(t, "module", receipts[0].Name)
+}
+
+func TestInstaller_Install_invalid(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	writeArtifact(t, fs, "/index/sample", []byte("sample"))
+	writeArtifact(t, fs, "/index/sample.tgz", tarGz(t, "sample", "sample"))
+
+	tests := []struct {
+		name  string
+		index string
+	}{
+		{
+			name: "checksum mismatch",
+			index: `
+plugins:
+- name: sample
+  version: 1.0.0
+  artifacts:
+  - url: sample
+    sha256: ` + checksum([]byte("other")),
+		},
+		{
+			name: "no artifact for platform",
+			index: `
+plugins:
+- name: sample
+  version: 1.0.0
+  artifacts:
+  - os: windows
+    url: sample
+    sha256: ` + checksum([]byte("sample")),
+		},
+		{
+			name: "not in index",
+			index: `
+plugins: []`,
+		},
+		{
+			name: "missing bin in archive",
+			index: `
+plugins:
+- name: sample
+  version: 1.0.0
+  artifacts:
+  - url: sample.tgz
+    bin: missing
+    sha256: ` + checksum(tarGz(t, "sample", "sample")),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			installer := newTestInstaller(t, fs)
+			index := loadIndex(t, fs, test.index)
+
+			_, err := installer.Install(context.Background(), index, "sample")
+			require.Error(t, err)
+
+			exists, err := afero.Exists(fs, "/custom/sample")
+			require.NoError(t, err)
+			assert.False(t, exists)
+		})
+	}
+}
+
+func TestLoadIndex_invalid(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/index/index.yaml", []byte(`
+plugins:
+- name: ../sample
+  version: 1.0.0
+`), 0644))
+
+	_, err := LoadIndex(context.Background(), fs, "/index/index.yaml")
+	require.Error(t, err)
+}
+
+func TestIsNewer(t *testing.T) {
+	assert.True(t, IsNewer("1.10.0", "1.9.0"))
+	assert.True(t, IsNewer("v0.2.0", "0.1.0"))
+	assert.False(t, IsNewer("1.0.0", "1.0.0"))
+	assert.False(t, IsNewer("1.0.0", "1.1.0"))
+	assert.True(t, IsNewer("nightly-2", "nightly-1"))
+}
+
+type testConfig struct {
+	fs afero.Fs
+}
+
+var _ plugin.Config = (*testConfig)(nil)
+
+func (c *testConfig) PluginDirs(string) ([]string, error) {
+	return []string{"/custom", "/plugins"}, nil
+}
+
+func (c *testConfig) Home() string {
+	return "/home"
+}
+
+func (c *testConfig) Fs() afero.Fs {
+	return c.fs
+}
+
+func (c *testConfig) OS() string {
+	return "linux"
+}
+
+func newTestInstaller(t *testing.T, fs afero.Fs) *Installer {
+	installer, err := NewInstaller(&testConfig{fs: fs})
+	require.NoError(t, err)
+
+	installer.goarch = "amd64"
+	installer.now = testTime
+	return installer
+}
+
+func testTime() time.Time {
+	return time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func loadIndex(t *testing.T, fs afero.Fs, format string, args ...interface{}) *Index {
+	require.NoError(t, afero.WriteFile(fs, "/index/index.yaml", []byte(fmt.Sprintf(format, args...)), 0644))
+
+	index, err := LoadIndex(context.Background(), fs, "/index/index.yaml")
+	require.NoError(t, err)
+	return index
+}
+
+func writeArtifact(t *testing.T, fs afero.Fs, name string, data []byte) {
+	require.NoError(t, afero.WriteFile(fs, name, data, 0644))
+}
+
+func assertPlugin(t *testing.T, fs afero.Fs, name, contents string, mode uint32) {
+	data, err := afero.ReadFile(fs, name)
+	require.NoError(t, err)
+	assert.Equal(t, contents, string(data))
+
+	fi, err := fs.Stat(name)
+	require.NoError(t, err)
+	assert.Equal(t, mode, uint32(fi.Mode().Perm()), filepath.Base(name))
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func tarGz(t *testing.T, name, contents string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	require.NoError(t, tw.WriteHeader(&tar.Header{
+		Name:     name,
+		Mode:     0755,
+		Size:     int64(len(contents)),
+		Typeflag: tar.TypeReg,
+	}))
+	_, err := tw.Write([]byte(contents))
+	require.NoError(t, err)
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func zipped(t *testing.T, name, contents string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	w, err := zw.Create(name)
+	require.NoError(t, err)
+	_, err = w.Write([]byte(contents))
+	require.NoError(t, err)
+
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/log"
 	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -77,6 +78,7 @@ type JSPlugin interface {
 	Print(ctx context.Context, object runtime.Object) (PrintResponse, error)
 	PrintTabs(ctx context.Context, object runtime.Object) ([]TabResponse, error)
 	ObjectStatus(ctx context.Context, object runtime.Object) (ObjectStatusResponse, error)
+	RelatedObjects(ctx context.Context, object runtime.Object) (RelatedObjectsResponse, error)
 	HandleAction(ctx context.Context, actionName string, payload action.Payload) error
 	Content(ctx context.Context, contentPath string) (component.ContentResponse, error)
 }
@@ -450,6 +452,35 @@ func (t *jsPlugin) ObjectStatus(ctx context.Context, object runtime.Object) (Obj
 	}, nil
 }
 
+// RelatedObjects returns the related objects from a JavaScript plugins related objects handler.
+func (t *jsPlugin) RelatedObjects(ctx context.Context, object runtime.Object) (RelatedObjectsResponse, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	roResponse, err := t.objectRequestCall(ctx, "relatedObjectsHandler", object)
+	if err != nil {
+		return RelatedObjectsResponse{}, err
+	}
+
+	jsonRO, err := json.Marshal(roResponse.Export())
+	if err != nil {
+		return RelatedObjectsResponse{}, fmt.Errorf("unable to marshal related objects: %w", err)
+	}
+
+	var response struct {
+		Owners  []store.Key `json:"owners"`
+		Related []store.Key `json:"related"`
+	}
+	if err := json.Unmarshal(jsonRO, &response); err != nil {
+		return RelatedObjectsResponse{}, fmt.Errorf("unable to unmarshal related objects: %w", err)
+	}
+
+	return RelatedObjectsResponse{
+		Owners:  response.Owners,
+		Related: response.Related,
+	}, nil
+}
+
 // HandleAction calls the JavaScript plugins action handler.
 func (t *jsPlugin) HandleAction(ctx context.Context, actionPath string, payload action.Payload) error {
 	t.mu.Lock()
@@ -662,6 +693,12 @@ func extractMetadata(vm *goja.Runtime, pluginValue goja.Value) (*Metadata, error
 					return nil, fmt.Errorf("extractGvks: %w", err)
 				}
 				metadata.Capabilities.SupportsObjectStatus = append(metadata.Capabilities.SupportsObjectStatus, GVKs...)
+			case "supportRelatedObjects":
+				GVKs, err := javascript.ConvertToGVKs(k, v)
+				if err != nil {
+					return nil, fmt.Errorf("extractGvks: %w", err)
+				}
+				metadata.Capabilities.SupportsRelatedObjects = append(metadata.Capabilities.SupportsRelatedObjects, GVKs...)
 			case "supportTab":
 				GVKs, err := javascript.ConvertToGVKs(k, v)
 				if err != nil {
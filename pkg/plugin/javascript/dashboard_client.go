@@ -49,7 +49,7 @@ func (m *ModularDashboardClientFactory) Create(ctx context.Context, vm *goja.Run
 // OctantClient is a client for interacting with Octant.
 type OctantClient interface {
 	octant.LinkGenerator
-	octant.Storage
+	PreferenceStorage
 }
 
 // DefaultFunctions are the default functions for the ModularDashboardClientFactory.
@@ -61,6 +61,8 @@ func DefaultFunctions(octantClient OctantClient, wsClient event.WSClientGetter)
 		NewDashboardDelete(octantClient),
 		NewDashboardRefPath(octantClient),
 		NewDashboardSendEvent(wsClient),
+		NewDashboardGetPreference(octantClient),
+		NewDashboardSetPreference(octantClient),
 	}
 }
 
@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package javascript
+
+import (
+	"context"
+
+	"github.com/dop251/goja"
+
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+)
+
+// PreferenceStorage stores preferences for the current context.
+type PreferenceStorage interface {
+	octant.Storage
+	CurrentContext() string
+}
+
+// DashboardGetPreference is a function that gets a preference stored by a
+// plugin for the current context.
+type DashboardGetPreference struct {
+	storage PreferenceStorage
+}
+
+var _ octant.DashboardClientFunction = &DashboardGetPreference{}
+
+// NewDashboardGetPreference creates an instance of DashboardGetPreference.
+func NewDashboardGetPreference(storage PreferenceStorage) *DashboardGetPreference {
+	return &DashboardGetPreference{
+		storage: storage,
+	}
+}
+
+// Name returns the name of this function. It will always return "GetPreference".
+func (d *DashboardGetPreference) Name() string {
+	return "GetPreference"
+}
+
+// Call creates a function call that gets a preference by key. Keys which
+// are not set return an empty string.
+func (d *DashboardGetPreference) Call(_ context.Context, vm *goja.Runtime) func(c goja.FunctionCall) goja.Value {
+	return func(c goja.FunctionCall) goja.Value {
+		key := c.Argument(0).String()
+
+		prefs := d.storage.Preferences().Get(d.storage.CurrentContext())
+		return vm.ToValue(prefs.Plugins[key])
+	}
+}
+
+// DashboardSetPreference is a function that stores a preference for the
+// current context.
+type DashboardSetPreference struct {
+	storage PreferenceStorage
+}
+
+var _ octant.DashboardClientFunction = &DashboardSetPreference{}
+
+// NewDashboardSetPreference creates an instance of DashboardSetPreference.
+func NewDashboardSetPreference(storage PreferenceStorage) *DashboardSetPreference {
+	return &DashboardSetPreference{
+		storage: storage,
+	}
+}
+
+// Name returns the name of this function. It will always return "SetPreference".
+func (d *DashboardSetPreference) Name() string {
+	return "SetPreference"
+}
+
+// Call creates a function call that stores a preference. Keys are shared by
+// all plugins, so plugins should prefix them with their name. Setting an empty
+// string removes the preference. If the preference can't be saved, it will
+// throw a javascript exception.
+func (d *DashboardSetPreference) Call(_ context.Context, vm *goja.Runtime) func(c goja.FunctionCall) goja.Value {
+	return func(c goja.FunctionCall) goja.Value {
+		key := c.Argument(0).String()
+		value := c.Argument(1).String()
+
+		err := d.storage.Preferences().Update(d.storage.CurrentContext(), func(prefs *preferences.Context) {
+			prefs.SetPlugin(key, value)
+		})
+		if err != nil {
+			panic(panicMessage(vm, err, "dashboardClient.SetPreference"))
+		}
+
+		return goja.Undefined()
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package javascript
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/octant/fake"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+)
+
+type preferenceStorage struct {
+	*fake.MockStorage
+	contextName string
+}
+
+func (s *preferenceStorage) CurrentContext() string {
+	return s.contextName
+}
+
+func newPreferenceStorage(t *testing.T, ctrl *gomock.Controller) (*preferenceStorage, *preferences.FileStore) {
+	store, err := preferences.NewFileStore("")
+	require.NoError(t, err)
+
+	storage := fake.NewMockStorage(ctrl)
+	storage.EXPECT().Preferences().Return(store).AnyTimes()
+
+	return &preferenceStorage{MockStorage: storage, contextName: "dev"}, store
+}
+
+func TestDashboardPreference_Name(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage, _ := newPreferenceStorage(t, ctrl)
+
+	require.Equal(t, "GetPreference", NewDashboardGetPreference(storage).Name())
+	require.Equal(t, "SetPreference", NewDashboardSetPreference(storage).Name())
+}
+
+func TestDashboardSetPreference_Call(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage, store := newPreferenceStorage(t, ctrl)
+
+	runner := functionRunner{}
+	runner.run(context.Background(), t, NewDashboardSetPreference(storage), `dashClient.SetPreference('my-plugin.mode', 'compact')`)
+
+	require.Equal(t, map[string]string{"my-plugin.mode": "compact"}, store.Get("dev").Plugins)
+	require.Empty(t, store.Get("prod").Plugins, "preferences are stored for the current context")
+}
+
+func TestDashboardGetPreference_Call(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage, store := newPreferenceStorage(t, ctrl)
+	require.NoError(t, store.Update("dev", func(c *preferences.Context) {
+		c.SetPlugin("my-plugin.mode", "compact")
+	}))
+
+	runner := functionRunner{}
+	runner.run(context.Background(), t, NewDashboardGetPreference(storage),
+		`if (dashClient.GetPreference('my-plugin.mode') !== 'compact') { throw new Error('unexpected value') }
+		 if (dashClient.GetPreference('missing') !== '') { throw new Error('unexpected missing value') }`)
+}
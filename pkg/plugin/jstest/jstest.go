@@ -0,0 +1,280 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package jstest provides a harness for testing JavaScript plugins. A bundled plugin
+// is loaded into the same runtime Octant uses, backed by an in-memory dashboard client.
+package jstest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	ocontext "github.com/vmware-tanzu/octant/internal/context"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/internal/search"
+	"github.com/vmware-tanzu/octant/internal/util/json"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/event"
+	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+	"github.com/vmware-tanzu/octant/pkg/plugin/javascript"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// DefaultClientID is the websocket client ID handlers see in their client state.
+const DefaultClientID = "jstest"
+
+// DefaultContext is the kubeconfig context preferences are stored for.
+const DefaultContext = "jstest"
+
+// ObjectPathFunc generates a path for an object reference.
+type ObjectPathFunc func(namespace, apiVersion, kind, name string) (string, error)
+
+// Option is an option for configuring a Harness.
+type Option func(*Harness)
+
+// WithObjects seeds the harness store with objects.
+func WithObjects(objects ...*unstructured.Unstructured) Option {
+	return func(h *Harness) {
+		h.seed = append(h.seed, objects...)
+	}
+}
+
+// WithClientID sets the client ID handlers see in their client state.
+func WithClientID(clientID string) Option {
+	return func(h *Harness) {
+		h.clientState.ClientID = clientID
+	}
+}
+
+// WithNamespace sets the namespace handlers see in their client state.
+func WithNamespace(namespace string) Option {
+	return func(h *Harness) {
+		h.clientState.Namespace = namespace
+	}
+}
+
+// WithObjectPath replaces the function used to answer dashboardClient.RefPath.
+func WithObjectPath(fn ObjectPathFunc) Option {
+	return func(h *Harness) {
+		h.objectPath = fn
+	}
+}
+
+// Harness runs a JavaScript plugin against an in-memory dashboard client.
+type Harness struct {
+	plugin      plugin.JSPlugin
+	store       *Store
+	preferences *preferences.FileStore
+	events      *eventRecorder
+
+	seed        []*unstructured.Unstructured
+	clientState ocontext.ClientState
+	objectPath  ObjectPathFunc
+}
+
+// New loads the plugin at pluginPath. The plugin should be bundled the same way it
+// would be for installation into Octant.
+func New(ctx context.Context, pluginPath string, options ...Option) (*Harness, error) {
+	h := &Harness{
+		events:      &eventRecorder{},
+		clientState: ocontext.ClientState{ClientID: DefaultClientID},
+		objectPath:  defaultObjectPath,
+	}
+
+	for _, option := range options {
+		option(h)
+	}
+
+	h.store = NewStore(h.seed...)
+
+	prefs, err := preferences.NewFileStore("")
+	if err != nil {
+		return nil, fmt.Errorf("create preferences: %w", err)
+	}
+	h.preferences = prefs
+
+	functions := javascript.DefaultFunctions(&octantClient{harness: h}, h.events)
+	factory := javascript.NewModularDashboardClientFactory(functions)
+
+	p, err := plugin.NewJSPlugin(h.withClientState(ctx), pluginPath, factory)
+	if err != nil {
+		return nil, fmt.Errorf("load plugin %s: %w", pluginPath, err)
+	}
+	h.plugin = p
+
+	return h, nil
+}
+
+// Load is New for use in tests. It fails the test if the plugin can't be loaded and
+// closes the plugin when the test completes.
+func Load(t *testing.T, pluginPath string, options ...Option) *Harness {
+	h, err := New(context.Background(), pluginPath, options...)
+	require.NoError(t, err)
+	t.Cleanup(h.Close)
+	return h
+}
+
+// Close stops the plugin's runtime.
+func (h *Harness) Close() {
+	h.plugin.Close()
+}
+
+// Metadata returns the plugin's metadata.
+func (h *Harness) Metadata() *plugin.Metadata {
+	return h.plugin.Metadata()
+}
+
+// Store returns the store backing the dashboard client.
+func (h *Harness) Store() *Store {
+	return h.store
+}
+
+// Preferences returns the preferences backing dashboardClient.GetPreference
+// and dashboardClient.SetPreference. They are stored for DefaultContext.
+func (h *Harness) Preferences() *preferences.FileStore {
+	return h.preferences
+}
+
+// Events returns the events the plugin sent with dashboardClient.SendEvent.
+func (h *Harness) Events() []event.Event {
+	return h.events.list()
+}
+
+// Alerts returns the alerts the plugin sent with dashboardClient.SendEvent.
+func (h *Harness) Alerts() []action.Alert {
+	var alerts []action.Alert
+	for _, e := range h.Events() {
+		if e.Type != event.EventTypeAlert {
+			continue
+		}
+
+		data, ok := e.Data.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		payload := action.Payload(data)
+		alertType, _ := payload.OptionalString("type")
+		message, _ := payload.OptionalString("message")
+
+		alerts = append(alerts, action.Alert{
+			Type:    action.AlertType(alertType),
+			Message: message,
+		})
+	}
+	return alerts
+}
+
+// Content calls the plugin's contentHandler.
+func (h *Harness) Content(ctx context.Context, contentPath string) (component.ContentResponse, error) {
+	return h.plugin.Content(h.withClientState(ctx), contentPath)
+}
+
+// Print calls the plugin's printHandler.
+func (h *Harness) Print(ctx context.Context, object runtime.Object) (plugin.PrintResponse, error) {
+	return h.plugin.Print(h.withClientState(ctx), object)
+}
+
+// PrintTabs calls the plugin's tabHandler.
+func (h *Harness) PrintTabs(ctx context.Context, object runtime.Object) ([]plugin.TabResponse, error) {
+	return h.plugin.PrintTabs(h.withClientState(ctx), object)
+}
+
+// HandleAction calls the plugin's actionHandler.
+func (h *Harness) HandleAction(ctx context.Context, actionName string, payload action.Payload) error {
+	return h.plugin.HandleAction(h.withClientState(ctx), actionName, payload)
+}
+
+// Navigation calls the plugin's navigationHandler.
+func (h *Harness) Navigation(ctx context.Context) (navigation.Navigation, error) {
+	return h.plugin.Navigation(h.withClientState(ctx))
+}
+
+func (h *Harness) withClientState(ctx context.Context) context.Context {
+	return ocontext.WithClientState(ctx, h.clientState)
+}
+
+// AssertJSONEq asserts the JSON encoding of actual is equal to expected. Components
+// are encoded the same way they are sent to the frontend.
+func AssertJSONEq(t *testing.T, expected string, actual interface{}) {
+	t.Helper()
+
+	data, err := json.Marshal(actual)
+	require.NoError(t, err)
+	require.JSONEq(t, expected, string(data))
+}
+
+// octantClient answers the dashboard client's Get, List, Update, Delete, RefPath,
+// GetPreference, and SetPreference calls.
+type octantClient struct {
+	harness *Harness
+}
+
+var _ javascript.OctantClient = (*octantClient)(nil)
+
+func (c *octantClient) ObjectPath(namespace, apiVersion, kind, name string) (string, error) {
+	return c.harness.objectPath(namespace, apiVersion, kind, name)
+}
+
+func (c *octantClient) ObjectStore() store.Store {
+	return c.harness.store
+}
+
+func (c *octantClient) Preferences() preferences.Store {
+	return c.harness.preferences
+}
+
+// Search returns an empty index. Plugins can't search cached objects.
+func (c *octantClient) Search() search.Searcher {
+	return search.NewIndex()
+}
+
+func (c *octantClient) CurrentContext() string {
+	return DefaultContext
+}
+
+func defaultObjectPath(namespace, apiVersion, kind, name string) (string, error) {
+	if namespace == "" {
+		return fmt.Sprintf("/%s/%s/%s", apiVersion, kind, name), nil
+	}
+	return fmt.Sprintf("/%s/%s/%s/%s", namespace, apiVersion, kind, name), nil
+}
+
+// eventRecorder captures events sent to any websocket client.
+type eventRecorder struct {
+	mu     sync.Mutex
+	events []event.Event
+}
+
+var _ event.WSClientGetter = (*eventRecorder)(nil)
+var _ event.WSEventSender = (*eventRecorder)(nil)
+
+func (r *eventRecorder) Get(_ string) event.WSEventSender {
+	return r
+}
+
+func (r *eventRecorder) Send(e event.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, e)
+}
+
+func (r *eventRecorder) list() []event.Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	events := make([]event.Event, len(r.events))
+	copy(events, r.events)
+	return events
+}
@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package jstest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const pluginPath = "testdata/plugin.js"
+
+func TestHarness_Metadata(t *testing.T) {
+	h := Load(t, pluginPath)
+
+	metadata := h.Metadata()
+	assert.Equal(t, "jstest", metadata.Name)
+	assert.True(t, metadata.Capabilities.IsModule)
+	assert.Equal(t, []string{"jstest/create", "jstest/delete"}, metadata.Capabilities.ActionNames)
+}
+
+func TestHarness_Content(t *testing.T) {
+	h := Load(t, pluginPath,
+		WithNamespace("namespace"),
+		WithObjects(
+			testutil.ToUnstructured(t, testutil.CreatePod("pod-b")),
+			testutil.ToUnstructured(t, testutil.CreatePod("pod-a")),
+		))
+
+	cr, err := h.Content(context.Background(), "/")
+	require.NoError(t, err)
+
+	AssertJSONEq(t, `{
+		"title": [{"metadata": {"type": "text"}, "config": {"value": "jstest /"}}],
+		"viewComponents": [{"metadata": {"type": "text"}, "config": {"value": "pods: pod-a,pod-b"}}]
+	}`, cr)
+}
+
+func TestHarness_Print(t *testing.T) {
+	h := Load(t, pluginPath)
+
+	pr, err := h.Print(context.Background(), testutil.CreatePod("pod"))
+	require.NoError(t, err)
+
+	require.Len(t, pr.Config, 1)
+	assert.Equal(t, "Name", pr.Config[0].Header)
+	AssertJSONEq(t, `{"metadata": {"type": "text"}, "config": {"value": "pod"}}`, pr.Config[0].Content)
+}
+
+func TestHarness_PrintTabs(t *testing.T) {
+	h := Load(t, pluginPath)
+
+	tabs, err := h.PrintTabs(context.Background(), testutil.CreatePod("pod"))
+	require.NoError(t, err)
+
+	require.Len(t, tabs, 1)
+	assert.Equal(t, "Extra", tabs[0].Tab.Name)
+	require.Len(t, tabs[0].Tab.Contents.Config.Sections, 1)
+	AssertJSONEq(t, `{"metadata": {"type": "text"}, "config": {"value": "pod"}}`,
+		tabs[0].Tab.Contents.Config.Sections[0][0].View)
+}
+
+func TestHarness_HandleAction(t *testing.T) {
+	h := Load(t, pluginPath,
+		WithNamespace("namespace"),
+		WithObjects(testutil.ToUnstructured(t, testutil.CreateConfigMap("existing"))))
+
+	ctx := context.Background()
+
+	require.NoError(t, h.HandleAction(ctx, "jstest/create", action.Payload{"name": "created"}))
+	require.NoError(t, h.HandleAction(ctx, "jstest/delete", action.Payload{"name": "existing"}))
+	require.Error(t, h.HandleAction(ctx, "jstest/unknown", action.Payload{}))
+	require.Error(t, h.HandleAction(ctx, "jstest/delete", action.Payload{"name": "missing"}))
+
+	calls := h.Store().Calls()
+	require.Len(t, calls, 2)
+
+	assert.Equal(t, OperationCreate, calls[0].Operation)
+	assert.Equal(t, store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "ConfigMap", Name: "created"}, calls[0].Key)
+	assert.Equal(t, OperationDelete, calls[1].Operation)
+	assert.Equal(t, store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "ConfigMap", Name: "existing"}, calls[1].Key)
+
+	assert.Equal(t, []action.Alert{{Type: action.AlertTypeInfo, Message: "created created"}}, h.Alerts())
+}
+
+func TestHarness_Navigation(t *testing.T) {
+	h := Load(t, pluginPath)
+
+	nav, err := h.Navigation(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "JS Test", nav.Title)
+	assert.Equal(t, "jstest", nav.Path)
+}
@@ -0,0 +1,288 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package jstest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// Operation is the type of mutation recorded by Store.
+type Operation string
+
+const (
+	// OperationCreate is recorded when an object is created.
+	OperationCreate Operation = "create"
+	// OperationUpdate is recorded when an object is updated.
+	OperationUpdate Operation = "update"
+	// OperationDelete is recorded when an object is deleted.
+	OperationDelete Operation = "delete"
+)
+
+// Call is a mutation made against Store.
+type Call struct {
+	Operation Operation
+	Key       store.Key
+	// Object is the object after the mutation. It is nil for deletes.
+	Object *unstructured.Unstructured
+}
+
+// Store is an in-memory store.Store. It is seeded with objects and records
+// every create, update, and delete made against it.
+type Store struct {
+	mu      sync.Mutex
+	objects map[store.Key]*unstructured.Unstructured
+	calls   []Call
+}
+
+var _ store.Store = (*Store)(nil)
+
+// NewStore creates an instance of Store seeded with objects.
+func NewStore(objects ...*unstructured.Unstructured) *Store {
+	s := &Store{
+		objects: map[store.Key]*unstructured.Unstructured{},
+	}
+
+	for _, object := range objects {
+		s.objects[objectKey(object)] = object.DeepCopy()
+	}
+
+	return s
+}
+
+// Calls returns the mutations made against the store in the order they were made.
+func (s *Store) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	calls := make([]Call, len(s.calls))
+	copy(calls, s.calls)
+	return calls
+}
+
+// CallsFor returns the mutations of a given operation made against the store.
+func (s *Store) CallsFor(operation Operation) []Call {
+	var calls []Call
+	for _, call := range s.Calls() {
+		if call.Operation == operation {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// List lists objects matching the key's namespace, apiVersion, kind, and selector.
+func (s *Store) List(_ context.Context, key store.Key) (*unstructured.UnstructuredList, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var selector labels.Selector
+	if key.Selector != nil {
+		selector = key.Selector.AsSelector()
+	}
+
+	list := &unstructured.UnstructuredList{}
+	for k, object := range s.objects {
+		if k.APIVersion != key.APIVersion || k.Kind != key.Kind {
+			continue
+		}
+		if key.Namespace != "" && k.Namespace != key.Namespace {
+			continue
+		}
+		if selector != nil && !selector.Matches(labels.Set(object.GetLabels())) {
+			continue
+		}
+		list.Items = append(list.Items, *object.DeepCopy())
+	}
+
+	sort.Slice(list.Items, func(i, j int) bool {
+		a, b := list.Items[i], list.Items[j]
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		return a.GetName() < b.GetName()
+	})
+
+	return list, false, nil
+}
+
+// Get gets an object by key. It returns a not found error if the object does not exist.
+func (s *Store) Get(_ context.Context, key store.Key) (*unstructured.Unstructured, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	object, ok := s.objects[normalizeKey(key)]
+	if !ok {
+		return nil, notFound(key)
+	}
+
+	return object.DeepCopy(), nil
+}
+
+// Delete deletes an object by key.
+func (s *Store) Delete(_ context.Context, key store.Key) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key = normalizeKey(key)
+	if _, ok := s.objects[key]; !ok {
+		return notFound(key)
+	}
+
+	delete(s.objects, key)
+	s.calls = append(s.calls, Call{Operation: OperationDelete, Key: key})
+
+	return nil
+}
+
+// Watch does nothing.
+func (s *Store) Watch(_ context.Context, _ store.Key, _ cache.ResourceEventHandler) error {
+	return nil
+}
+
+// Unwatch does nothing.
+func (s *Store) Unwatch(_ context.Context, _ ...schema.GroupVersionKind) error {
+	return nil
+}
+
+// UpdateClusterClient does nothing.
+func (s *Store) UpdateClusterClient(_ context.Context, _ cluster.ClientInterface) error {
+	return nil
+}
+
+// Update updates an object in place using updater.
+func (s *Store) Update(_ context.Context, key store.Key, updater func(*unstructured.Unstructured) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key = normalizeKey(key)
+	object, ok := s.objects[key]
+	if !ok {
+		return notFound(key)
+	}
+
+	updated := object.DeepCopy()
+	if err := updater(updated); err != nil {
+		return err
+	}
+
+	s.objects[key] = updated
+	s.calls = append(s.calls, Call{Operation: OperationUpdate, Key: key, Object: updated.DeepCopy()})
+
+	return nil
+}
+
+// IsLoading always returns false.
+func (s *Store) IsLoading(_ context.Context, _ store.Key) bool {
+	return false
+}
+
+// Health always returns a synced resource.
+func (s *Store) Health(_ context.Context, _ store.Key) (store.Health, error) {
+	return store.Health{Synced: true}, nil
+}
+
+// Create creates an object. It returns an already exists error if the object exists.
+func (s *Store) Create(_ context.Context, object *unstructured.Unstructured) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.create(object)
+}
+
+// CreateOrUpdateFromYAML creates or replaces the objects in input. Objects without
+// a namespace are placed in namespace. The results mirror the messages returned by the
+// cluster backed object store.
+func (s *Store) CreateOrUpdateFromYAML(_ context.Context, namespace, input string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var results []string
+
+	d := yaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(input), 4096)
+	for {
+		doc := map[string]interface{}{}
+		if err := d.Decode(&doc); err != nil {
+			if err == io.EOF {
+				return results, nil
+			}
+			return results, fmt.Errorf("unable to parse yaml: %w", err)
+		}
+		if len(doc) == 0 {
+			// skip empty documents
+			continue
+		}
+
+		object := &unstructured.Unstructured{Object: doc}
+		if object.GetNamespace() == "" {
+			object.SetNamespace(namespace)
+		}
+
+		key := objectKey(object)
+		if _, ok := s.objects[key]; !ok {
+			if err := s.create(object); err != nil {
+				return results, fmt.Errorf("unable to create resource: %w", err)
+			}
+			results = append(results, fmt.Sprintf("Created %s (%s) %s in %s", key.Kind, key.APIVersion, key.Name, key.Namespace))
+			continue
+		}
+
+		s.objects[key] = object.DeepCopy()
+		s.calls = append(s.calls, Call{Operation: OperationUpdate, Key: key, Object: object.DeepCopy()})
+		results = append(results, fmt.Sprintf("Updated %s (%s) %s in %s", key.Kind, key.APIVersion, key.Name, key.Namespace))
+	}
+}
+
+func (s *Store) create(object *unstructured.Unstructured) error {
+	key := objectKey(object)
+	if _, ok := s.objects[key]; ok {
+		gvk := key.GroupVersionKind()
+		return kerrors.NewAlreadyExists(schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind}, key.Name)
+	}
+
+	s.objects[key] = object.DeepCopy()
+	s.calls = append(s.calls, Call{Operation: OperationCreate, Key: key, Object: object.DeepCopy()})
+
+	return nil
+}
+
+// objectKey creates the map key for an object. Selectors are never part of a map key.
+func objectKey(object *unstructured.Unstructured) store.Key {
+	return store.Key{
+		Namespace:  object.GetNamespace(),
+		APIVersion: object.GetAPIVersion(),
+		Kind:       object.GetKind(),
+		Name:       object.GetName(),
+	}
+}
+
+func normalizeKey(key store.Key) store.Key {
+	return store.Key{
+		Namespace:  key.Namespace,
+		APIVersion: key.APIVersion,
+		Kind:       key.Kind,
+		Name:       key.Name,
+	}
+}
+
+func notFound(key store.Key) error {
+	gvk := key.GroupVersionKind()
+	return kerrors.NewNotFound(schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind}, key.Name)
+}
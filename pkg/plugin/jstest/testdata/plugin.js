@@ -0,0 +1,86 @@
+// A minimal plugin used by the jstest tests. It is written in ES5 so it can be
+// loaded without bundling.
+function text(value) {
+  return { metadata: { type: "text" }, config: { value: value } };
+}
+
+function JSTestPlugin() {
+  this.name = "jstest";
+  this.description = "plugin used to test the jstest harness";
+  this.isModule = true;
+  this.capabilities = {
+    supportPrinterConfig: [{ group: "", version: "v1", kind: "Pod" }],
+    supportTab: [{ group: "", version: "v1", kind: "Pod" }],
+    actionNames: ["jstest/create", "jstest/delete"],
+  };
+}
+
+JSTestPlugin.prototype.contentHandler = function (request) {
+  var pods = dashboardClient.List({
+    namespace: request.clientState.namespace,
+    apiVersion: "v1",
+    kind: "Pod",
+  });
+
+  var names = pods.map(function (pod) {
+    return pod.metadata.name;
+  });
+
+  return {
+    content: {
+      title: [text("jstest " + request.contentPath)],
+      viewComponents: [text("pods: " + names.join(","))],
+    },
+  };
+};
+
+JSTestPlugin.prototype.printHandler = function (request) {
+  return {
+    config: [{ header: "Name", content: text(request.object.metadata.name) }],
+  };
+};
+
+JSTestPlugin.prototype.tabHandler = function (request) {
+  return {
+    tab: {
+      name: "Extra",
+      contents: {
+        metadata: { type: "flexlayout" },
+        config: {
+          sections: [[{ width: 24, view: text(request.object.metadata.name) }]],
+        },
+      },
+    },
+  };
+};
+
+JSTestPlugin.prototype.actionHandler = function (request) {
+  switch (request.actionName) {
+    case "jstest/create":
+      dashboardClient.Update(
+        request.clientState.namespace,
+        "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: " + request.payload.name
+      );
+      dashboardClient.SendEvent(request.clientState.clientID, "event.octant.dev/alert", {
+        type: "INFO",
+        message: "created " + request.payload.name,
+      });
+      return;
+    case "jstest/delete":
+      dashboardClient.Delete({
+        namespace: request.clientState.namespace,
+        apiVersion: "v1",
+        kind: "ConfigMap",
+        name: request.payload.name,
+      });
+      return;
+    default:
+      return { error: "unknown action " + request.actionName };
+  }
+};
+
+JSTestPlugin.prototype.navigationHandler = function () {
+  return { title: "JS Test", path: "jstest" };
+};
+
+module.exports.default = JSTestPlugin;
@@ -129,12 +129,10 @@ func AvailablePlugins(config Config) ([]string, error) {
 		}
 
 		for _, fi := range fis {
-			mode := fi.Mode()
-			// Windows does not have unix style executable bits.
 			if IsJavaScriptPlugin(fi.Name()) {
 				pluginPath := filepath.Join(dir, fi.Name())
 				list = append(list, pluginPath)
-			} else if mode|64 == mode || config.OS() == "windows" {
+			} else if isExecutable(config, fi) {
 				pluginPath := filepath.Join(dir, fi.Name())
 				list = append(list, pluginPath)
 			}
@@ -145,3 +143,14 @@ func AvailablePlugins(config Config) ([]string, error) {
 
 	return list, nil
 }
+
+// isExecutable returns true if a file can be started as a binary plugin.
+func isExecutable(config Config, fi os.FileInfo) bool {
+	if fi.IsDir() {
+		return false
+	}
+
+	// Windows does not have unix style executable bits.
+	mode := fi.Mode()
+	return mode|64 == mode || config.OS() == "windows"
+}
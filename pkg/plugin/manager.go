@@ -62,8 +62,10 @@ func (f *DefaultClientFactory) Init(ctx context.Context, cmd string) Client {
 
 	return plugin.NewClient(&plugin.ClientConfig{
 		HandshakeConfig: Handshake,
-		Plugins:         pluginMap,
-		Cmd:             exec.Command(cmd),
+		Plugins: map[string]plugin.Plugin{
+			Name: &ServicePlugin{name: filepath.Base(cmd)},
+		},
+		Cmd: exec.Command(cmd),
 		AllowedProtocols: []plugin.Protocol{
 			plugin.ProtocolGRPC,
 		},
@@ -80,6 +82,7 @@ type Client interface {
 // ManagerStore is the data store for Manager.
 type ManagerStore interface {
 	Store(name string, client Client, metadata *Metadata, cmd string) error
+	Remove(name string)
 	StoreJS(name string, jspc JSPlugin) error
 	GetJS(name string) (JSPlugin, bool)
 	RemoveJS(name string)
@@ -98,6 +101,8 @@ type DefaultStore struct {
 	commands map[string]string
 
 	jsPlugins sync.Map
+
+	mu sync.RWMutex
 }
 
 var _ ManagerStore = (*DefaultStore)(nil)
@@ -148,6 +153,9 @@ func (s *DefaultStore) Store(name string, client Client, metadata *Metadata, cmd
 		return errors.New("metadata is nil")
 	}
 
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	s.clients[name] = client
 	s.metadata[name] = *metadata
 	s.commands[name] = cmd
@@ -155,9 +163,21 @@ func (s *DefaultStore) Store(name string, client Client, metadata *Metadata, cmd
 	return nil
 }
 
+// Remove removes information for a plugin.
+func (s *DefaultStore) Remove(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.clients, name)
+	delete(s.metadata, name)
+	delete(s.commands, name)
+}
+
 // GetService gets the service for a plugin.
 func (s *DefaultStore) GetService(name string) (Service, error) {
+	s.mu.RLock()
 	client, ok := s.clients[name]
+	s.mu.RUnlock()
 	if !ok {
 		return nil, errors.Errorf("plugin %q doesn't have a client", name)
 	}
@@ -182,6 +202,9 @@ func (s *DefaultStore) GetService(name string) (Service, error) {
 
 // GetMetadata gets the metadata for a plugin.
 func (s *DefaultStore) GetMetadata(name string) (*Metadata, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	metadata, ok := s.metadata[name]
 	if !ok {
 		return nil, errors.Errorf("plugin %q doesn't have metadata", name)
@@ -192,6 +215,9 @@ func (s *DefaultStore) GetMetadata(name string) (*Metadata, error) {
 
 // GetCommand gets the command for a plugin.
 func (s *DefaultStore) GetCommand(name string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	cmd, ok := s.commands[name]
 	if !ok {
 		return "", errors.Errorf("plugin %q doesn't have command", name)
@@ -200,9 +226,16 @@ func (s *DefaultStore) GetCommand(name string) (string, error) {
 	return cmd, nil
 }
 
-// Clients returns all the clients in the store.
+// Clients returns a copy of the clients in the store.
 func (s *DefaultStore) Clients() map[string]Client {
-	return s.clients
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	clients := make(map[string]Client, len(s.clients))
+	for name, client := range s.clients {
+		clients[name] = client
+	}
+	return clients
 }
 
 // ClientNames returns the client names in the store.
@@ -241,6 +274,9 @@ type ManagerInterface interface {
 	// ObjectStatus returns the object status
 	ObjectStatus(ctx context.Context, object runtime.Object) (*ObjectStatusResponse, error)
 
+	// RelatedObjects returns the objects plugins relate to an object.
+	RelatedObjects(ctx context.Context, object runtime.Object) (*RelatedObjectsResponse, error)
+
 	// SetOctantClient sets the the Octant client.
 	SetOctantClient(octantClient javascript.OctantClient)
 }
@@ -279,6 +315,11 @@ type Manager struct {
 	configs      []config
 	store        ManagerStore
 
+	// stopWatcher stops the plugin file watcher and watcherDone is closed
+	// once it has exited.
+	stopWatcher context.CancelFunc
+	watcherDone chan struct{}
+
 	lock sync.Mutex
 }
 
@@ -343,18 +384,18 @@ func (m *Manager) Load(cmd string) error {
 	return nil
 }
 
-func (m *Manager) watchJS(ctx context.Context) {
+func (m *Manager) watchPluginFiles(ctx context.Context) {
 	logger := log.From(ctx)
 
 	dirs, err := DefaultConfig.PluginDirs(DefaultConfig.Home())
 	if err != nil {
-		logger.Errorf("unable to get plugin dirs for JavaScript plugins: %w", err)
+		logger.Errorf("unable to get plugin dirs for plugin watcher: %w", err)
 		return
 	}
 
 	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
-		logger.Errorf("initializing JavaScript plugin watcher: %w", err)
+		logger.Errorf("initializing plugin watcher: %w", err)
 		return
 	}
 	defer func() {
@@ -365,13 +406,14 @@ func (m *Manager) watchJS(ctx context.Context) {
 
 	for _, dir := range dirs {
 		if err := watcher.Add(dir); err != nil {
-			logger.Warnf("unable to add %s to JavaScript plugin watcher", dir)
+			logger.Warnf("unable to add %s to plugin watcher", dir)
 		}
 	}
 
-	logger.Infof("watching for new JavaScript plugins in %q", dirs)
+	logger.Infof("watching for new plugins in %q", dirs)
 
 	writeEvents := make(map[string]bool)
+	binaryEvents := make(map[string]bool)
 	updatePlugin := func(name string) {
 		jsPlugin, ok := m.store.GetJS(name)
 		if ok {
@@ -389,11 +431,11 @@ func (m *Manager) watchJS(ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
-			logger.Infof("context cancelled shutting down JavaScript plugin watcher.")
+			logger.Infof("context cancelled shutting down plugin watcher.")
 			return
 		case event, ok := <-watcher.Events:
 			if !ok {
-				logger.Errorf("bad event returned from JavaScript plugin watcher")
+				logger.Errorf("bad event returned from plugin watcher")
 				return
 			}
 			if event.Op&(fsnotify.Chmod|fsnotify.Write|fsnotify.Create) == fsnotify.Chmod {
@@ -412,6 +454,14 @@ func (m *Manager) watchJS(ctx context.Context) {
 				} else if event.Op&fsnotify.Write == fsnotify.Write {
 					writeEvents[event.Name] = true
 				}
+				continue
+			}
+
+			if event.Op&(fsnotify.Remove|fsnotify.Rename) != 0 {
+				delete(binaryEvents, event.Name)
+				m.removeBinaryPlugin(ctx, event.Name)
+			} else if event.Op&(fsnotify.Write|fsnotify.Create) != 0 {
+				binaryEvents[event.Name] = true
 			}
 		case err, ok := <-watcher.Errors:
 			if !ok {
@@ -423,12 +473,111 @@ func (m *Manager) watchJS(ctx context.Context) {
 				updatePlugin(k)
 			}
 			writeEvents = make(map[string]bool)
+			for k := range binaryEvents {
+				m.reloadBinaryPlugin(ctx, k)
+			}
+			binaryEvents = make(map[string]bool)
 			continue
 		}
 
 	}
 }
 
+// reloadBinaryPlugin starts a binary plugin which was created or replaced on disk. If a
+// previous version of the plugin is running, it is stopped and everything it registered
+// is removed before the new version is started.
+func (m *Manager) reloadBinaryPlugin(ctx context.Context, pluginPath string) {
+	logger := log.From(ctx).With("plugin-name", filepath.Base(pluginPath))
+
+	fi, err := DefaultConfig.Fs().Stat(pluginPath)
+	if err != nil {
+		logger.WithErr(err).Errorf("unable to stat plugin")
+		return
+	}
+	if !isExecutable(DefaultConfig, fi) {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	c := config{
+		name: filepath.Base(pluginPath),
+		cmd:  pluginPath,
+	}
+
+	if m.hasConfig(c.name) {
+		logger.Infof("reloading plugin")
+		m.unregister(ctx, c.name)
+	} else {
+		logger.Infof("loading new plugin")
+		m.configs = append(m.configs, c)
+	}
+
+	if err := m.start(ctx, c); err != nil {
+		logger.WithErr(err).Errorf("unable to start plugin")
+	}
+}
+
+// removeBinaryPlugin stops a binary plugin which was removed from disk.
+func (m *Manager) removeBinaryPlugin(ctx context.Context, pluginPath string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	name := filepath.Base(pluginPath)
+	if !m.hasConfig(name) {
+		return
+	}
+
+	log.From(ctx).With("plugin-name", name).Infof("removing plugin")
+	m.unregister(ctx, name)
+
+	for i := range m.configs {
+		if m.configs[i].name == name {
+			m.configs = append(m.configs[:i], m.configs[i+1:]...)
+			break
+		}
+	}
+}
+
+func (m *Manager) hasConfig(name string) bool {
+	for _, c := range m.configs {
+		if c.name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// unregister stops a binary plugin client and unregisters the module and actions
+// it registered.
+func (m *Manager) unregister(ctx context.Context, name string) {
+	logger := log.From(ctx).With("plugin-name", name)
+
+	metadata, err := m.store.GetMetadata(name)
+	if err == nil {
+		if metadata.Capabilities.IsModule {
+			mp, err := NewModuleProxy(name, metadata, nil)
+			if err != nil {
+				logger.WithErr(err).Errorf("unregister: creating module proxy")
+			} else {
+				m.ModuleRegistrar.Unregister(mp)
+			}
+		}
+
+		for _, actionName := range metadata.Capabilities.ActionNames {
+			m.ActionRegistrar.Unregister(actionName, name)
+		}
+	}
+
+	if client, ok := m.store.Clients()[name]; ok {
+		client.Kill()
+	}
+
+	m.store.Remove(name)
+}
+
 func (m *Manager) unregisterJSPlugin(_ context.Context, p JSPlugin) error {
 	p.Close()
 
@@ -539,7 +688,13 @@ func (m *Manager) Start(ctx context.Context) error {
 		return err
 	}
 
-	go m.watchJS(ctx)
+	watchCtx, stopWatcher := context.WithCancel(ctx)
+	m.stopWatcher = stopWatcher
+	m.watcherDone = make(chan struct{})
+	go func(done chan struct{}) {
+		defer close(done)
+		m.watchPluginFiles(watchCtx)
+	}(m.watcherDone)
 
 	for i := range m.configs {
 		c := m.configs[i]
@@ -571,26 +726,11 @@ func (m *Manager) watchPlugins(ctx context.Context) {
 				rpcClient, err := client.Client()
 				if err != nil {
 					logger.WithErr(err).Errorf("retrieve plugin client for ping")
+					continue
 				}
 
 				if err := rpcClient.Ping(); err != nil {
-					logger.With("plugin-name", clientName).Infof("restarting plugin")
-
-					cmd, err := m.store.GetCommand(clientName)
-					if err != nil {
-						logger.WithErr(err).Errorf("unable to find command for plugin")
-						continue
-					}
-
-					c := config{
-						name: clientName,
-						cmd:  cmd,
-					}
-
-					if err := m.start(ctx, c); err != nil {
-						logger.WithErr(err).Errorf("unable to restart plugin")
-						continue
-					}
+					m.restart(ctx, clientName, client)
 				}
 			}
 
@@ -600,9 +740,54 @@ func (m *Manager) watchPlugins(ctx context.Context) {
 
 }
 
-func (m *Manager) start(ctx context.Context, c config) error {
+// restart restarts a plugin which no longer responds to pings. The plugin is skipped if
+// it was reloaded since the ping was sent.
+func (m *Manager) restart(ctx context.Context, name string, client Client) {
+	logger := log.From(ctx).With("plugin-name", name)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if current, ok := m.store.Clients()[name]; !ok || current != client {
+		return
+	}
+
+	logger.Infof("restarting plugin")
+
+	cmd, err := m.store.GetCommand(name)
+	if err != nil {
+		logger.WithErr(err).Errorf("unable to find command for plugin")
+		return
+	}
+
+	c := config{
+		name: name,
+		cmd:  cmd,
+	}
+
+	m.unregister(ctx, name)
+
+	if err := m.start(ctx, c); err != nil {
+		logger.WithErr(err).Errorf("unable to restart plugin")
+	}
+}
+
+func (m *Manager) start(ctx context.Context, c config) (err error) {
 	client := m.ClientFactory.Init(ctx, c.cmd)
 
+	// A plugin which fails to start is stopped so its process doesn't leak.
+	stored := false
+	defer func() {
+		if err == nil {
+			return
+		}
+		if stored {
+			m.unregister(ctx, c.name)
+		} else {
+			client.Kill()
+		}
+	}()
+
 	rpcClient, err := client.Client()
 	if err != nil {
 		return errors.Wrapf(err, "get rpc client for %q", c.name)
@@ -628,6 +813,7 @@ func (m *Manager) start(ctx context.Context, c config) error {
 	if err := m.store.Store(c.name, client, &metadata, c.cmd); err != nil {
 		return errors.Wrapf(err, "storing plugin")
 	}
+	stored = true
 
 	for _, actionName := range metadata.Capabilities.ActionNames {
 		actionPath := actionName
@@ -671,6 +857,18 @@ func (m *Manager) start(ctx context.Context, c config) error {
 func (m *Manager) Stop(ctx context.Context) {
 	logger := log.From(ctx)
 
+	// The watcher reloads plugins while holding the lock, so wait for it to
+	// exit before taking the lock.
+	m.lock.Lock()
+	stopWatcher, watcherDone := m.stopWatcher, m.watcherDone
+	m.stopWatcher, m.watcherDone = nil, nil
+	m.lock.Unlock()
+
+	if stopWatcher != nil {
+		stopWatcher()
+		<-watcherDone
+	}
+
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
@@ -784,3 +982,34 @@ func (m *Manager) ObjectStatus(ctx context.Context, object runtime.Object) (*Obj
 	<-done
 	return &osr, nil
 }
+
+// RelatedObjects collects the objects related to an object from plugins which
+// are configured to support the object's GVK.
+func (m *Manager) RelatedObjects(ctx context.Context, object runtime.Object) (*RelatedObjectsResponse, error) {
+	if m.Runners == nil {
+		return nil, errors.New("runners is nil")
+	}
+
+	runner, ch := m.Runners.RelatedObjects(m.store)
+	done := make(chan bool)
+
+	var ror RelatedObjectsResponse
+
+	go func() {
+		for resp := range ch {
+			ror.Owners = append(ror.Owners, resp.Owners...)
+			ror.Related = append(ror.Related, resp.Related...)
+		}
+
+		done <- true
+	}()
+
+	err := runner.Run(ctx, object, m.store.ClientNames())
+	close(ch)
+	<-done
+
+	if err != nil {
+		return nil, err
+	}
+	return &ror, nil
+}
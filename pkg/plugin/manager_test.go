@@ -7,12 +7,19 @@ package plugin_test
 
 import (
 	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	fake2 "github.com/vmware-tanzu/octant/pkg/event/fake"
 
 	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/go-plugin"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -49,6 +56,12 @@ func TestDefaultStore(t *testing.T) {
 
 	_, err = s.GetService("invalid")
 	require.Error(t, err)
+
+	s.Remove(name)
+
+	_, err = s.GetMetadata(name)
+	require.Error(t, err)
+	require.Empty(t, s.Clients())
 }
 
 func TestManager(t *testing.T) {
@@ -86,13 +99,143 @@ func TestManager(t *testing.T) {
 	err := manager.Load(name)
 	require.NoError(t, err)
 
-	ctx := context.Background()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	err = manager.Start(ctx)
 	require.NoError(t, err)
 
 	manager.Stop(ctx)
 }
 
+func TestManager_reloadsBinaryPlugin(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dir, err := ioutil.TempDir("", "octant-plugins")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	viper.Set("home", dir)
+	viper.Set("plugin-path", dir)
+	defer viper.Reset()
+
+	name := "plugin1"
+	pluginPath := filepath.Join(dir, name)
+
+	clientFactory := fake.NewMockClientFactory(controller)
+	moduleRegistrar := fake.NewMockModuleRegistrar(controller)
+	actionRegistrar := fake.NewMockActionRegistrar(controller)
+	wsClient := fake2.NewMockWSClientGetter(controller)
+
+	started := make(chan *fakePluginClient, 2)
+	clientFactory.EXPECT().Init(gomock.Any(), gomock.Eq(pluginPath)).
+		DoAndReturn(func(context.Context, string) dashPlugin.Client {
+			client := newFakePluginClient(name, controller)
+			client.clientProtocol.EXPECT().Ping().Return(nil).AnyTimes()
+			started <- client
+			return client
+		}).Times(2)
+
+	options := []dashPlugin.ManagerOption{
+		func(m *dashPlugin.Manager) {
+			m.ClientFactory = clientFactory
+		},
+	}
+
+	apiService := &stubAPIService{}
+	manager := dashPlugin.NewManager(apiService, moduleRegistrar, actionRegistrar, wsClient, options...)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, manager.Start(ctx))
+
+	waitForStart := func() *fakePluginClient {
+		select {
+		case client := <-started:
+			return client
+		case <-time.After(10 * time.Second):
+			require.FailNow(t, "timed out waiting for plugin to start")
+			return nil
+		}
+	}
+
+	// wait for the watcher to begin watching the directory
+	time.Sleep(100 * time.Millisecond)
+
+	require.NoError(t, ioutil.WriteFile(pluginPath, []byte("v1"), 0755))
+	first := waitForStart()
+
+	require.NoError(t, ioutil.WriteFile(pluginPath, []byte("v2"), 0755))
+	second := waitForStart()
+
+	require.Eventually(t, func() bool {
+		clients := manager.Store().Clients()
+		return len(clients) == 1 && clients[name] == second && first.killed()
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestManager_killsPluginWhichFailsToStart(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dir, err := ioutil.TempDir("", "octant-plugins")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	viper.Set("home", dir)
+	viper.Set("plugin-path", dir)
+	defer viper.Reset()
+
+	name := "plugin1"
+	pluginPath := filepath.Join(dir, name)
+
+	clientFactory := fake.NewMockClientFactory(controller)
+	moduleRegistrar := fake.NewMockModuleRegistrar(controller)
+	actionRegistrar := fake.NewMockActionRegistrar(controller)
+	wsClient := fake2.NewMockWSClientGetter(controller)
+
+	started := make(chan *fakePluginClient, 1)
+	clientFactory.EXPECT().Init(gomock.Any(), gomock.Eq(pluginPath)).
+		DoAndReturn(func(context.Context, string) dashPlugin.Client {
+			clientProtocol := fake.NewMockClientProtocol(controller)
+			clientProtocol.EXPECT().Dispense("plugin").Return(nil, errors.New("broken plugin"))
+			client := &fakePluginClient{clientProtocol: clientProtocol, name: name}
+			started <- client
+			return client
+		})
+
+	options := []dashPlugin.ManagerOption{
+		func(m *dashPlugin.Manager) {
+			m.ClientFactory = clientFactory
+		},
+	}
+
+	manager := dashPlugin.NewManager(&stubAPIService{}, moduleRegistrar, actionRegistrar, wsClient, options...)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, manager.Start(ctx))
+	defer manager.Stop(ctx)
+
+	// wait for the watcher to begin watching the directory
+	time.Sleep(100 * time.Millisecond)
+
+	require.NoError(t, ioutil.WriteFile(pluginPath, []byte("v1"), 0755))
+
+	var client *fakePluginClient
+	select {
+	case client = <-started:
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "timed out waiting for plugin to start")
+	}
+
+	require.Eventually(t, client.killed, 5*time.Second, 10*time.Millisecond)
+	assert.Empty(t, manager.Store().Clients())
+}
+
 func TestManager_Print(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -207,6 +350,9 @@ type fakePluginClient struct {
 	clientProtocol *fake.MockClientProtocol
 	service        *fake.MockService
 	name           string
+
+	mu       sync.Mutex
+	isKilled bool
 }
 
 var _ dashPlugin.Client = (*fakePluginClient)(nil)
@@ -232,7 +378,19 @@ func (c *fakePluginClient) Client() (plugin.ClientProtocol, error) {
 	return c.clientProtocol, nil
 }
 
-func (c *fakePluginClient) Kill() {}
+func (c *fakePluginClient) Kill() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.isKilled = true
+}
+
+func (c *fakePluginClient) killed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.isKilled
+}
 
 type stubAPIService struct{}
 
@@ -14,12 +14,6 @@ import (
 	"github.com/vmware-tanzu/octant/pkg/plugin/dashboard"
 )
 
-var (
-	pluginMap = map[string]plugin.Plugin{
-		Name: &ServicePlugin{},
-	}
-)
-
 // DashboardMetadataKey is a type used for metadata keys passed by plugins
 type DashboardMetadataKey string
 
@@ -28,6 +22,9 @@ type ServicePlugin struct {
 	plugin.NetRPCUnsupportedPlugin
 
 	Impl Service
+
+	// name is the name of the plugin clients are created for.
+	name string
 }
 
 var _ plugin.GRPCPlugin = (*ServicePlugin)(nil)
@@ -47,5 +44,6 @@ func (p *ServicePlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroke
 	return &GRPCClient{
 		client: dashboard.NewPluginClient(c),
 		broker: broker,
+		name:   p.name,
 	}, nil
 }
@@ -28,6 +28,9 @@ type Runners interface {
 	// ObjectStatus returns a runner for object status. The caller should
 	// close the channel when they are done with it.
 	ObjectStatus(ManagerStore) (DefaultRunner, chan ObjectStatusResponse)
+	// RelatedObjects returns a runner for related objects. The caller should
+	// close the channel when they are done with it.
+	RelatedObjects(ManagerStore) (DefaultRunner, chan RelatedObjectsResponse)
 }
 
 type defaultRunners struct{}
@@ -53,6 +56,11 @@ func (dr *defaultRunners) ObjectStatus(store ManagerStore) (DefaultRunner, chan
 	return ObjectStatusRunner(store, ch), ch
 }
 
+func (dr *defaultRunners) RelatedObjects(store ManagerStore) (DefaultRunner, chan RelatedObjectsResponse) {
+	ch := make(chan RelatedObjectsResponse)
+	return RelatedObjectsRunner(store, ch), ch
+}
+
 // DefaultRunner runs a function against all plugins
 type DefaultRunner struct {
 	RunFunc func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error
@@ -271,3 +279,51 @@ func ObjectStatusRunner(store ManagerStore, ch chan<- ObjectStatusResponse) Defa
 		},
 	}
 }
+
+// RelatedObjectsRunner is a runner for related objects.
+func RelatedObjectsRunner(store ManagerStore, ch chan<- RelatedObjectsResponse) DefaultRunner {
+	return DefaultRunner{
+		RunFunc: func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error {
+			if IsJavaScriptPlugin(name) {
+				jsPlugin, ok := store.GetJS(name)
+				if !ok {
+					return fmt.Errorf("plugin %s not found", name)
+				}
+
+				if !jsPlugin.Metadata().Capabilities.HasRelatedObjectsSupport(gvk) {
+					return nil
+				}
+
+				resp, err := jsPlugin.RelatedObjects(ctx, object)
+				if err != nil {
+					return fmt.Errorf("finding related objects for plugin: %q: %w", name, err)
+				}
+
+				ch <- resp
+				return nil
+			}
+
+			metadata, err := store.GetMetadata(name)
+			if err != nil {
+				return err
+			}
+
+			if !metadata.Capabilities.HasRelatedObjectsSupport(gvk) {
+				return nil
+			}
+
+			service, err := store.GetService(name)
+			if err != nil {
+				return err
+			}
+
+			resp, err := service.RelatedObjects(ctx, object)
+			if err != nil {
+				return fmt.Errorf("find related objects with plugin %q: %w", name, err)
+			}
+
+			ch <- resp
+			return nil
+		},
+	}
+}
@@ -26,6 +26,8 @@ type Dashboard interface {
 	ForceFrontendUpdate(ctx context.Context) error
 	SendAlert(ctx context.Context, clientID string, alert action.Alert) error
 	CreateLink(ctx context.Context, key store.Key) (api.LinkResponse, error)
+	GetPreference(ctx context.Context, key string) (string, error)
+	SetPreference(ctx context.Context, key, value string) error
 }
 
 // NewDashboardClient creates a dashboard client.
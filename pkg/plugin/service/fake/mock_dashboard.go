@@ -137,6 +137,21 @@ func (mr *MockDashboardMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDashboard)(nil).Get), arg0, arg1)
 }
 
+// GetPreference mocks base method
+func (m *MockDashboard) GetPreference(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreference", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreference indicates an expected call of GetPreference
+func (mr *MockDashboardMockRecorder) GetPreference(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreference", reflect.TypeOf((*MockDashboard)(nil).GetPreference), arg0, arg1)
+}
+
 // List mocks base method
 func (m *MockDashboard) List(arg0 context.Context, arg1 store.Key) (*unstructured.UnstructuredList, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +211,20 @@ func (mr *MockDashboardMockRecorder) SendAlert(arg0, arg1, arg2 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAlert", reflect.TypeOf((*MockDashboard)(nil).SendAlert), arg0, arg1, arg2)
 }
 
+// SetPreference mocks base method
+func (m *MockDashboard) SetPreference(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPreference", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPreference indicates an expected call of SetPreference
+func (mr *MockDashboardMockRecorder) SetPreference(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPreference", reflect.TypeOf((*MockDashboard)(nil).SetPreference), arg0, arg1, arg2)
+}
+
 // Update mocks base method
 func (m *MockDashboard) Update(arg0 context.Context, arg1 *unstructured.Unstructured) error {
 	m.ctrl.T.Helper()
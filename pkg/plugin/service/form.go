@@ -0,0 +1,590 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package service
+
+import (
+	"fmt"
+	"net/mail"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// FormTag is the struct tag used to describe form fields.
+//
+// The first element of the tag is the field name in the action payload. If it is
+// empty, the Go field name is used. A field tagged with "-" is skipped. The
+// remaining comma separated elements configure the field:
+//
+//	label=Display Name   label shown for the field (defaults to the field name)
+//	type=password        password, textarea, select, radio, or hidden
+//	placeholder=text     placeholder shown for empty text fields
+//	default=value        value used when the struct field is empty or the payload omits the field
+//	                     (checkboxes the payload omits are unchecked)
+//	choices=a|b|c        allowed values for select, radio, and checkbox fields
+//	error=message        message the frontend shows when validation fails
+//	required             value must not be empty (booleans must be true)
+//	email                value must be an email address
+//	min=1, max=10        bounds for numeric fields
+//	minLength=1          minimum length for string fields
+//	maxLength=10         maximum length for string fields
+//	pattern=^[a-z]+$     regular expression string fields must match
+//
+// Element values can't contain commas.
+const FormTag = "form"
+
+// FieldError is a validation error for a single form field.
+type FieldError struct {
+	// Name is the name of the field in the action payload.
+	Name string
+	// Label is the label of the field.
+	Label string
+	// Message describes why the field is invalid.
+	Message string
+}
+
+// FormError is returned when a submitted form fails validation.
+type FormError struct {
+	Fields []FieldError
+}
+
+var _ error = (*FormError)(nil)
+
+// Error returns the validation errors as a single string.
+func (e *FormError) Error() string {
+	var messages []string
+	for _, field := range e.Fields {
+		messages = append(messages, fmt.Sprintf("%s %s", field.Label, field.Message))
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Alert converts the validation errors to an error alert.
+func (e *FormError) Alert() action.Alert {
+	return action.CreateAlert(action.AlertTypeError, e.Error(), action.DefaultAlertExpiration)
+}
+
+// CreateForm creates a form from a struct. The values in v become the values of
+// the form fields. A hidden field named action is added so the form's submission is
+// routed to actionName.
+func CreateForm(actionName string, v interface{}) (component.Form, error) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return component.Form{}, errors.Errorf("form source must be a struct; got %T", v)
+	}
+
+	specs, err := formFieldSpecs(rv.Type())
+	if err != nil {
+		return component.Form{}, err
+	}
+
+	var fields []component.FormField
+	for _, spec := range specs {
+		field, err := spec.formField(rv.FieldByIndex(spec.index))
+		if err != nil {
+			return component.Form{}, err
+		}
+		fields = append(fields, field)
+	}
+
+	fields = append(fields, component.NewFormFieldHidden("action", actionName))
+
+	return component.Form{Fields: fields}, nil
+}
+
+// DecodeForm decodes a submitted form payload into the struct v points to. If the
+// payload fails validation, a *FormError describing each invalid field is returned.
+func DecodeForm(payload action.Payload, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return errors.Errorf("form destination must be a pointer to a struct; got %T", v)
+	}
+	rv = rv.Elem()
+
+	specs, err := formFieldSpecs(rv.Type())
+	if err != nil {
+		return err
+	}
+
+	formErr := &FormError{}
+	for _, spec := range specs {
+		if message := spec.decode(payload, rv.FieldByIndex(spec.index)); message != "" {
+			formErr.Fields = append(formErr.Fields, FieldError{
+				Name:    spec.name,
+				Label:   spec.label,
+				Message: message,
+			})
+		}
+	}
+
+	if len(formErr.Fields) > 0 {
+		return formErr
+	}
+
+	return nil
+}
+
+// DecodeForm decodes the request's payload into the struct v points to. If the
+// payload fails validation, the errors are sent to the requesting client as an alert
+// and a *FormError is returned.
+func (r *ActionRequest) DecodeForm(v interface{}) error {
+	err := DecodeForm(r.Payload, v)
+
+	var formErr *FormError
+	if errors.As(err, &formErr) && r.DashboardClient != nil {
+		if alertErr := r.DashboardClient.SendAlert(r.Context(), r.ClientState.ClientID, formErr.Alert()); alertErr != nil {
+			return errors.Wrap(alertErr, "send form validation alert")
+		}
+	}
+
+	return err
+}
+
+type formFieldSpec struct {
+	index []int
+	kind  reflect.Kind
+
+	name         string
+	label        string
+	fieldType    string
+	placeholder  string
+	defaultValue string
+	errorMessage string
+	choices      []string
+
+	required  bool
+	email     bool
+	min       *float64
+	max       *float64
+	minLength *int
+	maxLength *int
+	pattern   *regexp.Regexp
+}
+
+func formFieldSpecs(t reflect.Type) ([]formFieldSpec, error) {
+	var specs []formFieldSpec
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			// unexported
+			continue
+		}
+
+		tag, ok := field.Tag.Lookup(FormTag)
+		if !ok || tag == "-" {
+			continue
+		}
+
+		spec, err := parseFormTag(field, tag)
+		if err != nil {
+			return nil, errors.Wrapf(err, "form field %s", field.Name)
+		}
+		specs = append(specs, spec)
+	}
+
+	return specs, nil
+}
+
+func parseFormTag(field reflect.StructField, tag string) (formFieldSpec, error) {
+	parts := strings.Split(tag, ",")
+
+	spec := formFieldSpec{
+		index: field.Index,
+		kind:  field.Type.Kind(),
+		name:  parts[0],
+	}
+	if spec.name == "" {
+		spec.name = field.Name
+	}
+
+	switch spec.kind {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+	case reflect.Slice:
+		if field.Type.Elem().Kind() != reflect.String {
+			return spec, errors.Errorf("unsupported type %s", field.Type)
+		}
+	default:
+		return spec, errors.Errorf("unsupported type %s", field.Type)
+	}
+
+	for _, part := range parts[1:] {
+		key, value := part, ""
+		if i := strings.Index(part, "="); i >= 0 {
+			key, value = part[:i], part[i+1:]
+		}
+
+		switch key {
+		case "label":
+			spec.label = value
+		case "type":
+			spec.fieldType = value
+		case "placeholder":
+			spec.placeholder = value
+		case "default":
+			spec.defaultValue = value
+		case "error":
+			spec.errorMessage = value
+		case "choices":
+			spec.choices = strings.Split(value, "|")
+		case "required":
+			spec.required = true
+		case "email":
+			spec.email = true
+		case "min", "max":
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return spec, errors.Wrapf(err, "parse %s", key)
+			}
+			if key == "min" {
+				spec.min = &f
+			} else {
+				spec.max = &f
+			}
+		case "minLength", "maxLength":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return spec, errors.Wrapf(err, "parse %s", key)
+			}
+			if key == "minLength" {
+				spec.minLength = &n
+			} else {
+				spec.maxLength = &n
+			}
+		case "pattern":
+			re, err := regexp.Compile(value)
+			if err != nil {
+				return spec, errors.Wrap(err, "parse pattern")
+			}
+			spec.pattern = re
+		default:
+			return spec, errors.Errorf("unknown form tag option %q", key)
+		}
+	}
+
+	if spec.label == "" {
+		spec.label = field.Name
+	}
+
+	if spec.fieldType == "" {
+		spec.fieldType = defaultFieldType(spec)
+	}
+
+	return spec, nil
+}
+
+func defaultFieldType(spec formFieldSpec) string {
+	switch spec.kind {
+	case reflect.Bool, reflect.Slice:
+		return component.FieldTypeCheckBox
+	case reflect.String:
+		if len(spec.choices) > 0 {
+			return component.FieldTypeSelect
+		}
+		return component.FieldTypeText
+	default:
+		return component.FieldTypeNumber
+	}
+}
+
+// validators converts the spec's validation rules to frontend validators.
+func (spec formFieldSpec) validators() map[component.FormValidator]interface{} {
+	validators := map[component.FormValidator]interface{}{}
+
+	if spec.required {
+		if spec.kind == reflect.Bool {
+			validators[component.FormValidatorRequiredTrue] = ""
+		} else {
+			validators[component.FormValidatorRequired] = ""
+		}
+	}
+	if spec.email {
+		validators[component.FormValidatorEmail] = ""
+	}
+	if spec.min != nil {
+		validators[component.FormValidatorMin] = *spec.min
+	}
+	if spec.max != nil {
+		validators[component.FormValidatorMax] = *spec.max
+	}
+	if spec.minLength != nil {
+		validators[component.FormValidatorMinLength] = *spec.minLength
+	}
+	if spec.maxLength != nil {
+		validators[component.FormValidatorMaxLength] = *spec.maxLength
+	}
+	if spec.pattern != nil {
+		validators[component.FormValidatorPattern] = spec.pattern.String()
+	}
+
+	return validators
+}
+
+// formField creates a form field with the value of v.
+func (spec formFieldSpec) formField(v reflect.Value) (component.FormField, error) {
+	values := formValues(v)
+	if len(values) == 0 && spec.defaultValue != "" {
+		values = strings.Split(spec.defaultValue, "|")
+	}
+
+	value := ""
+	if len(values) > 0 {
+		value = values[0]
+	}
+
+	choices := spec.inputChoices(values)
+	validators := spec.validators()
+
+	switch spec.fieldType {
+	case component.FieldTypeText:
+		field := component.NewFormFieldText(spec.label, spec.name, value)
+		field.AddValidator(spec.placeholder, spec.errorMessage, validators)
+		return field, nil
+	case component.FieldTypePassword:
+		field := component.NewFormFieldPassword(spec.label, spec.name, value)
+		field.AddValidator(spec.placeholder, spec.errorMessage, validators)
+		return field, nil
+	case component.FieldTypeTextarea:
+		field := component.NewFormFieldTextarea(spec.label, spec.name, value)
+		field.AddValidator(spec.placeholder, spec.errorMessage, validators)
+		return field, nil
+	case component.FieldTypeHidden:
+		return component.NewFormFieldHidden(spec.name, value), nil
+	case component.FieldTypeNumber:
+		field := component.NewFormFieldNumber(spec.label, spec.name, value)
+		field.AddValidator(spec.errorMessage, validators)
+		return field, nil
+	case component.FieldTypeSelect:
+		field := component.NewFormFieldSelect(spec.label, spec.name, choices, spec.kind == reflect.Slice)
+		field.AddValidator(spec.errorMessage, validators)
+		return field, nil
+	case component.FieldTypeRadio:
+		field := component.NewFormFieldRadio(spec.label, spec.name, choices)
+		field.AddValidator(spec.errorMessage, validators)
+		return field, nil
+	case component.FieldTypeCheckBox:
+		field := component.NewFormFieldCheckBox(spec.label, spec.name, choices)
+		field.AddValidator(spec.errorMessage, validators)
+		return field, nil
+	default:
+		return nil, errors.Errorf("form field %s has unknown type %q", spec.name, spec.fieldType)
+	}
+}
+
+// inputChoices creates choices for the spec, checking choices found in values. A
+// boolean field without choices is a single checkbox.
+func (spec formFieldSpec) inputChoices(values []string) []component.InputChoice {
+	choices := spec.choices
+	if spec.kind == reflect.Bool && len(choices) == 0 {
+		choices = []string{"true"}
+	}
+
+	var inputChoices []component.InputChoice
+	for _, choice := range choices {
+		label := choice
+		if spec.kind == reflect.Bool {
+			label = spec.label
+		}
+
+		inputChoices = append(inputChoices, component.InputChoice{
+			Label:   label,
+			Value:   choice,
+			Checked: containsString(values, choice),
+		})
+	}
+
+	return inputChoices
+}
+
+// formValues converts a struct field to form values. Zero values are treated as unset.
+func formValues(v reflect.Value) []string {
+	if v.IsZero() {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		values := make([]string, v.Len())
+		for i := range values {
+			values[i] = v.Index(i).String()
+		}
+		return values
+	case reflect.Float32, reflect.Float64:
+		return []string{strconv.FormatFloat(v.Float(), 'f', -1, 64)}
+	default:
+		return []string{fmt.Sprint(v.Interface())}
+	}
+}
+
+// decode sets v from the payload and validates it. It returns a message describing
+// the problem if the value is invalid.
+func (spec formFieldSpec) decode(payload action.Payload, v reflect.Value) string {
+	raw, present := payload[spec.name]
+	values, ok := payloadValues(raw)
+	if !ok {
+		return "has an invalid value"
+	}
+	// Browsers leave unchecked checkboxes out of the payload, so only other
+	// fields fall back to their default.
+	if !present && spec.defaultValue != "" && spec.fieldType != component.FieldTypeCheckBox {
+		values = strings.Split(spec.defaultValue, "|")
+	}
+
+	if len(values) == 0 {
+		if spec.required {
+			return "is required"
+		}
+		v.Set(reflect.Zero(v.Type()))
+		return ""
+	}
+
+	if len(spec.choices) > 0 {
+		for _, value := range values {
+			if !containsString(spec.choices, value) {
+				return fmt.Sprintf("must be one of %s", strings.Join(spec.choices, ", "))
+			}
+		}
+	}
+
+	switch spec.kind {
+	case reflect.Slice:
+		// Elements may be a named string type, so set them one at a time.
+		slice := reflect.MakeSlice(v.Type(), len(values), len(values))
+		for i, value := range values {
+			slice.Index(i).SetString(value)
+		}
+		v.Set(slice)
+		return ""
+	case reflect.Bool:
+		b := true
+		if len(values) == 1 {
+			parsed, err := strconv.ParseBool(values[0])
+			if err != nil {
+				return "must be true or false"
+			}
+			b = parsed
+		}
+		if spec.required && !b {
+			return "is required"
+		}
+		v.SetBool(b)
+		return ""
+	case reflect.String:
+		return spec.decodeString(values[0], v)
+	default:
+		return spec.decodeNumber(values[0], v)
+	}
+}
+
+func (spec formFieldSpec) decodeString(s string, v reflect.Value) string {
+	if spec.minLength != nil && len(s) < *spec.minLength {
+		return fmt.Sprintf("must be at least %d characters", *spec.minLength)
+	}
+	if spec.maxLength != nil && len(s) > *spec.maxLength {
+		return fmt.Sprintf("must be at most %d characters", *spec.maxLength)
+	}
+	if spec.pattern != nil && !spec.pattern.MatchString(s) {
+		return fmt.Sprintf("must match %s", spec.pattern.String())
+	}
+	if spec.email {
+		if _, err := mail.ParseAddress(s); err != nil {
+			return "must be an email address"
+		}
+	}
+
+	v.SetString(s)
+	return ""
+}
+
+func (spec formFieldSpec) decodeNumber(s string, v reflect.Value) string {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return "must be a number"
+	}
+	if spec.min != nil && f < *spec.min {
+		return fmt.Sprintf("must be at least %v", *spec.min)
+	}
+	if spec.max != nil && f > *spec.max {
+		return fmt.Sprintf("must be at most %v", *spec.max)
+	}
+
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		if v.OverflowFloat(f) {
+			return "is out of range"
+		}
+		v.SetFloat(f)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil || v.OverflowUint(n) {
+			return "must be a whole number that is not negative"
+		}
+		v.SetUint(n)
+	default:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || v.OverflowInt(n) {
+			return "must be a whole number"
+		}
+		v.SetInt(n)
+	}
+
+	return ""
+}
+
+// payloadValues converts a payload value to strings. Checkbox and multiple select
+// fields are submitted as lists. An empty string is treated as no value.
+func payloadValues(i interface{}) ([]string, bool) {
+	switch v := i.(type) {
+	case nil:
+		return nil, true
+	case string:
+		if v == "" {
+			return nil, true
+		}
+		return []string{v}, true
+	case bool:
+		return []string{strconv.FormatBool(v)}, true
+	case float64:
+		return []string{strconv.FormatFloat(v, 'f', -1, 64)}, true
+	case int64:
+		return []string{strconv.FormatInt(v, 10)}, true
+	case int:
+		return []string{strconv.Itoa(v)}, true
+	case []string:
+		return v, true
+	case []interface{}:
+		var values []string
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, s)
+		}
+		return values, true
+	default:
+		return nil, false
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
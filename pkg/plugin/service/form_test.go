@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ocontext "github.com/vmware-tanzu/octant/internal/context"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/plugin/service/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+type testForm struct {
+	Name     string   `form:"name,label=Name,required,maxLength=10,pattern=^[a-z]+$"`
+	Email    string   `form:"email,label=Email,email"`
+	Password string   `form:"password,label=Password,type=password"`
+	Replicas int32    `form:"replicas,label=Replicas,min=1,max=5,default=2"`
+	Ratio    float64  `form:"ratio,label=Ratio"`
+	Tier     string   `form:"tier,label=Tier,choices=gold|silver,default=silver"`
+	Enabled  bool     `form:"enabled,label=Enabled"`
+	Features []string `form:"features,label=Features,choices=a|b|c"`
+	Ignored  string   `form:"-"`
+	Untagged string
+}
+
+func TestCreateForm(t *testing.T) {
+	source := testForm{
+		Name:     "nginx",
+		Enabled:  true,
+		Features: []string{"b"},
+	}
+
+	got, err := CreateForm("plugin/save", source)
+	require.NoError(t, err)
+
+	name := component.NewFormFieldText("Name", "name", "nginx")
+	name.AddValidator("", "", map[component.FormValidator]interface{}{
+		component.FormValidatorRequired:  "",
+		component.FormValidatorMaxLength: 10,
+		component.FormValidatorPattern:   "^[a-z]+$",
+	})
+	email := component.NewFormFieldText("Email", "email", "")
+	email.AddValidator("", "", map[component.FormValidator]interface{}{
+		component.FormValidatorEmail: "",
+	})
+	password := component.NewFormFieldPassword("Password", "password", "")
+	password.AddValidator("", "", map[component.FormValidator]interface{}{})
+	replicas := component.NewFormFieldNumber("Replicas", "replicas", "2")
+	replicas.AddValidator("", map[component.FormValidator]interface{}{
+		component.FormValidatorMin: float64(1),
+		component.FormValidatorMax: float64(5),
+	})
+	ratio := component.NewFormFieldNumber("Ratio", "ratio", "")
+	ratio.AddValidator("", map[component.FormValidator]interface{}{})
+	tier := component.NewFormFieldSelect("Tier", "tier", []component.InputChoice{
+		{Label: "gold", Value: "gold"},
+		{Label: "silver", Value: "silver", Checked: true},
+	}, false)
+	tier.AddValidator("", map[component.FormValidator]interface{}{})
+	enabled := component.NewFormFieldCheckBox("Enabled", "enabled", []component.InputChoice{
+		{Label: "Enabled", Value: "true", Checked: true},
+	})
+	enabled.AddValidator("", map[component.FormValidator]interface{}{})
+	features := component.NewFormFieldCheckBox("Features", "features", []component.InputChoice{
+		{Label: "a", Value: "a"},
+		{Label: "b", Value: "b", Checked: true},
+		{Label: "c", Value: "c"},
+	})
+	features.AddValidator("", map[component.FormValidator]interface{}{})
+
+	expected := component.Form{
+		Fields: []component.FormField{
+			name, email, password, replicas, ratio, tier, enabled, features,
+			component.NewFormFieldHidden("action", "plugin/save"),
+		},
+	}
+
+	require.Equal(t, expected, got)
+}
+
+func TestCreateForm_invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		source interface{}
+	}{
+		{
+			name:   "not a struct",
+			source: "string",
+		},
+		{
+			name: "unsupported field type",
+			source: struct {
+				Field map[string]string `form:"field"`
+			}{},
+		},
+		{
+			name: "unknown option",
+			source: struct {
+				Field string `form:"field,unknown"`
+			}{},
+		},
+		{
+			name: "invalid pattern",
+			source: struct {
+				Field string `form:"field,pattern=["`
+			}{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := CreateForm("action", test.source)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestDecodeForm(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  action.Payload
+		expected testForm
+		errors   []FieldError
+	}{
+		{
+			name: "valid",
+			payload: action.Payload{
+				"name":     "nginx",
+				"email":    "user@example.com",
+				"password": "secret",
+				"replicas": float64(3),
+				"ratio":    "0.5",
+				"tier":     "gold",
+				"enabled":  []interface{}{"true"},
+				"features": []interface{}{"a", "c"},
+			},
+			expected: testForm{
+				Name:     "nginx",
+				Email:    "user@example.com",
+				Password: "secret",
+				Replicas: 3,
+				Ratio:    0.5,
+				Tier:     "gold",
+				Enabled:  true,
+				Features: []string{"a", "c"},
+			},
+		},
+		{
+			name: "defaults",
+			payload: action.Payload{
+				"name":    "nginx",
+				"enabled": []interface{}{},
+			},
+			expected: testForm{
+				Name:     "nginx",
+				Replicas: 2,
+				Tier:     "silver",
+			},
+		},
+		{
+			name: "invalid",
+			payload: action.Payload{
+				"name":     "NGINX",
+				"email":    "not an email",
+				"replicas": "10",
+				"ratio":    "half",
+				"tier":     "bronze",
+				"features": []interface{}{"d"},
+			},
+			errors: []FieldError{
+				{Name: "name", Label: "Name", Message: "must match ^[a-z]+$"},
+				{Name: "email", Label: "Email", Message: "must be an email address"},
+				{Name: "replicas", Label: "Replicas", Message: "must be at most 5"},
+				{Name: "ratio", Label: "Ratio", Message: "must be a number"},
+				{Name: "tier", Label: "Tier", Message: "must be one of gold, silver"},
+				{Name: "features", Label: "Features", Message: "must be one of a, b, c"},
+			},
+		},
+		{
+			name:    "missing required",
+			payload: action.Payload{},
+			errors: []FieldError{
+				{Name: "name", Label: "Name", Message: "is required"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got testForm
+			err := DecodeForm(test.payload, &got)
+			if len(test.errors) > 0 {
+				var formErr *FormError
+				require.True(t, errors.As(err, &formErr))
+				require.Equal(t, test.errors, formErr.Fields)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, got)
+		})
+	}
+}
+
+type color string
+
+type checkboxForm struct {
+	Notify bool    `form:"notify,label=Notify,default=true"`
+	Colors []color `form:"colors,label=Colors,choices=red|blue,default=red"`
+}
+
+func TestDecodeForm_checkboxes(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  action.Payload
+		expected checkboxForm
+	}{
+		{
+			name: "checked",
+			payload: action.Payload{
+				"notify": "true",
+				"colors": []interface{}{"red", "blue"},
+			},
+			expected: checkboxForm{
+				Notify: true,
+				Colors: []color{"red", "blue"},
+			},
+		},
+		{
+			name:     "unchecked",
+			payload:  action.Payload{},
+			expected: checkboxForm{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got checkboxForm
+			require.NoError(t, DecodeForm(test.payload, &got))
+			require.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestDecodeForm_requires_pointer(t *testing.T) {
+	require.Error(t, DecodeForm(action.Payload{}, testForm{}))
+}
+
+func TestActionRequest_DecodeForm(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ctx := context.Background()
+
+	dashboard := fake.NewMockDashboard(controller)
+	dashboard.EXPECT().
+		SendAlert(ctx, "client", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, alert action.Alert) error {
+			assert.Equal(t, action.AlertTypeError, alert.Type)
+			assert.Equal(t, "Name is required", alert.Message)
+			return nil
+		})
+
+	request := &ActionRequest{
+		baseRequest:     newBaseRequest(ctx, "plugin-name"),
+		DashboardClient: dashboard,
+		Payload:         action.Payload{},
+		ClientState:     ocontext.ClientState{ClientID: "client"},
+	}
+
+	var got testForm
+	err := request.DecodeForm(&got)
+
+	var formErr *FormError
+	require.True(t, errors.As(err, &formErr))
+}
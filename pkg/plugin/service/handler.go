@@ -118,6 +118,22 @@ func (p *Handler) ObjectStatus(ctx context.Context, object runtime.Object) (plug
 	return p.HandlerFuncs.ObjectStatus(request)
 }
 
+// RelatedObjects finds objects related to an object.
+func (p *Handler) RelatedObjects(ctx context.Context, object runtime.Object) (plugin.RelatedObjectsResponse, error) {
+	if p.HandlerFuncs.RelatedObjects == nil {
+		return plugin.RelatedObjectsResponse{}, nil
+	}
+
+	request := &PrintRequest{
+		baseRequest:     newBaseRequest(ctx, p.name),
+		DashboardClient: p.dashboardClient,
+		Object:          object,
+		ClientState:     ocontext.ClientStateFrom(ctx),
+	}
+
+	return p.HandlerFuncs.RelatedObjects(request)
+}
+
 // HandleAction handles actions given a payload.
 func (p *Handler) HandleAction(ctx context.Context, actionName string, payload action.Payload) error {
 	if p.HandlerFuncs.HandleAction == nil {
@@ -18,6 +18,7 @@ import (
 	"github.com/vmware-tanzu/octant/pkg/navigation"
 	"github.com/vmware-tanzu/octant/pkg/plugin"
 	"github.com/vmware-tanzu/octant/pkg/plugin/service/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
 )
 
 func TestHandler_Register(t *testing.T) {
@@ -256,6 +257,66 @@ func TestHandler_ObjectStatus_using_supplied_function(t *testing.T) {
 	assert.True(t, ran)
 }
 
+func TestHandler_RelatedObjects_default(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashboardClient := fake.NewMockDashboard(controller)
+
+	h := Handler{
+		dashboardClient: dashboardClient,
+	}
+
+	pod := testutil.CreatePod("pod")
+
+	ctx := context.Background()
+	got, err := h.RelatedObjects(ctx, pod)
+	require.NoError(t, err)
+
+	expected := plugin.RelatedObjectsResponse{}
+
+	require.Equal(t, expected, got)
+}
+
+func TestHandler_RelatedObjects_using_supplied_function(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashboardClient := fake.NewMockDashboard(controller)
+	pod := testutil.CreatePod("pod")
+	clientState := ocontext.ClientState{
+		ClientID:  "foo-client",
+		Namespace: "foo-namespace",
+	}
+
+	expected := plugin.RelatedObjectsResponse{
+		Owners: []store.Key{{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "config"}},
+	}
+
+	ran := false
+
+	h := Handler{
+		dashboardClient: dashboardClient,
+		HandlerFuncs: HandlerFuncs{
+			RelatedObjects: func(r *PrintRequest) (plugin.RelatedObjectsResponse, error) {
+				ran = true
+				assert.Equal(t, dashboardClient, r.DashboardClient)
+				assert.Equal(t, pod, r.Object)
+				assert.Equal(t, clientState, r.ClientState)
+				return expected, nil
+			},
+		},
+	}
+
+	ctx := context.Background()
+	ctx = ocontext.WithClientState(ctx, clientState)
+	got, err := h.RelatedObjects(ctx, pod)
+	require.NoError(t, err)
+
+	assert.Equal(t, expected, got)
+	assert.True(t, ran)
+}
+
 func TestHandler_HandleAction_default(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
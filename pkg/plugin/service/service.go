@@ -47,6 +47,13 @@ func WithObjectStatus(fn HandlerObjectStatusFunc) PluginOption {
 	}
 }
 
+// WithRelatedObjects configures the plugin to supply related objects.
+func WithRelatedObjects(fn HandlerRelatedObjectsFunc) PluginOption {
+	return func(p *Plugin) {
+		p.pluginHandler.HandlerFuncs.RelatedObjects = fn
+	}
+}
+
 // WithActionHandler configures the plugin to handle actions.
 func WithActionHandler(fn HandlerActionFunc) PluginOption {
 	return func(p *Plugin) {
@@ -180,16 +187,18 @@ type NavigationRequest struct {
 type HandlerPrinterFunc func(request *PrintRequest) (plugin.PrintResponse, error)
 type HandlerTabPrintFunc func(request *PrintRequest) (plugin.TabResponse, error)
 type HandlerObjectStatusFunc func(request *PrintRequest) (plugin.ObjectStatusResponse, error)
+type HandlerRelatedObjectsFunc func(request *PrintRequest) (plugin.RelatedObjectsResponse, error)
 type HandlerActionFunc func(request *ActionRequest) error
 type HandlerNavigationFunc func(request *NavigationRequest) (navigation.Navigation, error)
 type HandlerInitRoutesFunc func(router *Router)
 
 // HandlerFuncs are functions for configuring a plugin.
 type HandlerFuncs struct {
-	Print        HandlerPrinterFunc
-	PrintTabs    []HandlerTabPrintFunc
-	ObjectStatus HandlerObjectStatusFunc
-	HandleAction HandlerActionFunc
-	Navigation   HandlerNavigationFunc
-	InitRoutes   HandlerInitRoutesFunc
+	Print          HandlerPrinterFunc
+	PrintTabs      []HandlerTabPrintFunc
+	ObjectStatus   HandlerObjectStatusFunc
+	RelatedObjects HandlerRelatedObjectsFunc
+	HandleAction   HandlerActionFunc
+	Navigation     HandlerNavigationFunc
+	InitRoutes     HandlerInitRoutesFunc
 }
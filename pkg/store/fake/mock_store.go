@@ -98,6 +98,21 @@ func (mr *MockStoreMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStore)(nil).Get), arg0, arg1)
 }
 
+// Health mocks base method
+func (m *MockStore) Health(arg0 context.Context, arg1 store.Key) (store.Health, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Health", arg0, arg1)
+	ret0, _ := ret[0].(store.Health)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Health indicates an expected call of Health
+func (mr *MockStoreMockRecorder) Health(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Health", reflect.TypeOf((*MockStore)(nil).Health), arg0, arg1)
+}
+
 // IsLoading mocks base method
 func (m *MockStore) IsLoading(arg0 context.Context, arg1 store.Key) bool {
 	m.ctrl.T.Helper()
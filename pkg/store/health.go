@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package store
+
+import "time"
+
+// Health is the health of the cache of a resource.
+type Health struct {
+	// Synced is true once the resource's objects have been cached.
+	Synced bool
+	// Forbidden is true if the resource can't be listed or watched with the
+	// current credentials. Forbidden resources are retried when the
+	// credentials change.
+	Forbidden bool
+	// Stale is true if the last attempt to list or watch the resource failed.
+	// Objects cached before the failure are still returned.
+	Stale bool
+	// LastError is the message of the last list or watch error.
+	LastError string
+	// LastErrorTime is when the last list or watch error happened.
+	LastErrorTime time.Time
+	// Failures is the number of list or watch errors since the resource was
+	// last listed.
+	Failures int
+	// Backoff is about how long it will be before a stale resource is listed
+	// again.
+	Backoff time.Duration
+}
+
+// Healthy returns true if the resource's cache is up to date.
+func (h Health) Healthy() bool {
+	return !h.Forbidden && !h.Stale
+}
@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package store
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Notifier is implemented by stores which can notify callers when objects change.
+type Notifier interface {
+	// Notify calls fn when an object matching any of keys is added, updated, or
+	// deleted. fn should not block. The returned function stops the notifications.
+	Notify(keys []Key, fn func()) (cancel func())
+}
+
+// KeyRecorder records the keys read from stores.
+type KeyRecorder struct {
+	mu sync.Mutex
+	// sources are the keys read from each store. Keys recorded without
+	// a store are kept with a nil notifier.
+	sources map[Notifier]map[string]Key
+}
+
+// NewKeyRecorder creates an instance of KeyRecorder.
+func NewKeyRecorder() *KeyRecorder {
+	return &KeyRecorder{
+		sources: map[Notifier]map[string]Key{},
+	}
+}
+
+// Record records a key.
+func (r *KeyRecorder) Record(key Key) {
+	r.RecordFrom(nil, key)
+}
+
+// RecordFrom records a key read from notifier.
+func (r *KeyRecorder) RecordFrom(notifier Notifier, key Key) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.sources[notifier] == nil {
+		r.sources[notifier] = map[string]Key{}
+	}
+	r.sources[notifier][key.String()] = key
+}
+
+// Keys returns the recorded keys sorted by their string representation.
+func (r *KeyRecorder) Keys() []Key {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keys := map[string]Key{}
+	for _, source := range r.sources {
+		for name, key := range source {
+			keys[name] = key
+		}
+	}
+
+	return sortedKeys(keys)
+}
+
+// Sources returns the recorded keys of each store, sorted by their string
+// representation. Keys recorded without a store are returned with a nil
+// notifier.
+func (r *KeyRecorder) Sources() map[Notifier][]Key {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sources := make(map[Notifier][]Key, len(r.sources))
+	for notifier, keys := range r.sources {
+		sources[notifier] = sortedKeys(keys)
+	}
+
+	return sources
+}
+
+func sortedKeys(keys map[string]Key) []Key {
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := make([]Key, len(names))
+	for i, name := range names {
+		out[i] = keys[name]
+	}
+
+	return out
+}
+
+type keyRecorderKey struct{}
+
+// WithKeyRecorder returns a context which records the keys read from a store.
+func WithKeyRecorder(ctx context.Context, recorder *KeyRecorder) context.Context {
+	return context.WithValue(ctx, keyRecorderKey{}, recorder)
+}
+
+// RecordKey records a key with the context's key recorder. It does nothing if
+// the context has no key recorder. Store implementations call it when reading.
+func RecordKey(ctx context.Context, key Key) {
+	recorder, ok := ctx.Value(keyRecorderKey{}).(*KeyRecorder)
+	if !ok || recorder == nil {
+		return
+	}
+
+	recorder.Record(key)
+}
+
+// RecordNotifierKey records a key read from notifier with the context's key
+// recorder, so callers can watch the store the key was read from. It does
+// nothing if the context has no key recorder.
+func RecordNotifierKey(ctx context.Context, notifier Notifier, key Key) {
+	recorder, ok := ctx.Value(keyRecorderKey{}).(*KeyRecorder)
+	if !ok || recorder == nil {
+		return
+	}
+
+	recorder.RecordFrom(notifier, key)
+}
+
+// Matches returns true if the object would be returned when reading the key.
+func (k Key) Matches(object *unstructured.Unstructured) bool {
+	if object == nil {
+		return false
+	}
+
+	gvk := object.GroupVersionKind()
+	if gvk.GroupKind() != schema.FromAPIVersionAndKind(k.APIVersion, k.Kind).GroupKind() {
+		return false
+	}
+
+	if k.Namespace != "" && k.Namespace != object.GetNamespace() {
+		return false
+	}
+
+	if k.Name != "" && k.Name != object.GetName() {
+		return false
+	}
+
+	objectLabels := labels.Set(object.GetLabels())
+
+	if k.Selector != nil && !k.Selector.AsSelector().Matches(objectLabels) {
+		return false
+	}
+
+	if k.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(k.LabelSelector)
+		if err != nil || !selector.Matches(objectLabels) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestRecordKey(t *testing.T) {
+	pods := Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+	service := Key{Namespace: "default", APIVersion: "v1", Kind: "Service", Name: "web"}
+
+	// Recording without a recorder does nothing.
+	RecordKey(context.Background(), pods)
+
+	recorder := NewKeyRecorder()
+	ctx := WithKeyRecorder(context.Background(), recorder)
+
+	RecordKey(ctx, service)
+	RecordKey(ctx, pods)
+	RecordKey(ctx, pods)
+
+	assert.Equal(t, []Key{pods, service}, recorder.Keys())
+}
+
+type fakeNotifier struct{}
+
+func (n *fakeNotifier) Notify([]Key, func()) func() {
+	return func() {}
+}
+
+func TestRecordNotifierKey(t *testing.T) {
+	pods := Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+	service := Key{Namespace: "default", APIVersion: "v1", Kind: "Service", Name: "web"}
+	notifier := &fakeNotifier{}
+
+	// Recording without a recorder does nothing.
+	RecordNotifierKey(context.Background(), notifier, pods)
+
+	recorder := NewKeyRecorder()
+	ctx := WithKeyRecorder(context.Background(), recorder)
+
+	RecordNotifierKey(ctx, notifier, pods)
+	RecordKey(ctx, service)
+
+	assert.Equal(t, []Key{pods, service}, recorder.Keys())
+	assert.Equal(t, map[Notifier][]Key{
+		notifier: {pods},
+		nil:      {service},
+	}, recorder.Sources())
+}
+
+func TestKey_Matches(t *testing.T) {
+	object := &unstructured.Unstructured{}
+	object.SetAPIVersion("apps/v1")
+	object.SetKind("Deployment")
+	object.SetNamespace("default")
+	object.SetName("web")
+	object.SetLabels(map[string]string{"app": "web"})
+
+	tests := []struct {
+		name     string
+		key      Key
+		expected bool
+	}{
+		{
+			name:     "kind",
+			key:      Key{APIVersion: "apps/v1", Kind: "Deployment"},
+			expected: true,
+		},
+		{
+			name:     "other version in group",
+			key:      Key{APIVersion: "apps/v1beta1", Kind: "Deployment"},
+			expected: true,
+		},
+		{
+			name: "other kind",
+			key:  Key{APIVersion: "apps/v1", Kind: "StatefulSet"},
+		},
+		{
+			name:     "namespace and name",
+			key:      Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "web"},
+			expected: true,
+		},
+		{
+			name: "other namespace",
+			key:  Key{Namespace: "other", APIVersion: "apps/v1", Kind: "Deployment"},
+		},
+		{
+			name: "other name",
+			key:  Key{APIVersion: "apps/v1", Kind: "Deployment", Name: "db"},
+		},
+		{
+			name:     "selector",
+			key:      Key{APIVersion: "apps/v1", Kind: "Deployment", Selector: &labels.Set{"app": "web"}},
+			expected: true,
+		},
+		{
+			name: "selector mismatch",
+			key:  Key{APIVersion: "apps/v1", Kind: "Deployment", Selector: &labels.Set{"app": "db"}},
+		},
+		{
+			name: "label selector mismatch",
+			key: Key{APIVersion: "apps/v1", Kind: "Deployment", LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "db"},
+			}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.key.Matches(object))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package store
+
+import "context"
+
+type metadataOnlyKey struct{}
+
+// WithMetadataOnly returns a context for reads which only need object
+// metadata such as names and labels. Stores may cache less for these reads and
+// return objects without their spec or status.
+func WithMetadataOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, metadataOnlyKey{}, true)
+}
+
+// MetadataOnly returns true if reads made with ctx only need object metadata.
+func MetadataOnly(ctx context.Context) bool {
+	metadataOnly, _ := ctx.Value(metadataOnlyKey{}).(bool)
+	return metadataOnly
+}
+
+type listPageKey struct{}
+
+// ListPage requests a page of a list.
+type ListPage struct {
+	// Limit is the most objects in the page.
+	Limit int64
+	// Continue is the continue token of the previous page. It is empty for the
+	// first page.
+	Continue string
+}
+
+// WithListPage returns a context whose lists may be paged. Stores decide
+// whether to page: a page has a continue token for the next page unless it is
+// the last, while whole lists never have one.
+func WithListPage(ctx context.Context, page ListPage) context.Context {
+	return context.WithValue(ctx, listPageKey{}, page)
+}
+
+// ListPageFrom returns the page requested for lists made with ctx. False is
+// returned if lists made with ctx must not be paged.
+func ListPageFrom(ctx context.Context) (ListPage, bool) {
+	page, ok := ctx.Value(listPageKey{}).(ListPage)
+	return page, ok
+}
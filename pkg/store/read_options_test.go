@@ -0,0 +1,30 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetadataOnly(t *testing.T) {
+	ctx := context.Background()
+	assert.False(t, MetadataOnly(ctx))
+	assert.True(t, MetadataOnly(WithMetadataOnly(ctx)))
+}
+
+func TestListPageFrom(t *testing.T) {
+	ctx := context.Background()
+	_, ok := ListPageFrom(ctx)
+	assert.False(t, ok)
+
+	page := ListPage{Limit: 100, Continue: "token"}
+	got, ok := ListPageFrom(WithListPage(ctx, page))
+	assert.True(t, ok)
+	assert.Equal(t, page, got)
+}
@@ -41,11 +41,14 @@ type Store interface {
 	UpdateClusterClient(ctx context.Context, client cluster.ClientInterface) error
 	Update(ctx context.Context, key Key, updater func(*unstructured.Unstructured) error) error
 	IsLoading(ctx context.Context, key Key) bool
+	// Health returns the health of the cache of key's resource.
+	Health(ctx context.Context, key Key) (Health, error)
 	Create(ctx context.Context, object *unstructured.Unstructured) error
 	// CreateOrUpdateFromYAML creates resources in the cluster from YAML input.
 	// Resources are created in the order they are present in the YAML.
-	// An error creating a resource halts resource creation.
-	// A list of created resources is returned. You may have created resources AND a non-nil error.
+	// An error creating a resource halts resource creation unless the apply
+	// options set with WithApplyOptions continue on errors.
+	// A list of results is returned. You may have created resources AND a non-nil error.
 	CreateOrUpdateFromYAML(ctx context.Context, namespace, input string) ([]string, error)
 }
 
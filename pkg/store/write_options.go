@@ -0,0 +1,69 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package store
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type dryRunKey struct{}
+
+type propagationPolicyKey struct{}
+
+type applyOptionsKey struct{}
+
+// ApplyOptions configure how resources are applied from YAML.
+type ApplyOptions struct {
+	// ServerSide applies resources with server-side apply. Otherwise, missing
+	// resources are created and existing resources are patched.
+	ServerSide bool
+	// Force takes ownership of fields owned by other field managers when applying
+	// server-side. Without it, those fields are reported as conflicts.
+	Force bool
+	// ContinueOnError applies the remaining resources after one fails.
+	ContinueOnError bool
+}
+
+// WithDryRun returns a context for previewing writes. Stores send writes made
+// with it to the API server with dryRun=All, so they are validated and admitted
+// but not persisted.
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// DryRun returns true if writes made with ctx should not be persisted.
+func DryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return dryRun
+}
+
+// WithPropagationPolicy returns a context whose deletes use policy.
+func WithPropagationPolicy(ctx context.Context, policy metav1.DeletionPropagation) context.Context {
+	return context.WithValue(ctx, propagationPolicyKey{}, policy)
+}
+
+// PropagationPolicy returns the propagation policy for deletes made with ctx.
+// Dependents are deleted in the foreground unless a policy was set.
+func PropagationPolicy(ctx context.Context) metav1.DeletionPropagation {
+	policy, ok := ctx.Value(propagationPolicyKey{}).(metav1.DeletionPropagation)
+	if !ok {
+		return metav1.DeletePropagationForeground
+	}
+	return policy
+}
+
+// WithApplyOptions returns a context whose YAML applies use options.
+func WithApplyOptions(ctx context.Context, options ApplyOptions) context.Context {
+	return context.WithValue(ctx, applyOptionsKey{}, options)
+}
+
+// ApplyOptionsFrom returns the options for YAML applies made with ctx.
+func ApplyOptionsFrom(ctx context.Context) ApplyOptions {
+	options, _ := ctx.Value(applyOptionsKey{}).(ApplyOptions)
+	return options
+}
@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDryRun(t *testing.T) {
+	ctx := context.Background()
+	assert.False(t, DryRun(ctx))
+	assert.True(t, DryRun(WithDryRun(ctx)))
+}
+
+func TestPropagationPolicy(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, metav1.DeletePropagationForeground, PropagationPolicy(ctx), "foreground is the default")
+
+	ctx = WithPropagationPolicy(ctx, metav1.DeletePropagationOrphan)
+	assert.Equal(t, metav1.DeletePropagationOrphan, PropagationPolicy(ctx))
+}
+
+func TestApplyOptionsFrom(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, ApplyOptions{}, ApplyOptionsFrom(ctx))
+
+	options := ApplyOptions{ServerSide: true, ContinueOnError: true}
+	assert.Equal(t, options, ApplyOptionsFrom(WithApplyOptions(ctx, options)))
+}
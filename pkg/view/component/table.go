@@ -25,6 +25,16 @@ type TableFilter struct {
 	Selected []string `json:"selected"`
 }
 
+// TablePagination describes a page of a table whose rows are paged by the
+// server. Continue is the token the current page was listed with, and Next is
+// the token for the following page. An empty Next means this is the last page.
+type TablePagination struct {
+	Continue  string `json:"continue"`
+	Next      string `json:"next"`
+	Remaining *int64 `json:"remaining,omitempty"`
+	PageSize  int64  `json:"pageSize"`
+}
+
 // TableConfig is the contents of a Table
 type TableConfig struct {
 	Columns      []TableCol             `json:"columns"`
@@ -33,6 +43,8 @@ type TableConfig struct {
 	Loading      bool                   `json:"loading"`
 	Filters      map[string]TableFilter `json:"filters"`
 	ButtonGroup  *ButtonGroup           `json:"buttonGroup,omitempty"`
+	Pagination   *TablePagination       `json:"pagination,omitempty"`
+	Alert        *Alert                 `json:"alert,omitempty"`
 }
 
 func (t *TableConfig) UnmarshalJSON(data []byte) error {
@@ -43,6 +55,8 @@ func (t *TableConfig) UnmarshalJSON(data []byte) error {
 		Loading      bool                   `json:"loading"`
 		Filters      map[string]TableFilter `json:"filters"`
 		ButtonGroup  *TypedObject           `json:"buttonGroup,omitempty"`
+		Pagination   *TablePagination       `json:"pagination,omitempty"`
+		Alert        *Alert                 `json:"alert,omitempty"`
 	}{}
 
 	if err := json.Unmarshal(data, &x); err != nil {
@@ -67,6 +81,8 @@ func (t *TableConfig) UnmarshalJSON(data []byte) error {
 	t.EmptyContent = x.EmptyContent
 	t.Loading = x.Loading
 	t.Filters = x.Filters
+	t.Pagination = x.Pagination
+	t.Alert = x.Alert
 
 	return nil
 }
@@ -290,6 +306,22 @@ func (t *Table) AddButton(name string, payload action.Payload, buttonOptions ...
 	t.Config.ButtonGroup.AddButton(button)
 }
 
+// SetPagination sets the server side pagination of the table.
+func (t *Table) SetPagination(pagination *TablePagination) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.Config.Pagination = pagination
+}
+
+// SetAlert sets an alert shown above the table.
+func (t *Table) SetAlert(alert Alert) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.Config.Alert = &alert
+}
+
 // Columns returns the table columns.
 func (t *Table) Columns() []TableCol {
 	return t.Config.Columns
@@ -243,3 +243,39 @@ func TestTable_AddFilter(t *testing.T) {
 
 	assert.Equal(t, expected, table.Config.Filters)
 }
+
+func TestTable_SetPagination(t *testing.T) {
+	table := NewTable("table", "placeholder", NewTableCols("a"))
+	remaining := int64(900)
+	pagination := &TablePagination{
+		Continue:  "page-2",
+		Next:      "page-3",
+		Remaining: &remaining,
+		PageSize:  100,
+	}
+	table.SetPagination(pagination)
+
+	assert.Equal(t, pagination, table.Config.Pagination)
+
+	data, err := json.Marshal(table.Config)
+	require.NoError(t, err)
+
+	var got TableConfig
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, pagination, got.Pagination)
+}
+
+func TestTable_SetAlert(t *testing.T) {
+	table := NewTable("table", "placeholder", NewTableCols("a"))
+	alert := NewAlert(AlertTypeWarning, "out of date")
+	table.SetAlert(alert)
+
+	assert.Equal(t, &alert, table.Config.Alert)
+
+	data, err := json.Marshal(table.Config)
+	require.NoError(t, err)
+
+	var got TableConfig
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, &alert, got.Alert)
+}
@@ -108,6 +108,7 @@ export class WebsocketService implements BackendService {
         retryWhen(errors =>
           errors.pipe(
             tap(_ => {
+              this.checkSession();
               const id = this.notifierSession.pushSignal(
                 NotifierSignalType.ERROR,
                 'Lost connection to Octant service. Retrying...'
@@ -202,6 +203,29 @@ export class WebsocketService implements BackendService {
     }
   }
 
+  /**
+   * Sends the browser to the login page if the server requires authentication
+   * and the session has ended.
+   */
+  private checkSession() {
+    if (this.electronService.isElectron()) {
+      return;
+    }
+
+    const loc = this.window.location;
+    this.window
+      .fetch(loc.pathname + 'api/v1/auth/session', {
+        credentials: 'same-origin',
+      })
+      .then(response => {
+        if (response.status === 401) {
+          const next = encodeURIComponent(loc.pathname + loc.hash);
+          loc.href = loc.pathname + 'api/v1/auth/login?next=' + next;
+        }
+      })
+      .catch(() => {});
+  }
+
   websocketURI(): string {
     if (this.electronService.isElectron()) {
       const port = this.electronService.port();
@@ -6,6 +6,8 @@ import { ComponentFixture, TestBed, waitForAsync } from '@angular/core/testing';
 import { DatagridComponent } from './datagrid.component';
 import { SharedModule } from '../../../shared.module';
 import { windowProvider, WindowToken } from '../../../../../window';
+import { ContentService } from '../../../services/content/content.service';
+import { ContextPreferencesService } from '../../../services/context-preferences/context-preferences.service';
 
 describe('DatagridComponent', () => {
   let component: DatagridComponent;
@@ -29,4 +31,39 @@ describe('DatagridComponent', () => {
   it('should create', () => {
     expect(component).toBeTruthy();
   });
+
+  it('requests the next and previous pages of a server paged list', () => {
+    const contentService = TestBed.inject(ContentService);
+    spyOn(contentService, 'setListPage');
+
+    component.listPage = { continue: '', next: 'page-2', pageSize: 100 };
+    component.nextListPage();
+    expect(contentService.setListPage).toHaveBeenCalledWith('page-2');
+    expect(component.previousPages).toEqual(['']);
+
+    component.listPage = { continue: 'page-2', next: '', pageSize: 100 };
+    component.previousListPage();
+    expect(contentService.setListPage).toHaveBeenCalledWith('');
+    expect(component.previousPages).toEqual([]);
+  });
+
+  it('saves hidden columns for the table', () => {
+    const preferencesService = TestBed.inject(ContextPreferencesService);
+    spyOn(preferencesService, 'setHiddenColumns');
+
+    component.title = 'Pods';
+    component.hiddenColumns = ['Labels'];
+
+    component.onHiddenChange('Age', true);
+    expect(preferencesService.setHiddenColumns).toHaveBeenCalledWith('Pods', [
+      'Labels',
+      'Age',
+    ]);
+    expect(component.isHidden('Age')).toBeTrue();
+
+    component.onHiddenChange('Labels', false);
+    expect(preferencesService.setHiddenColumns).toHaveBeenCalledWith('Pods', [
+      'Age',
+    ]);
+  });
 });
@@ -16,6 +16,7 @@ import {
   GridAction,
   GridActionsView,
   TableFilters,
+  TablePagination,
   TableRow,
   TableRowWithMetadata,
   TableView,
@@ -33,6 +34,8 @@ import { ButtonGroupView } from '../../../models/content';
 import { DomSanitizer } from '@angular/platform-browser';
 import { parse } from 'marked';
 import { PreferencesService } from '../../../services/preferences/preferences.service';
+import { ContentService } from '../../../services/content/content.service';
+import { ContextPreferencesService } from '../../../services/context-preferences/context-preferences.service';
 import { Subscription } from 'rxjs';
 
 @Component({
@@ -56,6 +59,9 @@ export class DatagridComponent
   buttonGroup?: ButtonGroupView;
   isModalOpen = false;
   defaultPageSize: number;
+  listPage?: TablePagination;
+  previousPages: string[] = [];
+  hiddenColumns: string[] = [];
 
   actionDialogOptions: ActionDialogOptions = undefined;
 
@@ -66,12 +72,15 @@ export class DatagridComponent
   loading: boolean;
   loading$: Observable<boolean>;
   sub: Subscription;
+  contextPreferencesSubscription: Subscription;
 
   constructor(
     private viewService: ViewService,
     private actionService: ActionService,
     private loadingService: LoadingService,
     private preferencesService: PreferencesService,
+    private contentService: ContentService,
+    private contextPreferencesService: ContextPreferencesService,
     private cdr: ChangeDetectorRef,
     private readonly sanitizer: DomSanitizer
   ) {
@@ -82,10 +91,21 @@ export class DatagridComponent
         this.defaultPageSize = +e;
         this.cdr.markForCheck();
       });
+    this.contextPreferencesSubscription = this.contextPreferencesService.current.subscribe(
+      () => {
+        this.hiddenColumns = this.contextPreferencesService.hiddenColumns(
+          this.title
+        );
+        this.cdr.markForCheck();
+      }
+    );
   }
 
   update() {
     this.title = this.viewService.viewTitleAsText(this.view);
+    this.hiddenColumns = this.contextPreferencesService.hiddenColumns(
+      this.title
+    );
 
     this.loading = true;
 
@@ -104,6 +124,45 @@ export class DatagridComponent
     this.columns = this.v.config.columns.map(column => column.name);
     this.filters = this.v.config.filters;
     this.buttonGroup = this.v.config.buttonGroup;
+    this.listPage = this.v.config.pagination;
+    if (!this.listPage?.continue) {
+      this.previousPages = [];
+    }
+  }
+
+  nextListPage() {
+    if (!this.listPage?.next) {
+      return;
+    }
+    this.previousPages = [...this.previousPages, this.listPage.continue];
+    this.contentService.setListPage(this.listPage.next);
+  }
+
+  previousListPage() {
+    if (this.previousPages.length === 0) {
+      return;
+    }
+    const token = this.previousPages[this.previousPages.length - 1];
+    this.previousPages = this.previousPages.slice(0, -1);
+    this.contentService.setListPage(token);
+  }
+
+  isHidden(columnName: string): boolean {
+    return this.hiddenColumns.includes(columnName);
+  }
+
+  onHiddenChange(columnName: string, hidden: boolean) {
+    if (!this.title || this.isHidden(columnName) === hidden) {
+      return;
+    }
+
+    this.hiddenColumns = hidden
+      ? [...this.hiddenColumns, columnName]
+      : this.hiddenColumns.filter(name => name !== columnName);
+    this.contextPreferencesService.setHiddenColumns(
+      this.title,
+      this.hiddenColumns
+    );
   }
 
   private getRowsWithMetadata(rows: TableRow[]): TableRowWithMetadata[] {
@@ -194,6 +253,7 @@ export class DatagridComponent
 
   ngOnDestroy() {
     this.sub.unsubscribe();
+    this.contextPreferencesSubscription.unsubscribe();
   }
 }
 
@@ -3,7 +3,7 @@ Copyright (c) 2020 the Octant contributors. All Rights Reserved.
 SPDX-License-Identifier: Apache-2.0
 */
 
-import { Component, OnDestroy, OnInit } from '@angular/core';
+import { Component, Input, OnDestroy, OnInit } from '@angular/core';
 import { EditorView } from '../../../models/content';
 import { NamespaceService } from '../../../services/namespace/namespace.service';
 import { ActionService } from '../../../services/action/action.service';
@@ -25,6 +25,9 @@ interface Options {
 export class EditorComponent
   extends AbstractViewComponent<EditorView>
   implements OnInit, OnDestroy {
+  // payload is merged into the submitted payload.
+  @Input() payload: { [key: string]: any } = {};
+
   set value(v: string) {
     if (v !== this.editorValue) {
       this.isModified = true;
@@ -96,6 +99,7 @@ export class EditorComponent
       ...(this.metadata || {
         namespace: this.namespaceService.activeNamespace.value,
       }),
+      ...this.payload,
     };
     this.actionService.perform(payload);
   }
@@ -344,9 +344,18 @@ export interface TableView extends View {
     loading: boolean;
     filters: TableFilters;
     buttonGroup?: ButtonGroupView;
+    pagination?: TablePagination;
+    alert?: Alert;
   };
 }
 
+export interface TablePagination {
+  continue: string;
+  next: string;
+  remaining?: number;
+  pageSize: number;
+}
+
 export interface TableFilters {
   [key: string]: TableFilter;
 }
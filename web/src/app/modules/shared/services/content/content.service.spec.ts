@@ -6,6 +6,9 @@
 import { TestBed } from '@angular/core/testing';
 
 import {
+  ContentPatch,
+  ContentPatchMessage,
+  ContentResyncRequest,
   ContentService,
   ContentUpdate,
   ContentUpdateMessage,
@@ -73,6 +76,57 @@ describe('ContentService', () => {
     });
   });
 
+  describe('content patch', () => {
+    const update: ContentUpdate = {
+      content: { extensionComponent: null, title: [], viewComponents: [] },
+      namespace: 'default',
+      contentPath: '/path',
+      queryParams: {},
+      sequence: 1,
+    };
+
+    let backendService: BackendService;
+
+    beforeEach(() => {
+      backendService = TestBed.inject(WebsocketService);
+      spyOn(backendService, 'sendMessage');
+      backendService.triggerHandler(ContentUpdateMessage, update);
+    });
+
+    it('applies the patch to the current content', () => {
+      const patch: ContentPatch = {
+        patch: [{ op: 'add', path: '/title/0', value: { metadata: {} } }],
+        namespace: 'default',
+        contentPath: '/path',
+        queryParams: {},
+        sequence: 2,
+      };
+      backendService.triggerHandler(ContentPatchMessage, patch);
+
+      expect(service.current.getValue().content.title).toEqual([
+        { metadata: {} } as any,
+      ]);
+      expect(backendService.sendMessage).not.toHaveBeenCalled();
+    });
+
+    it('requests full content if a patch was missed', () => {
+      const patch: ContentPatch = {
+        patch: [],
+        namespace: 'default',
+        contentPath: '/path',
+        queryParams: {},
+        sequence: 3,
+      };
+      backendService.triggerHandler(ContentPatchMessage, patch);
+
+      expect(service.current.getValue().content).toEqual(update.content);
+      expect(backendService.sendMessage).toHaveBeenCalledWith(
+        ContentResyncRequest,
+        {}
+      );
+    });
+  });
+
   describe('label filters updated', () => {
     let labelFilterService: LabelFilterService;
 
@@ -108,6 +162,14 @@ describe('ContentService', () => {
       );
     });
 
+    it('sends a setListPage message to the server', () => {
+      service.setListPage('page-2');
+      expect(backendService.sendMessage).toHaveBeenCalledWith(
+        'action.octant.dev/setListPage',
+        { continue: 'page-2' }
+      );
+    });
+
     describe('with filters defined', () => {
       beforeEach(() => {
         filters = [{ key: 'foo', value: 'bar' }];
@@ -16,14 +16,31 @@ import {
 import { NamespaceService } from '../namespace/namespace.service';
 import { LoadingService } from '../loading/loading.service';
 import { debounceTime, delay, distinctUntilChanged } from 'rxjs/operators';
+import { applyPatch, PatchOperation } from '../../../../util/json-patch';
 
 export const ContentUpdateMessage = 'event.octant.dev/content';
+export const ContentPatchMessage = 'event.octant.dev/contentPatch';
+export const ContentResyncRequest = 'action.octant.dev/contentResync';
+export const SetListPageRequest = 'action.octant.dev/setListPage';
 
 export interface ContentUpdate {
   content: Content;
   namespace: string;
   contentPath: string;
   queryParams: { [key: string]: string[] };
+  sequence?: number;
+}
+
+/**
+ * ContentPatch is a change to the content in the update with the previous
+ * sequence number.
+ */
+export interface ContentPatch {
+  patch: PatchOperation[];
+  namespace: string;
+  contentPath: string;
+  queryParams: { [key: string]: string[] };
+  sequence: number;
 }
 
 const emptyContentResponse: ContentResponse = {
@@ -47,6 +64,8 @@ export class ContentService {
   }
 
   private lastReceived = '';
+  private lastUpdate: ContentUpdate;
+  private resyncing = false;
 
   constructor(
     private router: Router,
@@ -56,44 +75,12 @@ export class ContentService {
     private loadingService: LoadingService
   ) {
     websocketService.registerHandler(ContentUpdateMessage, data => {
-      const response = data as ContentUpdate;
-
-      const s = JSON.stringify(data);
-      if (s === this.lastReceived) {
-        return;
-      }
-
-      this.lastReceived = s;
-
-      this.setContent(response);
-      namespaceService.setNamespace(response.namespace);
-
-      if (response.contentPath) {
-        if (this.previousContentPath.length > 0) {
-          if (response.contentPath !== this.previousContentPath) {
-            const segments = response.contentPath.split('/');
-            this.router
-              .navigate(segments, {
-                queryParams: response.queryParams,
-              })
-              .then(result => {
-                if (result) {
-                  this.delayedComplete(true);
-                } else {
-                  this.loadingService.requestComplete.next(true);
-                }
-              })
-              .catch(reason => {
-                this.loadingService.requestComplete.next(true);
-                console.error(`unable to navigate`, { segments, reason });
-              });
-          }
-        } else {
-          this.loadingService.requestComplete.next(true);
-        }
-      }
+      this.resyncing = false;
+      this.receiveContent(data as ContentUpdate);
+    });
 
-      this.previousContentPath = response.contentPath;
+    websocketService.registerHandler(ContentPatchMessage, data => {
+      this.receivePatch(data as ContentPatch);
     });
 
     labelFilterService.filters.subscribe(filters => {
@@ -105,6 +92,80 @@ export class ContentService {
       .subscribe(pos => this.debouncedScrollPos.next(pos));
   }
 
+  private receivePatch(update: ContentPatch) {
+    if (this.resyncing) {
+      return;
+    }
+
+    if (!this.lastUpdate || update.sequence !== this.lastUpdate.sequence + 1) {
+      this.requestResync();
+      return;
+    }
+
+    let content: Content;
+    try {
+      content = applyPatch(this.lastUpdate.content, update.patch);
+    } catch (e) {
+      console.error('unable to apply content patch', e);
+      this.requestResync();
+      return;
+    }
+
+    this.receiveContent({
+      content,
+      namespace: update.namespace,
+      contentPath: update.contentPath,
+      queryParams: update.queryParams,
+      sequence: update.sequence,
+    });
+  }
+
+  private requestResync() {
+    this.resyncing = true;
+    this.websocketService.sendMessage(ContentResyncRequest, {});
+  }
+
+  private receiveContent(response: ContentUpdate) {
+    this.lastUpdate = response;
+
+    const s = JSON.stringify({ ...response, sequence: undefined });
+    if (s === this.lastReceived) {
+      return;
+    }
+
+    this.lastReceived = s;
+
+    this.setContent(response);
+    this.namespaceService.setNamespace(response.namespace);
+
+    if (response.contentPath) {
+      if (this.previousContentPath.length > 0) {
+        if (response.contentPath !== this.previousContentPath) {
+          const segments = response.contentPath.split('/');
+          this.router
+            .navigate(segments, {
+              queryParams: response.queryParams,
+            })
+            .then(result => {
+              if (result) {
+                this.delayedComplete(true);
+              } else {
+                this.loadingService.requestComplete.next(true);
+              }
+            })
+            .catch(reason => {
+              this.loadingService.requestComplete.next(true);
+              console.error(`unable to navigate`, { segments, reason });
+            });
+        }
+      } else {
+        this.loadingService.requestComplete.next(true);
+      }
+    }
+
+    this.previousContentPath = response.contentPath;
+  }
+
   delayedComplete(value: boolean) {
     const delayed = new Observable(x => {
       x.next();
@@ -133,6 +194,16 @@ export class ContentService {
     );
   }
 
+  /**
+   * setListPage requests the page of a server paged list listed with a
+   * continue token. An empty token requests the first page.
+   */
+  setListPage(token: string) {
+    this.websocketService.sendMessage(SetListPageRequest, {
+      continue: token,
+    });
+  }
+
   private setContent(contentUpdate: ContentUpdate) {
     const contentResponse: ContentResponse = {
       content: contentUpdate.content,
@@ -0,0 +1,126 @@
+// Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { TestBed } from '@angular/core/testing';
+
+import {
+  ContextPreferences,
+  ContextPreferencesMessage,
+  ContextPreferencesService,
+  DeleteViewRequest,
+  SaveViewRequest,
+  SetFavoriteNamespaceRequest,
+  SetHiddenColumnsRequest,
+  SetViewRequest,
+  UnpinObjectAction,
+} from './context-preferences.service';
+import { WebsocketServiceMock } from '../../../../data/services/websocket/mock';
+import { WebsocketService } from '../../../../data/services/websocket/websocket.service';
+import { SharedModule } from '../../shared.module';
+
+describe('ContextPreferencesService', () => {
+  let service: ContextPreferencesService;
+  let websocketService: WebsocketService;
+
+  const update: ContextPreferences = {
+    context: 'dev',
+    favoriteNamespaces: ['frontend'],
+    pinnedObjects: [
+      {
+        apiVersion: 'apps/v1',
+        kind: 'Deployment',
+        namespace: 'frontend',
+        name: 'web',
+        path: '/overview/namespace/frontend/workloads/deployments/web',
+      },
+    ],
+    hiddenColumns: { Pods: ['Node'] },
+    views: [
+      {
+        name: 'payments',
+        namespaces: ['payments-a', 'payments-b'],
+        filters: [{ key: 'team', value: 'payments' }],
+      },
+    ],
+    view: 'payments',
+  };
+
+  beforeEach(() => {
+    TestBed.configureTestingModule({
+      imports: [SharedModule],
+      providers: [
+        ContextPreferencesService,
+        {
+          provide: WebsocketService,
+          useClass: WebsocketServiceMock,
+        },
+      ],
+    });
+
+    service = TestBed.inject(ContextPreferencesService);
+    websocketService = TestBed.inject(WebsocketService);
+    websocketService.triggerHandler(ContextPreferencesMessage, update);
+  });
+
+  it('tracks the current preferences', () => {
+    expect(service.current.value).toEqual(update);
+    expect(service.isFavoriteNamespace('frontend')).toBeTrue();
+    expect(service.isFavoriteNamespace('backend')).toBeFalse();
+    expect(service.hiddenColumns('Pods')).toEqual(['Node']);
+    expect(service.hiddenColumns('Services')).toEqual([]);
+  });
+
+  it('saves favorite namespaces', () => {
+    spyOn(websocketService, 'sendMessage');
+    service.setFavoriteNamespace('backend', true);
+    expect(websocketService.sendMessage).toHaveBeenCalledWith(
+      SetFavoriteNamespaceRequest,
+      { namespace: 'backend', favorite: true }
+    );
+  });
+
+  it('saves hidden columns', () => {
+    spyOn(websocketService, 'sendMessage');
+    service.setHiddenColumns('Pods', ['Node', 'Age']);
+    expect(websocketService.sendMessage).toHaveBeenCalledWith(
+      SetHiddenColumnsRequest,
+      { table: 'Pods', columns: ['Node', 'Age'] }
+    );
+  });
+
+  it('saves and selects views', () => {
+    spyOn(websocketService, 'sendMessage');
+    service.saveView('search', ['search']);
+    expect(websocketService.sendMessage).toHaveBeenCalledWith(
+      SaveViewRequest,
+      { name: 'search', namespaces: ['search'] }
+    );
+
+    service.setView('payments');
+    expect(websocketService.sendMessage).toHaveBeenCalledWith(SetViewRequest, {
+      name: 'payments',
+    });
+
+    service.deleteView('payments');
+    expect(websocketService.sendMessage).toHaveBeenCalledWith(
+      DeleteViewRequest,
+      { name: 'payments' }
+    );
+  });
+
+  it('unpins objects', () => {
+    spyOn(websocketService, 'sendMessage');
+    service.unpin(update.pinnedObjects[0]);
+    expect(websocketService.sendMessage).toHaveBeenCalledWith(
+      'action.octant.dev/performAction',
+      {
+        action: UnpinObjectAction,
+        apiVersion: 'apps/v1',
+        kind: 'Deployment',
+        namespace: 'frontend',
+        name: 'web',
+      }
+    );
+  });
+});
@@ -0,0 +1,127 @@
+// Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Injectable } from '@angular/core';
+import { BehaviorSubject } from 'rxjs';
+import { WebsocketService } from '../../../../data/services/websocket/websocket.service';
+import { ActionService } from '../action/action.service';
+
+export const ContextPreferencesMessage = 'event.octant.dev/contextPreferences';
+export const SetFavoriteNamespaceRequest =
+  'action.octant.dev/setFavoriteNamespace';
+export const SetHiddenColumnsRequest = 'action.octant.dev/setHiddenColumns';
+export const UnpinObjectAction = 'action.octant.dev/unpinObject';
+export const SaveViewRequest = 'action.octant.dev/saveView';
+export const DeleteViewRequest = 'action.octant.dev/deleteView';
+export const SetViewRequest = 'action.octant.dev/setView';
+
+export interface PinnedObject {
+  apiVersion: string;
+  kind: string;
+  namespace?: string;
+  name: string;
+  path: string;
+}
+
+export interface ContextView {
+  name: string;
+  namespaces: string[];
+  filters?: { key: string; value: string }[];
+}
+
+export interface ContextPreferences {
+  context: string;
+  favoriteNamespaces: string[];
+  pinnedObjects: PinnedObject[];
+  hiddenColumns: { [table: string]: string[] };
+  views: ContextView[];
+  view: string;
+}
+
+const emptyContextPreferences: ContextPreferences = {
+  context: '',
+  favoriteNamespaces: [],
+  pinnedObjects: [],
+  hiddenColumns: {},
+  views: [],
+  view: '',
+};
+
+/**
+ * ContextPreferencesService tracks the preferences Octant saves for the
+ * current kubeconfig context and saves changes to them.
+ */
+@Injectable({
+  providedIn: 'root',
+})
+export class ContextPreferencesService {
+  current = new BehaviorSubject<ContextPreferences>(emptyContextPreferences);
+
+  constructor(
+    private websocketService: WebsocketService,
+    private actionService: ActionService
+  ) {
+    websocketService.registerHandler(ContextPreferencesMessage, data => {
+      const update = data as ContextPreferences;
+      this.current.next({
+        context: update.context,
+        favoriteNamespaces: update.favoriteNamespaces || [],
+        pinnedObjects: update.pinnedObjects || [],
+        hiddenColumns: update.hiddenColumns || {},
+        views: update.views || [],
+        view: update.view || '',
+      });
+    });
+  }
+
+  isFavoriteNamespace(namespace: string): boolean {
+    return this.current.value.favoriteNamespaces.includes(namespace);
+  }
+
+  setFavoriteNamespace(namespace: string, favorite: boolean) {
+    this.websocketService.sendMessage(SetFavoriteNamespaceRequest, {
+      namespace,
+      favorite,
+    });
+  }
+
+  hiddenColumns(table: string): string[] {
+    return this.current.value.hiddenColumns[table] || [];
+  }
+
+  setHiddenColumns(table: string, columns: string[]) {
+    this.websocketService.sendMessage(SetHiddenColumnsRequest, {
+      table,
+      columns,
+    });
+  }
+
+  /**
+   * Saves the current filters and namespaces as a view and selects it.
+   */
+  saveView(name: string, namespaces: string[]) {
+    this.websocketService.sendMessage(SaveViewRequest, { name, namespaces });
+  }
+
+  deleteView(name: string) {
+    this.websocketService.sendMessage(DeleteViewRequest, { name });
+  }
+
+  /**
+   * Selects a view. A blank name leaves the current view.
+   */
+  setView(name: string) {
+    this.websocketService.sendMessage(SetViewRequest, { name });
+  }
+
+  unpin(object: PinnedObject) {
+    this.actionService.perform({
+      action: UnpinObjectAction,
+      apiVersion: object.apiVersion,
+      kind: object.kind,
+      namespace: object.namespace,
+      name: object.name,
+    });
+  }
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { TestBed } from '@angular/core/testing';
+
+import {
+  SearchRequest,
+  SearchResults,
+  SearchResultsMessage,
+  SearchService,
+} from './search.service';
+import { WebsocketServiceMock } from '../../../../data/services/websocket/mock';
+import { WebsocketService } from '../../../../data/services/websocket/websocket.service';
+import { SharedModule } from '../../shared.module';
+
+describe('SearchService', () => {
+  let service: SearchService;
+  let websocketService: WebsocketService;
+
+  const update: SearchResults = {
+    query: 'web',
+    results: [
+      {
+        link: {
+          metadata: { type: 'link' },
+          config: {
+            ref: '/overview/namespace/default/workloads/pods/web-1',
+            value: 'web-1',
+          },
+        },
+        apiVersion: 'v1',
+        kind: 'Pod',
+        namespace: 'default',
+        name: 'web-1',
+        score: 60,
+      },
+    ],
+  };
+
+  beforeEach(() => {
+    TestBed.configureTestingModule({
+      imports: [SharedModule],
+      providers: [
+        SearchService,
+        {
+          provide: WebsocketService,
+          useClass: WebsocketServiceMock,
+        },
+      ],
+    });
+    service = TestBed.inject(SearchService);
+    websocketService = TestBed.inject(WebsocketService);
+  });
+
+  it('tracks the latest results', () => {
+    websocketService.triggerHandler(SearchResultsMessage, update);
+    expect(service.results.value).toEqual({ ...update, error: undefined });
+  });
+
+  it('sends queries', () => {
+    spyOn(websocketService, 'sendMessage');
+    service.search('kind:Pod web');
+    expect(websocketService.sendMessage).toHaveBeenCalledWith(SearchRequest, {
+      query: 'kind:Pod web',
+    });
+  });
+});
@@ -0,0 +1,61 @@
+// Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Injectable } from '@angular/core';
+import { BehaviorSubject } from 'rxjs';
+import { WebsocketService } from '../../../../data/services/websocket/websocket.service';
+import { LinkView } from '../../models/content';
+
+export const SearchResultsMessage = 'event.octant.dev/searchResults';
+export const SearchRequest = 'action.octant.dev/search';
+
+export interface SearchResult {
+  link: LinkView;
+  apiVersion: string;
+  kind: string;
+  namespace?: string;
+  name: string;
+  score: number;
+}
+
+export interface SearchResults {
+  query: string;
+  results: SearchResult[];
+  error?: string;
+}
+
+const emptySearchResults: SearchResults = {
+  query: '',
+  results: [],
+};
+
+/**
+ * SearchService searches the objects Octant has cached. Results for the last
+ * query are updated as the cache changes.
+ */
+@Injectable({
+  providedIn: 'root',
+})
+export class SearchService {
+  results = new BehaviorSubject<SearchResults>(emptySearchResults);
+
+  constructor(private websocketService: WebsocketService) {
+    websocketService.registerHandler(SearchResultsMessage, data => {
+      const update = data as SearchResults;
+      this.results.next({
+        query: update.query,
+        results: update.results || [],
+        error: update.error,
+      });
+    });
+  }
+
+  /**
+   * Searches for objects. Queries are free text and `field:value` terms,
+   * e.g. `kind:Pod label:app=web image:nginx ns:prod`.
+   */
+  search(query: string) {
+    this.websocketService.sendMessage(SearchRequest, { query });
+  }
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { ComponentFixture, TestBed, waitForAsync } from '@angular/core/testing';
+import {
+  ActionPreviewComponent,
+  PreviewMessage,
+} from './action-preview.component';
+import { WebsocketService } from '../../../../../data/services/websocket/websocket.service';
+import { WebsocketServiceMock } from '../../../../../data/services/websocket/mock';
+import { ActionService } from '../../../../shared/services/action/action.service';
+import { SharedModule } from '../../../../shared/shared.module';
+
+describe('ActionPreviewComponent', () => {
+  let component: ActionPreviewComponent;
+  let fixture: ComponentFixture<ActionPreviewComponent>;
+  let websocketService: WebsocketServiceMock;
+  let actionService: ActionService;
+
+  beforeEach(
+    waitForAsync(() => {
+      TestBed.configureTestingModule({
+        declarations: [ActionPreviewComponent],
+        imports: [SharedModule],
+        providers: [
+          { provide: WebsocketService, useClass: WebsocketServiceMock },
+        ],
+      }).compileComponents();
+    })
+  );
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(ActionPreviewComponent);
+    component = fixture.componentInstance;
+    websocketService = TestBed.inject(WebsocketService) as any;
+    actionService = TestBed.inject(ActionService);
+    fixture.detectChanges();
+  });
+
+  it('opens previews', () => {
+    websocketService.triggerHandler(PreviewMessage, {
+      title: 'Delete Deployment web',
+      changes: [
+        { description: 'Deployment (apps/v1) web in default' },
+        {
+          description: 'ReplicaSet (apps/v1) web-1 in default',
+          dependent: true,
+        },
+      ],
+      confirm: { action: 'action.octant.dev/deleteObject' },
+      propagationPolicies: ['Foreground', 'Background', 'Orphan'],
+    });
+
+    expect(component.isOpen).toBeTrue();
+    expect(component.propagationPolicy).toEqual('Foreground');
+
+    component.propagationPolicy = 'Orphan';
+    expect(component.isOrphaned(component.preview.changes[1])).toBeTrue();
+  });
+
+  it('performs the action with the chosen propagation policy', () => {
+    spyOn(actionService, 'perform');
+
+    websocketService.triggerHandler(PreviewMessage, {
+      title: 'Delete Pod web',
+      confirm: { action: 'action.octant.dev/deleteObject', name: 'web' },
+      propagationPolicies: ['Foreground', 'Background', 'Orphan'],
+    });
+    component.propagationPolicy = 'Background';
+    component.confirm();
+
+    expect(component.isOpen).toBeFalse();
+    expect(actionService.perform).toHaveBeenCalledWith({
+      action: 'action.octant.dev/deleteObject',
+      name: 'web',
+      propagationPolicy: 'Background',
+    });
+  });
+});
@@ -0,0 +1,69 @@
+// Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Component, OnInit } from '@angular/core';
+import { WebsocketService } from '../../../../../data/services/websocket/websocket.service';
+import { ActionService } from '../../../../shared/services/action/action.service';
+
+export const PreviewMessage = 'event.octant.dev/preview';
+
+export interface PreviewChange {
+  description: string;
+  dependent?: boolean;
+}
+
+export interface Preview {
+  title: string;
+  changes?: PreviewChange[];
+  error?: string;
+  confirm: { [key: string]: any };
+  propagationPolicies?: string[];
+}
+
+@Component({
+  selector: 'app-action-preview',
+  templateUrl: './action-preview.component.html',
+  styleUrls: ['./action-preview.component.scss'],
+})
+export class ActionPreviewComponent implements OnInit {
+  isOpen = false;
+  preview: Preview;
+  propagationPolicy = '';
+
+  constructor(
+    private websocketService: WebsocketService,
+    private actionService: ActionService
+  ) {}
+
+  ngOnInit(): void {
+    this.websocketService.registerHandler(PreviewMessage, data => {
+      this.preview = data as Preview;
+      const policies = this.preview.propagationPolicies || [];
+      this.propagationPolicy = policies.length > 0 ? policies[0] : '';
+      this.isOpen = true;
+    });
+  }
+
+  isOrphaned(change: PreviewChange): boolean {
+    return change.dependent && this.propagationPolicy === 'Orphan';
+  }
+
+  cancel() {
+    this.isOpen = false;
+    this.preview = undefined;
+  }
+
+  confirm() {
+    const payload = { ...this.preview.confirm };
+    if (this.propagationPolicy) {
+      payload.propagationPolicy = this.propagationPolicy;
+    }
+    this.cancel();
+    this.actionService.perform(payload);
+  }
+
+  trackByFn(index, item) {
+    return index;
+  }
+}
@@ -2,6 +2,7 @@
 // SPDX-License-Identifier: Apache-2.0
 //
 import { ComponentFixture, TestBed, waitForAsync } from '@angular/core/testing';
+import { FormsModule } from '@angular/forms';
 import { ApplyYAMLComponent } from './apply-yaml.component';
 
 describe('ApplyYAMLComponent', () => {
@@ -11,6 +12,7 @@ describe('ApplyYAMLComponent', () => {
   beforeEach(
     waitForAsync(() => {
       TestBed.configureTestingModule({
+        imports: [FormsModule],
         declarations: [ApplyYAMLComponent],
       }).compileComponents();
     })
@@ -13,13 +13,18 @@ import { EditorView } from 'src/app/modules/shared/models/content';
 })
 export class ApplyYAMLComponent implements OnInit {
   isOpen: boolean;
+  options = {
+    serverSide: false,
+    force: false,
+    continueOnError: false,
+  };
   editorView: EditorView = {
     config: {
       value: '',
       language: 'yaml',
       readOnly: false,
       metadata: null,
-      submitAction: 'action.octant.dev/apply',
+      submitAction: 'action.octant.dev/previewApply',
       submitLabel: 'Apply',
     },
     metadata: {
@@ -0,0 +1,90 @@
+// Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { ComponentFixture, TestBed, waitForAsync } from '@angular/core/testing';
+import {
+  AuthStatusComponent,
+  AuthStatusMessage,
+  RefreshCredentialsRequest,
+} from './auth-status.component';
+import { WebsocketService } from '../../../../../data/services/websocket/websocket.service';
+import { WebsocketServiceMock } from '../../../../../data/services/websocket/mock';
+import { SharedModule } from '../../../../shared/shared.module';
+
+describe('AuthStatusComponent', () => {
+  let component: AuthStatusComponent;
+  let fixture: ComponentFixture<AuthStatusComponent>;
+  let websocketService: WebsocketServiceMock;
+
+  beforeEach(
+    waitForAsync(() => {
+      TestBed.configureTestingModule({
+        declarations: [AuthStatusComponent],
+        imports: [SharedModule],
+        providers: [
+          { provide: WebsocketService, useClass: WebsocketServiceMock },
+        ],
+      }).compileComponents();
+    })
+  );
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(AuthStatusComponent);
+    component = fixture.componentInstance;
+    websocketService = TestBed.inject(WebsocketService) as any;
+    fixture.detectChanges();
+  });
+
+  it('shows failed credentials', () => {
+    websocketService.triggerHandler(AuthStatusMessage, {
+      failed: true,
+      message: 'exec: executable aws failed: exit status 255',
+      plugin: 'exec',
+      command: 'aws eks get-token',
+      stderr: 'token expired',
+      refreshable: true,
+    });
+    fixture.detectChanges();
+
+    const element: HTMLElement = fixture.nativeElement;
+    expect(element.textContent).toContain('aws eks get-token');
+    expect(element.textContent).toContain('Refresh credentials');
+
+    component.toggleStderr();
+    fixture.detectChanges();
+    expect(element.querySelector('.auth-stderr').textContent).toContain(
+      'token expired'
+    );
+  });
+
+  it('hides the refresh button when credentials are not refreshable', () => {
+    websocketService.triggerHandler(AuthStatusMessage, {
+      failed: true,
+      message: 'the cluster rejected the credentials',
+      refreshable: false,
+    });
+    fixture.detectChanges();
+
+    const element: HTMLElement = fixture.nativeElement;
+    expect(element.textContent).not.toContain('Refresh credentials');
+  });
+
+  it('hides the banner when credentials work', () => {
+    websocketService.triggerHandler(AuthStatusMessage, { failed: false });
+    fixture.detectChanges();
+
+    expect(fixture.nativeElement.querySelector('clr-alert')).toBeNull();
+  });
+
+  it('refreshes credentials', () => {
+    const spy = spyOn(websocketService, 'sendMessage');
+    component.refresh();
+
+    expect(component.refreshing).toBeTrue();
+    expect(spy).toHaveBeenCalledWith(RefreshCredentialsRequest, {});
+
+    websocketService.triggerHandler(AuthStatusMessage, { failed: false });
+    expect(component.refreshing).toBeFalse();
+  });
+});
@@ -0,0 +1,47 @@
+// Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Component, OnInit } from '@angular/core';
+import { WebsocketService } from '../../../../../data/services/websocket/websocket.service';
+
+export const AuthStatusMessage = 'event.octant.dev/authStatus';
+export const RefreshCredentialsRequest = 'action.octant.dev/refreshCredentials';
+
+export interface AuthStatus {
+  failed: boolean;
+  message?: string;
+  plugin?: string;
+  command?: string;
+  stderr?: string;
+  refreshable?: boolean;
+}
+
+@Component({
+  selector: 'app-auth-status',
+  templateUrl: './auth-status.component.html',
+  styleUrls: ['./auth-status.component.scss'],
+})
+export class AuthStatusComponent implements OnInit {
+  status: AuthStatus;
+  refreshing = false;
+  showStderr = false;
+
+  constructor(private websocketService: WebsocketService) {}
+
+  ngOnInit(): void {
+    this.websocketService.registerHandler(AuthStatusMessage, data => {
+      this.status = data as AuthStatus;
+      this.refreshing = false;
+    });
+  }
+
+  refresh() {
+    this.refreshing = true;
+    this.websocketService.sendMessage(RefreshCredentialsRequest, {});
+  }
+
+  toggleStderr() {
+    this.showStderr = !this.showStderr;
+  }
+}
@@ -11,6 +11,8 @@ import {
   OverlayScrollbarsComponent,
   OverlayscrollbarsModule,
 } from 'overlayscrollbars-ngx';
+import { ContextPreferencesService } from 'src/app/modules/shared/services/context-preferences/context-preferences.service';
+import { NamespaceService } from 'src/app/modules/shared/services/namespace/namespace.service';
 
 describe('NamespaceComponent', () => {
   let component: NamespaceComponent;
@@ -35,4 +37,75 @@ describe('NamespaceComponent', () => {
   it('should create', () => {
     expect(component).toBeTruthy();
   });
+
+  it('lists favorite namespaces which exist', () => {
+    const namespaceService = TestBed.inject(NamespaceService);
+    const preferencesService = TestBed.inject(ContextPreferencesService);
+
+    namespaceService.availableNamespaces.next(['default', 'frontend']);
+    preferencesService.current.next({
+      context: 'dev',
+      favoriteNamespaces: ['frontend', 'deleted'],
+      pinnedObjects: [],
+      hiddenColumns: {},
+      views: [],
+      view: '',
+    });
+
+    expect(component.favoriteNamespaces).toEqual(['frontend']);
+    expect(component.isFavorite('frontend')).toBeTrue();
+    expect(component.isFavorite('default')).toBeFalse();
+  });
+
+  it('toggles the current namespace as a favorite', () => {
+    const preferencesService = TestBed.inject(ContextPreferencesService);
+    spyOn(preferencesService, 'setFavoriteNamespace');
+
+    component.currentNamespace = 'default';
+    component.toggleFavorite();
+
+    expect(preferencesService.setFavoriteNamespace).toHaveBeenCalledWith(
+      'default',
+      true
+    );
+  });
+
+  it('saves the current namespace as a new view', () => {
+    const preferencesService = TestBed.inject(ContextPreferencesService);
+    spyOn(preferencesService, 'saveView');
+
+    component.currentNamespace = 'payments-a';
+    component.openSaveView();
+    expect(component.viewNamespaces).toEqual(['payments-a']);
+    expect(component.canSaveView()).toBeFalse();
+
+    component.viewName = ' payments ';
+    component.toggleViewNamespace('payments-b', true);
+    component.saveView();
+
+    expect(preferencesService.saveView).toHaveBeenCalledWith('payments', [
+      'payments-a',
+      'payments-b',
+    ]);
+    expect(component.isSaveViewOpen).toBeFalse();
+  });
+
+  it('edits the active view', () => {
+    const preferencesService = TestBed.inject(ContextPreferencesService);
+    preferencesService.current.next({
+      context: 'dev',
+      favoriteNamespaces: [],
+      pinnedObjects: [],
+      hiddenColumns: {},
+      views: [{ name: 'payments', namespaces: ['payments-a', 'payments-b'] }],
+      view: 'payments',
+    });
+
+    component.openSaveView();
+    expect(component.viewName).toEqual('payments');
+    expect(component.viewNamespaces).toEqual(['payments-a', 'payments-b']);
+
+    component.toggleViewNamespace('payments-a', false);
+    expect(component.viewNamespaces).toEqual(['payments-b']);
+  });
 });
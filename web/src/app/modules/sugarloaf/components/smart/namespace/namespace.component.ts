@@ -11,6 +11,10 @@ import {
 } from '@angular/core';
 import { NamespaceService } from 'src/app/modules/shared/services/namespace/namespace.service';
 import trackByIdentity from 'src/app/util/trackBy/trackByIdentity';
+import {
+  ContextPreferencesService,
+  ContextView,
+} from 'src/app/modules/shared/services/context-preferences/context-preferences.service';
 import { Subscription } from 'rxjs';
 import {
   Module,
@@ -26,6 +30,12 @@ import {
 })
 export class NamespaceComponent implements OnInit, OnDestroy {
   namespaces: string[];
+  favoriteNamespaces: string[] = [];
+  views: ContextView[] = [];
+  activeView = '';
+  isSaveViewOpen = false;
+  viewName = '';
+  viewNamespaces: string[] = [];
   currentNamespace = '';
   trackByIdentity = trackByIdentity;
   modules: Module[] = [];
@@ -34,10 +44,13 @@ export class NamespaceComponent implements OnInit, OnDestroy {
   showDropdown: boolean;
 
   private namespaceSubscription: Subscription;
+  private preferencesSubscription: Subscription;
+  private savedFavorites: string[] = [];
 
   constructor(
     private namespaceService: NamespaceService,
     private navigationService: NavigationService,
+    private contextPreferencesService: ContextPreferencesService,
     private cdr: ChangeDetectorRef
   ) {}
 
@@ -52,6 +65,17 @@ export class NamespaceComponent implements OnInit, OnDestroy {
     this.namespaceSubscription = this.namespaceService.availableNamespaces.subscribe(
       (namespaces: string[]) => {
         this.namespaces = namespaces;
+        this.updateFavorites();
+        this.cdr.detectChanges();
+      }
+    );
+
+    this.preferencesSubscription = this.contextPreferencesService.current.subscribe(
+      preferences => {
+        this.savedFavorites = preferences.favoriteNamespaces;
+        this.views = preferences.views;
+        this.activeView = preferences.view;
+        this.updateFavorites();
         this.cdr.detectChanges();
       }
     );
@@ -76,6 +100,9 @@ export class NamespaceComponent implements OnInit, OnDestroy {
     if (this.namespaceSubscription) {
       this.namespaceSubscription.unsubscribe();
     }
+    if (this.preferencesSubscription) {
+      this.preferencesSubscription.unsubscribe();
+    }
   }
 
   namespaceClass(namespace: string) {
@@ -87,6 +114,72 @@ export class NamespaceComponent implements OnInit, OnDestroy {
     this.namespaceService.setNamespace(namespace);
   }
 
+  isFavorite(namespace: string): boolean {
+    return this.savedFavorites.includes(namespace);
+  }
+
+  toggleFavorite() {
+    this.contextPreferencesService.setFavoriteNamespace(
+      this.currentNamespace,
+      !this.isFavorite(this.currentNamespace)
+    );
+  }
+
+  identifyView(_: number, view: ContextView): string {
+    return view.name;
+  }
+
+  viewClass(name: string) {
+    return this.activeView === name ? ['active'] : [];
+  }
+
+  selectView(name: string) {
+    this.contextPreferencesService.setView(name);
+  }
+
+  deleteView(name: string) {
+    this.contextPreferencesService.deleteView(name);
+  }
+
+  // openSaveView edits the active view, or starts a new view containing the
+  // current namespace.
+  openSaveView() {
+    const view = this.views.find(v => v.name === this.activeView);
+    this.viewName = view ? view.name : '';
+    this.viewNamespaces = view
+      ? [...view.namespaces]
+      : [this.currentNamespace];
+    this.isSaveViewOpen = true;
+  }
+
+  toggleViewNamespace(namespace: string, selected: boolean) {
+    const others = this.viewNamespaces.filter(n => n !== namespace);
+    this.viewNamespaces = selected ? [...others, namespace] : others;
+  }
+
+  canSaveView(): boolean {
+    return this.viewName.trim() !== '' && this.viewNamespaces.length > 0;
+  }
+
+  saveView() {
+    if (!this.canSaveView()) {
+      return;
+    }
+    this.contextPreferencesService.saveView(
+      this.viewName.trim(),
+      this.viewNamespaces
+    );
+    this.isSaveViewOpen = false;
+  }
+
+  // updateFavorites lists the favorite namespaces which still exist.
+  private updateFavorites() {
+    const namespaces = this.namespaces || [];
+    this.favoriteNamespaces = this.savedFavorites.filter(namespace =>
+      namespaces.includes(namespace)
+    );
+  }
+
   hasDropdown() {
     if (this.selectedItem && this.modules[this.selectedItem.module]) {
       return this.modules[this.selectedItem.module].name !== 'cluster-overview';
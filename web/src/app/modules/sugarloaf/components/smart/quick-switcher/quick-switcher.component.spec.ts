@@ -6,6 +6,8 @@ import { ComponentFixture, TestBed, waitForAsync } from '@angular/core/testing';
 import { DefaultPipe } from '../../../../shared/pipes/default/default.pipe';
 import { QuickSwitcherComponent } from './quick-switcher.component';
 import { windowProvider, WindowToken } from '../../../../../window';
+import { ContextPreferencesService } from 'src/app/modules/shared/services/context-preferences/context-preferences.service';
+import { SearchService } from 'src/app/modules/shared/services/search/search.service';
 
 describe('QuickSwitcherComponent', () => {
   let component: QuickSwitcherComponent;
@@ -29,4 +31,69 @@ describe('QuickSwitcherComponent', () => {
   it('should create', () => {
     expect(component).toBeTruthy();
   });
+
+  it('lists pinned objects as destinations', () => {
+    const preferencesService = TestBed.inject(ContextPreferencesService);
+    preferencesService.current.next({
+      context: 'dev',
+      favoriteNamespaces: [],
+      pinnedObjects: [
+        {
+          apiVersion: 'apps/v1',
+          kind: 'Deployment',
+          namespace: 'default',
+          name: 'web',
+          path: '/workloads/namespace/default/detail/deployments/web',
+        },
+      ],
+      hiddenColumns: {},
+      views: [],
+      view: '',
+    });
+
+    expect(component.destinations[0]).toEqual({
+      title: 'Deployment web',
+      type: 'Pinned',
+      path: '/workloads/namespace/default/detail/deployments/web',
+      keywords: ['Deployment', 'web', 'default'],
+    });
+  });
+
+  it('searches cached objects as the input changes', () => {
+    const searchService = TestBed.inject(SearchService);
+    spyOn(searchService, 'search');
+
+    component.input = 'kind:Pod web';
+    component.updateFilteredDestinations('kind:Pod web');
+    expect(searchService.search).toHaveBeenCalledWith('kind:Pod web');
+
+    searchService.results.next({
+      query: 'kind:Pod web',
+      results: [
+        {
+          link: {
+            metadata: { type: 'link' },
+            config: {
+              ref: '/overview/namespace/default/workloads/pods/web-1',
+              value: 'web-1',
+            },
+          },
+          apiVersion: 'v1',
+          kind: 'Pod',
+          namespace: 'default',
+          name: 'web-1',
+          score: 60,
+        },
+      ],
+    });
+
+    expect(component.filteredDestinations).toEqual([
+      {
+        title: 'web-1',
+        type: 'Pod default',
+        path: '/overview/namespace/default/workloads/pods/web-1',
+        keywords: [],
+      },
+    ]);
+  });
 });
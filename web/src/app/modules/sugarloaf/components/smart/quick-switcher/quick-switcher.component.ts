@@ -14,6 +14,14 @@ import { Navigation, NavigationChild } from '../../../models/navigation';
 import { NavigationService } from '../../../../shared/services/navigation/navigation.service';
 import { debounceTime, distinctUntilChanged } from 'rxjs/operators';
 import { NamespaceService } from 'src/app/modules/shared/services/namespace/namespace.service';
+import {
+  ContextPreferencesService,
+  PinnedObject,
+} from 'src/app/modules/shared/services/context-preferences/context-preferences.service';
+import {
+  SearchResults,
+  SearchService,
+} from 'src/app/modules/shared/services/search/search.service';
 
 const emptyNavigation: Navigation = {
   sections: [],
@@ -41,11 +49,15 @@ export class QuickSwitcherComponent implements OnInit, OnDestroy {
   searchingNamespace = false;
 
   destinations: Destination[];
+  navigationDestinations: Destination[] = [];
+  pinnedDestinations: Destination[] = [];
   namespaceDestinations: Destination[];
+  matchedDestinations: Destination[] = [];
+  searchDestinations: Destination[] = [];
   filteredDestinations: Destination[];
   currentDestination = '';
 
-  helperText = `Search namespaces by starting with `;
+  helperText = `Search objects with kind:, label:, image: and ns:. Search namespaces by starting with `;
 
   input = '';
   inputChanged: Subject<string> = new Subject<string>();
@@ -54,10 +66,14 @@ export class QuickSwitcherComponent implements OnInit, OnDestroy {
 
   private navigationSubscription: Subscription;
   private namespaceSubscription: Subscription;
+  private preferencesSubscription: Subscription;
+  private searchSubscription: Subscription;
 
   constructor(
     private navigationService: NavigationService,
     private namespaceService: NamespaceService,
+    private contextPreferencesService: ContextPreferencesService,
+    private searchService: SearchService,
     private router: Router,
     private el: ElementRef
   ) {
@@ -78,9 +94,21 @@ export class QuickSwitcherComponent implements OnInit, OnDestroy {
     this.navigationSubscription = this.navigationService.current.subscribe(
       navigation => {
         this.navigation = navigation;
-        this.destinations = this.buildDestinations(navigation);
+        this.navigationDestinations = this.buildDestinations(navigation);
+        this.updateDestinations();
+      }
+    );
+    this.preferencesSubscription = this.contextPreferencesService.current.subscribe(
+      preferences => {
+        this.pinnedDestinations = this.buildPinnedDestinations(
+          preferences.pinnedObjects
+        );
+        this.updateDestinations();
       }
     );
+    this.searchSubscription = this.searchService.results.subscribe(results =>
+      this.updateSearchDestinations(results)
+    );
   }
 
   ngOnDestroy(): void {
@@ -90,6 +118,12 @@ export class QuickSwitcherComponent implements OnInit, OnDestroy {
     if (this.namespaceSubscription) {
       this.namespaceSubscription.unsubscribe();
     }
+    if (this.preferencesSubscription) {
+      this.preferencesSubscription.unsubscribe();
+    }
+    if (this.searchSubscription) {
+      this.searchSubscription.unsubscribe();
+    }
   }
 
   identifyNavigationItem(index: number, item: NavigationChild): string {
@@ -133,6 +167,51 @@ export class QuickSwitcherComponent implements OnInit, OnDestroy {
     return nsDestinations;
   }
 
+  // pinned objects are listed before the navigation destinations so they are
+  // the first thing offered when the switcher opens
+  private buildPinnedDestinations(objects: PinnedObject[]): Destination[] {
+    return objects.map(object => {
+      const keywords = [object.kind, object.name];
+      if (object.namespace) {
+        keywords.push(object.namespace);
+      }
+      return {
+        title: `${object.kind} ${object.name}`,
+        type: 'Pinned',
+        path: object.path,
+        keywords,
+      };
+    });
+  }
+
+  private updateDestinations() {
+    this.destinations = [
+      ...this.pinnedDestinations,
+      ...this.navigationDestinations,
+    ];
+  }
+
+  // objects found by searching the cache are listed after the destinations
+  // matching the input. Results for an earlier query are ignored.
+  private updateSearchDestinations(results: SearchResults) {
+    const query = this.input.trim();
+    if (this.searchingNamespace || !query || results.query !== query) {
+      return;
+    }
+    this.searchDestinations = results.results.map(result => ({
+      title: result.name,
+      type: result.namespace
+        ? `${result.kind} ${result.namespace}`
+        : result.kind,
+      path: result.link.config.ref,
+      keywords: [],
+    }));
+    this.filteredDestinations = [
+      ...this.matchedDestinations,
+      ...this.searchDestinations,
+    ];
+  }
+
   private recBuildDestinations(titleAcc: string, keywordAcc, item) {
     if (titleAcc !== '') {
       item.type = titleAcc;
@@ -198,9 +277,11 @@ export class QuickSwitcherComponent implements OnInit, OnDestroy {
 
   updateFilteredDestinations(filter: string) {
     this.activeIndex = 0;
+    this.searchDestinations = [];
     if (filter === '') {
       this.filteredDestinations = this.destinations;
       this.searchingNamespace = false;
+      this.searchService.search('');
       return;
     }
 
@@ -216,15 +297,19 @@ export class QuickSwitcherComponent implements OnInit, OnDestroy {
       return;
     }
     this.searchingNamespace = false;
-    this.filteredDestinations = this.destinations.filter(d => {
+    this.matchedDestinations = this.destinations.filter(d => {
       const lk = d.keywords.map(k => k.toLowerCase());
       return lk.findIndex(k => k.includes(filter.toLowerCase())) !== -1;
     });
+    this.filteredDestinations = this.matchedDestinations;
+    this.searchService.search(filter.trim());
   }
 
   private resetModal() {
     this.input = '';
     this.filteredDestinations = this.destinations;
+    this.matchedDestinations = [];
+    this.searchDestinations = [];
     this.activeIndex = 0;
     this.searchingNamespace = false;
   }
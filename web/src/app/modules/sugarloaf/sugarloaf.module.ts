@@ -8,6 +8,8 @@ import { NotifierComponent } from './components/smart/notifier/notifier.componen
 import { NavigationComponent } from './components/smart/navigation/navigation.component';
 import { QuickSwitcherComponent } from './components/smart/quick-switcher/quick-switcher.component';
 import { ApplyYAMLComponent } from './components/smart/apply-yaml/apply-yaml.component';
+import { ActionPreviewComponent } from './components/smart/action-preview/action-preview.component';
+import { AuthStatusComponent } from './components/smart/auth-status/auth-status.component';
 import { ThemeSwitchButtonComponent } from './components/smart/theme-switch/theme-switch-button.component';
 import { UploaderComponent } from './components/smart/uploader/uploader.component';
 import { ClarityModule } from '@clr/angular';
@@ -37,7 +39,9 @@ export class UnstripTrailingSlashLocation extends Location {
 
 @NgModule({
   declarations: [
+    ActionPreviewComponent,
     ApplyYAMLComponent,
+    AuthStatusComponent,
     ContainerComponent,
     NamespaceComponent,
     PageNotFoundComponent,
@@ -0,0 +1,30 @@
+/*
+ * Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+import { applyPatch } from './json-patch';
+
+describe('applyPatch', () => {
+  it('should apply operations to a copy of the document', () => {
+    const document = { a: 1, 'b/c': { d: [1, 2, 3] }, e: true };
+
+    const result = applyPatch(document, [
+      { op: 'replace', path: '/a', value: 2 },
+      { op: 'remove', path: '/e' },
+      { op: 'remove', path: '/b~1c/d/2' },
+      { op: 'add', path: '/b~1c/d/2', value: 4 },
+      { op: 'add', path: '/f', value: null },
+    ]);
+
+    expect(result).toEqual({ a: 2, 'b/c': { d: [1, 2, 4] }, f: null } as any);
+    expect(document).toEqual({ a: 1, 'b/c': { d: [1, 2, 3] }, e: true });
+  });
+
+  it('should throw if a path does not exist', () => {
+    expect(() =>
+      applyPatch({ a: [] }, [{ op: 'replace', path: '/a/0', value: 1 }])
+    ).toThrow();
+    expect(() => applyPatch({}, [{ op: 'remove', path: '/b/c' }])).toThrow();
+  });
+});
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+/**
+ * An RFC 6902 JSON Patch operation. Only the operations the server sends
+ * are supported.
+ */
+export interface PatchOperation {
+  op: 'add' | 'remove' | 'replace';
+  path: string;
+  value?: any;
+}
+
+/**
+ * Applies a JSON Patch to a copy of a document. It throws if an operation
+ * can't be applied.
+ */
+export function applyPatch<T>(document: T, operations: PatchOperation[]): T {
+  let result: any = JSON.parse(JSON.stringify(document));
+
+  for (const operation of operations) {
+    if (operation.path === '') {
+      if (operation.op === 'remove') {
+        throw new Error('unable to remove the document root');
+      }
+      result = operation.value;
+      continue;
+    }
+
+    const keys = operation.path
+      .substring(1)
+      .split('/')
+      .map(key => key.replace(/~1/g, '/').replace(/~0/g, '~'));
+    const last = keys.pop();
+
+    let parent = result;
+    for (const key of keys) {
+      if (parent === null || typeof parent !== 'object' || !(key in parent)) {
+        throw new Error(`path ${operation.path} does not exist`);
+      }
+      parent = parent[key];
+    }
+
+    if (Array.isArray(parent)) {
+      const index = last === '-' ? parent.length : Number(last);
+      if (!Number.isInteger(index) || index < 0 || index > parent.length) {
+        throw new Error(`invalid index in ${operation.path}`);
+      }
+
+      switch (operation.op) {
+        case 'add':
+          parent.splice(index, 0, operation.value);
+          break;
+        case 'remove':
+        case 'replace':
+          if (index === parent.length) {
+            throw new Error(`path ${operation.path} does not exist`);
+          }
+          if (operation.op === 'remove') {
+            parent.splice(index, 1);
+          } else {
+            parent[index] = operation.value;
+          }
+          break;
+      }
+    } else if (parent !== null && typeof parent === 'object') {
+      if (operation.op !== 'add' && !(last in parent)) {
+        throw new Error(`path ${operation.path} does not exist`);
+      }
+      if (operation.op === 'remove') {
+        delete parent[last];
+      } else {
+        parent[last] = operation.value;
+      }
+    } else {
+      throw new Error(`path ${operation.path} does not exist`);
+    }
+  }
+
+  return result;
+}